@@ -307,6 +307,12 @@ def go_dependencies():
         sum = "h1:1+zwFm3MEqd/0K3YBB2v9u9DtyYHyEuhVOfeIXbteWA=",
         version = "v1.16.1",
     )
+    go_repository(
+        name = "com_github_hashicorp_terraform_plugin_framework_timeouts",
+        importpath = "github.com/hashicorp/terraform-plugin-framework-timeouts",
+        sum = "h1:jblRy1PkLfPm5hb5XeMa3tezusnMRziUGqtT5epSYoI=",
+        version = "v0.7.0",
+    )
     go_repository(
         name = "com_github_hashicorp_terraform_plugin_go",
         importpath = "github.com/hashicorp/terraform-plugin-go",
@@ -0,0 +1,35 @@
+# Write-only credential example - n8n Community Edition
+# Requires Terraform 1.11+: the password is never stored in the state.
+terraform {
+  required_version = ">= 1.11"
+  required_providers {
+    n8n = {
+      source  = "kodflow/n8n"
+      version = ">= 1.0"
+    }
+  }
+}
+
+provider "n8n" {
+  base_url = var.n8n_base_url
+  api_key  = var.n8n_api_key
+}
+
+# Create HTTP Basic Auth credential with write-only data
+resource "n8n_credential" "http_basic_auth" {
+  name       = "ci-${var.run_id}-Example Write-Only Basic Auth"
+  type       = "httpBasicAuth"
+  project_id = var.project_id != "" ? var.project_id : null
+
+  data_wo = {
+    user     = var.basic_auth_user
+    password = var.basic_auth_password
+  }
+  # Bump to rotate the credential after changing the password.
+  data_wo_version = var.basic_auth_password_version
+}
+
+output "credential_id" {
+  value       = n8n_credential.http_basic_auth.id
+  description = "The ID of the created credential"
+}
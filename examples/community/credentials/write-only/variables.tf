@@ -0,0 +1,43 @@
+variable "n8n_base_url" {
+  description = "N8N Base URL"
+  type        = string
+  default     = "http://localhost:5678"
+}
+
+variable "n8n_api_key" {
+  description = "N8N API Key"
+  type        = string
+  sensitive   = true
+}
+
+variable "run_id" {
+  description = "Unique run identifier for cattle-style resource naming"
+  type        = string
+  default     = "local"
+}
+
+variable "basic_auth_user" {
+  description = "Basic auth username"
+  type        = string
+  default     = "testuser"
+}
+
+variable "basic_auth_password" {
+  description = "Basic auth password"
+  type        = string
+  sensitive   = true
+  ephemeral   = true
+  default     = "testpassword"
+}
+
+variable "basic_auth_password_version" {
+  description = "Version of the basic auth password, bump to rotate the credential"
+  type        = number
+  default     = 1
+}
+
+variable "project_id" {
+  description = "Project ID for E2E test isolation"
+  type        = string
+  default     = ""
+}
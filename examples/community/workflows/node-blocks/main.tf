@@ -0,0 +1,63 @@
+# Workflow with node blocks example - n8n Community Edition
+terraform {
+  required_providers {
+    n8n = {
+      source  = "kodflow/n8n"
+      version = ">= 1.0"
+    }
+  }
+}
+
+provider "n8n" {
+  base_url = var.n8n_base_url
+  api_key  = var.n8n_api_key
+}
+
+# Nodes, connections and settings are declared as attributes and blocks instead
+# of JSON strings, so plans show the changed attributes of each node.
+resource "n8n_workflow" "node_blocks_example" {
+  name       = "ci-${var.run_id}-Node Blocks Workflow"
+  project_id = var.project_id != "" ? var.project_id : null
+
+  settings = {
+    timezone                  = "UTC"
+    execution_order           = "v1"
+    save_data_error_execution = "all"
+  }
+
+  # Nodes are keyed by name, so plans only show the nodes that change.
+  nodes = {
+    "Webhook" = {
+      type     = "n8n-nodes-base.webhook"
+      position = [250, 300]
+      parameters = jsonencode({
+        path       = "node-blocks-example"
+        httpMethod = "POST"
+      })
+    }
+    "Call API" = {
+      type          = "n8n-nodes-base.httpRequest"
+      type_version  = 4.2
+      position      = [450, 300]
+      retry_on_fail = true
+      max_tries     = 3
+      on_error      = "continueRegularOutput"
+      parameters = jsonencode({
+        url    = "https://example.com/api"
+        method = "POST"
+      })
+    }
+  }
+
+  # AI agent nodes are connected the same way, with from_output set to
+  # ai_languageModel, ai_tool, ai_memory or ai_outputParser.
+  node_connection {
+    from = "Webhook"
+    to   = "Call API"
+  }
+}
+
+output "workflow_id" {
+  value       = n8n_workflow.node_blocks_example.id
+  description = "The ID of the created workflow"
+}
@@ -311,10 +311,10 @@ resource "n8n_workflow_node" "node_integration_aws_lambda" {
   )
 }
 
-# 20. integration/background-color - n8n-nodes-base.Blur
+# 20. integration/background-color - n8n-nodes-base.editImage
 resource "n8n_workflow_node" "node_integration_background_color" {
   name     = "Background Color"
-  type     = "n8n-nodes-base.Blur"
+  type     = "n8n-nodes-base.editImage"
   position = [250, 700]
 
   parameters = jsonencode(
@@ -324,10 +324,10 @@ resource "n8n_workflow_node" "node_integration_background_color" {
   )
 }
 
-# 21. integration/bamboohr - n8n-nodes-base.bamboohr
+# 21. integration/bamboohr - n8n-nodes-base.bambooHr
 resource "n8n_workflow_node" "node_integration_bamboohr" {
   name     = "BambooHr"
-  type     = "n8n-nodes-base.bamboohr"
+  type     = "n8n-nodes-base.bambooHr"
   position = [500, 700]
 
   parameters = jsonencode(
@@ -987,10 +987,10 @@ resource "n8n_workflow_node" "node_integration_execution_data" {
   )
 }
 
-# 72. integration/extraction-values - n8n-nodes-base.extractionValues
+# 72. integration/extraction-values - n8n-nodes-base.htmlExtract
 resource "n8n_workflow_node" "node_integration_extraction_values" {
   name     = "Extraction Values"
-  type     = "n8n-nodes-base.extractionValues"
+  type     = "n8n-nodes-base.htmlExtract"
   position = [750, 1700]
 
   parameters = jsonencode(
@@ -1432,10 +1432,10 @@ resource "n8n_workflow_node" "node_integration_icalendar" {
   )
 }
 
-# 106. integration/interact-with-telegram-using-our-pre-built - n8n-nodes-base.preBuiltAgentsCalloutTelegram
+# 106. integration/interact-with-telegram-using-our-pre-built - n8n-nodes-base.telegram
 resource "n8n_workflow_node" "node_integration_interact_with_telegram_using_our_pre_built" {
   name     = "Interact with Telegram using our pre-built"
-  type     = "n8n-nodes-base.preBuiltAgentsCalloutTelegram"
+  type     = "n8n-nodes-base.telegram"
   position = [1750, 2300]
 
   parameters = jsonencode(
@@ -1640,10 +1640,10 @@ resource "n8n_workflow_node" "node_integration_lemlist" {
   )
 }
 
-# 122. integration/limit-wait-time - n8n-nodes-base.limitWaitTime
+# 122. integration/limit-wait-time - n8n-nodes-base.wait
 resource "n8n_workflow_node" "node_integration_limit_wait_time" {
   name     = "Limit Wait Time"
-  type     = "n8n-nodes-base.limitWaitTime"
+  type     = "n8n-nodes-base.wait"
   position = [750, 2700]
 
   parameters = jsonencode(
@@ -2628,10 +2628,10 @@ resource "n8n_workflow_node" "node_integration_rename_keys" {
   )
 }
 
-# 198. integration/respond-with - n8n-nodes-base.respondWith
+# 198. integration/respond-with - n8n-nodes-base.respondToWebhook
 resource "n8n_workflow_node" "node_integration_respond_with" {
   name     = "Respond With"
-  type     = "n8n-nodes-base.respondWith"
+  type     = "n8n-nodes-base.respondToWebhook"
   position = [2250, 4100]
 
   parameters = jsonencode(
@@ -3161,10 +3161,10 @@ resource "n8n_workflow_node" "node_integration_thehive" {
   )
 }
 
-# 239. integration/thehiveproject - n8n-nodes-base.thehiveproject
+# 239. integration/thehiveproject - n8n-nodes-base.theHiveProject
 resource "n8n_workflow_node" "node_integration_thehiveproject" {
   name     = "TheHiveProject"
-  type     = "n8n-nodes-base.thehiveproject"
+  type     = "n8n-nodes-base.theHiveProject"
   position = [2500, 4900]
 
   parameters = jsonencode(
@@ -3369,10 +3369,10 @@ resource "n8n_workflow_node" "node_integration_vonage" {
   )
 }
 
-# 255. integration/wait-amount - n8n-nodes-base.amount
+# 255. integration/wait-amount - n8n-nodes-base.wait
 resource "n8n_workflow_node" "node_integration_wait_amount" {
   name     = "Wait Amount"
-  type     = "n8n-nodes-base.amount"
+  type     = "n8n-nodes-base.wait"
   position = [1500, 5300]
 
   parameters = jsonencode(
@@ -1,6 +1,6 @@
 # Test workflow for Background Color
 # Category: Integration
-# Type: n8n-nodes-base.Blur
+# Type: n8n-nodes-base.editImage
 
 terraform {
   required_providers {
@@ -26,7 +26,7 @@ resource "n8n_workflow_node" "manual_trigger" {
 # TESTED NODE: Background Color
 resource "n8n_workflow_node" "test_node" {
   name     = "Background Color"
-  type     = "n8n-nodes-base.Blur"
+  type     = "n8n-nodes-base.editImage"
   position = [450, 300]
 
   parameters = jsonencode(
@@ -1,6 +1,6 @@
 # Test workflow for BambooHr
 # Category: Integration
-# Type: n8n-nodes-base.bamboohr
+# Type: n8n-nodes-base.bambooHr
 
 terraform {
   required_providers {
@@ -26,7 +26,7 @@ resource "n8n_workflow_node" "manual_trigger" {
 # TESTED NODE: BambooHr
 resource "n8n_workflow_node" "test_node" {
   name     = "BambooHr"
-  type     = "n8n-nodes-base.bamboohr"
+  type     = "n8n-nodes-base.bambooHr"
   position = [450, 300]
 
   parameters = jsonencode(
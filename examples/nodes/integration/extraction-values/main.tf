@@ -1,6 +1,6 @@
 # Test workflow for Extraction Values
 # Category: Integration
-# Type: n8n-nodes-base.extractionValues
+# Type: n8n-nodes-base.htmlExtract
 
 terraform {
   required_providers {
@@ -26,7 +26,7 @@ resource "n8n_workflow_node" "manual_trigger" {
 # TESTED NODE: Extraction Values
 resource "n8n_workflow_node" "test_node" {
   name     = "Extraction Values"
-  type     = "n8n-nodes-base.extractionValues"
+  type     = "n8n-nodes-base.htmlExtract"
   position = [450, 300]
 
   parameters = jsonencode(
@@ -1,6 +1,6 @@
 # Test workflow for Interact with Telegram using our pre-built
 # Category: Integration
-# Type: n8n-nodes-base.preBuiltAgentsCalloutTelegram
+# Type: n8n-nodes-base.telegram
 
 terraform {
   required_providers {
@@ -26,7 +26,7 @@ resource "n8n_workflow_node" "manual_trigger" {
 # TESTED NODE: Interact with Telegram using our pre-built
 resource "n8n_workflow_node" "test_node" {
   name     = "Interact with Telegram using our pre-built"
-  type     = "n8n-nodes-base.preBuiltAgentsCalloutTelegram"
+  type     = "n8n-nodes-base.telegram"
   position = [450, 300]
 
   parameters = jsonencode(
@@ -1,6 +1,6 @@
 # Test workflow for Limit Wait Time
 # Category: Integration
-# Type: n8n-nodes-base.limitWaitTime
+# Type: n8n-nodes-base.wait
 
 terraform {
   required_providers {
@@ -26,7 +26,7 @@ resource "n8n_workflow_node" "manual_trigger" {
 # TESTED NODE: Limit Wait Time
 resource "n8n_workflow_node" "test_node" {
   name     = "Limit Wait Time"
-  type     = "n8n-nodes-base.limitWaitTime"
+  type     = "n8n-nodes-base.wait"
   position = [450, 300]
 
   parameters = jsonencode(
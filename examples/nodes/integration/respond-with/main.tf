@@ -1,6 +1,6 @@
 # Test workflow for Respond With
 # Category: Integration
-# Type: n8n-nodes-base.respondWith
+# Type: n8n-nodes-base.respondToWebhook
 
 terraform {
   required_providers {
@@ -26,7 +26,7 @@ resource "n8n_workflow_node" "manual_trigger" {
 # TESTED NODE: Respond With
 resource "n8n_workflow_node" "test_node" {
   name     = "Respond With"
-  type     = "n8n-nodes-base.respondWith"
+  type     = "n8n-nodes-base.respondToWebhook"
   position = [450, 300]
 
   parameters = jsonencode(
@@ -1,6 +1,6 @@
 # Test workflow for TheHiveProject
 # Category: Integration
-# Type: n8n-nodes-base.thehiveproject
+# Type: n8n-nodes-base.theHiveProject
 
 terraform {
   required_providers {
@@ -26,7 +26,7 @@ resource "n8n_workflow_node" "manual_trigger" {
 # TESTED NODE: TheHiveProject
 resource "n8n_workflow_node" "test_node" {
   name     = "TheHiveProject"
-  type     = "n8n-nodes-base.thehiveproject"
+  type     = "n8n-nodes-base.theHiveProject"
   position = [450, 300]
 
   parameters = jsonencode(
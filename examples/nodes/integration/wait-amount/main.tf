@@ -1,6 +1,6 @@
 # Test workflow for Wait Amount
 # Category: Integration
-# Type: n8n-nodes-base.amount
+# Type: n8n-nodes-base.wait
 
 terraform {
   required_providers {
@@ -26,7 +26,7 @@ resource "n8n_workflow_node" "manual_trigger" {
 # TESTED NODE: Wait Amount
 resource "n8n_workflow_node" "test_node" {
   name     = "Wait Amount"
-  type     = "n8n-nodes-base.amount"
+  type     = "n8n-nodes-base.wait"
   position = [450, 300]
 
   parameters = jsonencode(
@@ -7,7 +7,7 @@ BLUE ?= \033[36m
 GREEN ?= \033[32m
 NC ?= \033[0m
 
-.PHONY: nodes nodes/fetch nodes/parse nodes/diff nodes/generate nodes/workflows nodes/mega-workflow nodes/mega-workflow/test nodes/validate-coverage nodes/sync-report nodes/docs nodes/stats nodes/test nodes/clean
+.PHONY: nodes nodes/fetch nodes/parse nodes/diff nodes/generate nodes/catalog nodes/workflows nodes/mega-workflow nodes/mega-workflow/test nodes/validate-coverage nodes/sync-report nodes/docs nodes/stats nodes/test nodes/clean
 
 # Main nodes synchronization command
 nodes: nodes/fetch nodes/parse nodes/sync-report nodes/diff nodes/generate nodes/test ## Synchronize n8n nodes from official repository
@@ -34,6 +34,11 @@ nodes/generate: ## Generate Go code and Terraform examples
 	@echo "$(BLUE)[1mGenerating code and examples...$(NC)"
 	@bash scripts/nodes/sync-n8n-nodes.sh generate
 
+# Generate the node type catalog embedded in the provider
+nodes/catalog: ## Generate the node type catalog validated by the workflow resources
+	@echo "$(BLUE)[1mGenerating node catalog...$(NC)"
+	@bash scripts/nodes/sync-n8n-nodes.sh catalog
+
 # Generate complete workflow for each node
 nodes/workflows: ## Generate per-node workflow examples (296 examples)
 	@echo "$(BLUE)[1mGenerating per-node workflow examples...$(NC)"
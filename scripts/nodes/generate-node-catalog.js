@@ -0,0 +1,75 @@
+#!/usr/bin/env node
+/**
+ * Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+ * Licensed under the Sustainable Use License 1.0
+ * See LICENSE in the project root for license information.
+ *
+ * Generate the node type catalog embedded in the provider from the registry JSON
+ */
+
+const fs = require('fs');
+const path = require('path');
+
+// Parse command line arguments
+const [,, dataDir, catalogFile] = process.argv;
+
+if (!dataDir || !catalogFile) {
+    console.error('Usage: generate-node-catalog.js <data-dir> <catalog-file>');
+    process.exit(1);
+}
+
+const registryFile = path.join(dataDir, 'n8n-nodes-registry.json');
+
+if (!fs.existsSync(registryFile)) {
+    console.error('Registry not found:', registryFile);
+    console.error('Run "make nodes/fetch nodes/parse" first.');
+    process.exit(1);
+}
+
+const registry = JSON.parse(fs.readFileSync(registryFile, 'utf8'));
+
+// The catalog records the n8n release the provider validates against
+if (!registry.version || registry.version === 'unknown') {
+    console.error('Registry has no n8n version:', registryFile);
+    console.error('Run "make nodes/fetch nodes/parse" again.');
+    process.exit(1);
+}
+
+// Build the catalog entries, keyed by node type
+const nodes = {};
+for (const node of registry.nodes) {
+    // Registries parsed before packages were recorded only hold base nodes
+    const nodeType = node.type.includes('.') ? node.type : `${node.package || 'n8n-nodes-base'}.${node.type}`;
+    const entry = nodes[nodeType] || { versions: [], parameters: [], trigger: false, polling: false };
+
+    entry.versions = [...new Set([...entry.versions, ...(node.versions || [])])].sort((a, b) => a - b);
+    entry.parameters = [...new Set([...entry.parameters, ...(node.parameters || [])])].sort();
+    entry.trigger = entry.trigger || node.group === 'trigger';
+    entry.polling = entry.polling || Boolean(node.polling);
+    nodes[nodeType] = entry;
+}
+
+// Write one node type per line, so that catalog updates review as line diffs
+const lines = Object.keys(nodes).sort().map(nodeType => {
+    const entry = {};
+    if (nodes[nodeType].versions.length > 0) entry.versions = nodes[nodeType].versions;
+    if (nodes[nodeType].parameters.length > 0) entry.parameters = nodes[nodeType].parameters;
+    if (nodes[nodeType].trigger) entry.trigger = true;
+    if (nodes[nodeType].polling) entry.polling = true;
+    return `    ${JSON.stringify(nodeType)}: ${JSON.stringify(entry)}`;
+});
+
+const catalog = [
+    '{',
+    `  "n8n_version": ${JSON.stringify(registry.version)},`,
+    '  "nodes": {',
+    lines.join(',\n'),
+    '  }',
+    '}',
+    '',
+].join('\n');
+
+fs.writeFileSync(catalogFile, catalog);
+
+console.log('✓ Catalog written to:', catalogFile);
+console.log(`  Node types: ${lines.length}`);
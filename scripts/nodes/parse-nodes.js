@@ -18,101 +18,193 @@ if (!cacheDir || !dataDir) {
     process.exit(1);
 }
 
-const nodesBaseDir = path.join(cacheDir, 'packages', 'nodes-base', 'nodes');
+// Node packages shipped with n8n, relative to the repository root
+const nodePackages = [
+    path.join(cacheDir, 'packages', 'nodes-base'),
+    path.join(cacheDir, 'packages', '@n8n', 'nodes-langchain'),
+];
 const registryFile = path.join(dataDir, 'n8n-nodes-registry.json');
 const metadataFile = path.join(dataDir, 'n8n-nodes-metadata.json');
 
-console.log('Parsing nodes from:', nodesBaseDir);
+// Find .node.ts files recursively (e.g. nodes/Google/Sheet/GoogleSheets.node.ts)
+function findNodeFiles(dir) {
+    const files = [];
+    for (const entry of fs.readdirSync(dir, { withFileTypes: true })) {
+        const entryPath = path.join(dir, entry.name);
+        if (entry.isDirectory()) {
+            files.push(...findNodeFiles(entryPath));
+        } else if (entry.name.endsWith('.node.ts')) {
+            files.push(entryPath);
+        }
+    }
+    return files;
+}
 
-// Read all node directories
+// Read a node file and the files it imports from the package, e.g. the
+// version classes of versioned nodes and their property descriptions
+function readNodeSources(nodeFile, packageDir) {
+    const sources = new Map();
+    const visited = new Set();
+    const pending = [nodeFile];
+
+    while (pending.length > 0) {
+        const file = pending.pop();
+        if (visited.has(file)) continue;
+        visited.add(file);
+
+        const content = fs.readFileSync(file, 'utf8');
+        sources.set(file, content);
+
+        for (const match of content.matchAll(/from\s+['"](\.{1,2}\/[^'"]+)['"]/g)) {
+            const base = path.resolve(path.dirname(file), match[1]);
+            const candidates = [base + '.ts', path.join(base, 'index.ts')];
+            const resolved = candidates.find(f => fs.existsSync(f));
+            if (resolved && resolved.startsWith(path.join(packageDir, 'nodes'))) {
+                pending.push(resolved);
+            }
+        }
+    }
+    return sources;
+}
+
+// Extract the node name from the node description, not from a nested property
+function parseNodeName(content) {
+    const descriptionMatch = content.match(/\b(?:baseDescription|description)\b[^=]*=\s*\{[\s\S]*?\bname:\s*['"]([^'"]+)['"]/);
+    if (descriptionMatch) return descriptionMatch[1];
+    const nameMatch = content.match(/name:\s*['"]([^'"]+)['"]/);
+    return nameMatch ? nameMatch[1] : null;
+}
+
+// Extract every type version, e.g. `version: 1` or `version: [2, 2.1, 2.2]`
+function parseVersions(sources) {
+    const versions = new Set();
+    for (const content of sources) {
+        for (const match of content.matchAll(/\bversion:\s*(\[[^\]]*\]|\d+(?:\.\d+)?)/g)) {
+            for (const number of match[1].match(/\d+(?:\.\d+)?/g) || []) {
+                versions.add(parseFloat(number));
+            }
+        }
+    }
+    return versions.size > 0 ? [...versions].sort((a, b) => a - b) : [1];
+}
+
+// Extract the parameter names declared by the node properties, including
+// nested options, so that only keys unknown to the node are reported
+function parseParameters(sources) {
+    const parameters = new Set();
+    for (const content of sources) {
+        for (const match of content.matchAll(/displayName:\s*['"`][^'"`]*['"`],\s*name:\s*['"]([^'"]+)['"]/g)) {
+            parameters.add(match[1]);
+        }
+    }
+    return [...parameters].sort();
+}
+
+// Read all node files of all packages
 function discoverNodes() {
     const nodes = [];
     const categories = {};
 
-    if (!fs.existsSync(nodesBaseDir)) {
-        console.error('Nodes directory not found:', nodesBaseDir);
-        process.exit(1);
-    }
+    for (const packageDir of nodePackages) {
+        const packageJsonFile = path.join(packageDir, 'package.json');
+        if (!fs.existsSync(packageJsonFile)) {
+            console.error('Node package not found:', packageDir);
+            process.exit(1);
+        }
+        const packageName = JSON.parse(fs.readFileSync(packageJsonFile, 'utf8')).name;
+        const nodesDir = path.join(packageDir, 'nodes');
+
+        console.log('Parsing nodes from:', nodesDir);
+
+        // Version classes of versioned nodes (e.g. If/V2/IfV2.node.ts) are
+        // imported by their node, and merged into it rather than listed
+        const nodeFiles = findNodeFiles(nodesDir);
+        const nodeSources = new Map(nodeFiles.map(f => [f, readNodeSources(f, packageDir)]));
+        const versionFiles = new Set();
+        for (const [nodeFile, sources] of nodeSources) {
+            for (const file of sources.keys()) {
+                if (file !== nodeFile) versionFiles.add(file);
+            }
+        }
 
-    const entries = fs.readdirSync(nodesBaseDir, { withFileTypes: true });
-
-    for (const entry of entries) {
-        if (!entry.isDirectory()) continue;
-
-        const nodeName = entry.name;
-        const nodeDir = path.join(nodesBaseDir, nodeName);
-
-        // Look for .node.ts file
-        const nodeFiles = fs.readdirSync(nodeDir).filter(f => f.endsWith('.node.ts'));
-
-        if (nodeFiles.length === 0) continue;
-
-        const nodeFile = path.join(nodeDir, nodeFiles[0]);
-        const nodeJsonFile = path.join(nodeDir, nodeName + '.node.json');
-
-        try {
-            // Parse basic info from file content
-            const content = fs.readFileSync(nodeFile, 'utf8');
-
-            // Extract node type identifier (e.g., "n8n-nodes-base.webhook")
-            const typeMatch = content.match(/name:\s*['"]([^'"]+)['"]/);
-            const displayNameMatch = content.match(/displayName:\s*['"]([^'"]+)['"]/);
-            const descriptionMatch = content.match(/description:\s*['"]([^'"]+)['"]/);
-            const groupMatch = content.match(/group:\s*\[['"]([^'"]+)['"]\]/);
-            const versionMatch = content.match(/version:\s*(\d+)/g);
-
-            // Try to detect input/output types
-            const inputsMatch = content.match(/inputs:\s*\[([^\]]+)\]/);
-            const outputsMatch = content.match(/outputs:\s*\[([^\]]+)\]/);
-
-            const nodeType = typeMatch ? typeMatch[1] : `n8n-nodes-base.${nodeName.toLowerCase()}`;
-            const displayName = displayNameMatch ? displayNameMatch[1] : nodeName;
-            const description = descriptionMatch ? descriptionMatch[1] : '';
-            const group = groupMatch ? groupMatch[1] : 'action';
-            const versions = versionMatch ? versionMatch.map(v => parseInt(v.match(/\d+/)[0])) : [1];
-
-            // Parse inputs/outputs
-            const inputs = inputsMatch ? parseConnectionArray(inputsMatch[1]) : ['main'];
-            const outputs = outputsMatch ? parseConnectionArray(outputsMatch[1]) : ['main'];
-
-            // Determine category from group or file location
-            let category = 'Integration';
-            if (group === 'trigger') category = 'Trigger';
-            else if (['Code', 'Set', 'Merge', 'If', 'Switch'].includes(nodeName)) category = 'Core';
-            else if (['PostgreSQL', 'MySQL', 'MongoDB'].some(db => nodeName.includes(db))) category = 'Database';
-
-            // Try to read .node.json for additional metadata
-            let resources = {};
-            if (fs.existsSync(nodeJsonFile)) {
-                try {
-                    const nodeJson = JSON.parse(fs.readFileSync(nodeJsonFile, 'utf8'));
-                    resources = nodeJson.resources || {};
-                } catch (e) {
-                    // Ignore JSON parse errors
+        for (const nodeFile of nodeFiles) {
+            if (versionFiles.has(nodeFile)) continue;
+
+            const nodeName = path.basename(nodeFile, '.node.ts');
+            const nodeDir = path.dirname(nodeFile);
+            const nodeJsonFile = path.join(nodeDir, nodeName + '.node.json');
+
+            try {
+                // Parse basic info from file content
+                const content = fs.readFileSync(nodeFile, 'utf8');
+
+                const typeName = parseNodeName(content);
+                if (!typeName) continue;
+
+                const displayNameMatch = content.match(/displayName:\s*['"]([^'"]+)['"]/);
+                const descriptionMatch = content.match(/description:\s*['"]([^'"]+)['"]/);
+                const groupMatch = content.match(/group:\s*\[['"]([^'"]+)['"]\]/);
+
+                // Try to detect input/output types
+                const inputsMatch = content.match(/inputs:\s*\[([^\]]+)\]/);
+                const outputsMatch = content.match(/outputs:\s*\[([^\]]+)\]/);
+
+                const sources = [...nodeSources.get(nodeFile).values()];
+                const nodeType = `${packageName}.${typeName}`;
+                const displayName = displayNameMatch ? displayNameMatch[1] : nodeName;
+                const description = descriptionMatch ? descriptionMatch[1] : '';
+                const group = groupMatch ? groupMatch[1] : 'action';
+                const versions = parseVersions(sources);
+                // Pollers are triggers whose executions are scheduled by n8n
+                const polling = sources.some(source => /\bpolling:\s*true/.test(source));
+
+                // Parse inputs/outputs
+                const inputs = inputsMatch ? parseConnectionArray(inputsMatch[1]) : ['main'];
+                const outputs = outputsMatch ? parseConnectionArray(outputsMatch[1]) : ['main'];
+
+                // Determine category from group or file location
+                let category = 'Integration';
+                if (group === 'trigger') category = 'Trigger';
+                else if (packageName.includes('langchain')) category = 'AI';
+                else if (['Code', 'Set', 'Merge', 'If', 'Switch'].includes(nodeName)) category = 'Core';
+                else if (['PostgreSQL', 'MySQL', 'MongoDB'].some(db => nodeName.includes(db))) category = 'Database';
+
+                // Try to read .node.json for additional metadata
+                let resources = {};
+                if (fs.existsSync(nodeJsonFile)) {
+                    try {
+                        const nodeJson = JSON.parse(fs.readFileSync(nodeJsonFile, 'utf8'));
+                        resources = nodeJson.resources || {};
+                    } catch (e) {
+                        // Ignore JSON parse errors
+                    }
                 }
-            }
 
-            const nodeInfo = {
-                name: displayName,
-                type: nodeType,
-                category,
-                group,
-                versions,
-                latest_version: Math.max(...versions),
-                description,
-                inputs,
-                outputs,
-                file: path.relative(cacheDir, nodeFile),
-                resources
-            };
-
-            nodes.push(nodeInfo);
-
-            // Count by category
-            categories[category] = (categories[category] || 0) + 1;
-
-        } catch (error) {
-            console.warn(`Warning: Failed to parse ${nodeName}:`, error.message);
+                const nodeInfo = {
+                    name: displayName,
+                    type: nodeType,
+                    package: packageName,
+                    category,
+                    group,
+                    polling,
+                    versions,
+                    latest_version: Math.max(...versions),
+                    description,
+                    inputs,
+                    outputs,
+                    parameters: parseParameters(sources).filter(name => name !== typeName),
+                    file: path.relative(cacheDir, nodeFile),
+                    resources
+                };
+
+                nodes.push(nodeInfo);
+
+                // Count by category
+                categories[category] = (categories[category] || 0) + 1;
+
+            } catch (error) {
+                console.warn(`Warning: Failed to parse ${nodeName}:`, error.message);
+            }
         }
     }
 
@@ -24,6 +24,7 @@ REGISTRY_FILE="${DATA_DIR}/n8n-nodes-registry.json"
 METADATA_FILE="${DATA_DIR}/n8n-nodes-metadata.json"
 VERSION_FILE="${DATA_DIR}/n8n-nodes-version.txt"
 CHANGELOG_FILE="${DATA_DIR}/n8n-nodes-changelog.md"
+CATALOG_FILE="${ROOT_DIR}/src/internal/provider/workflow/nodecatalog/catalog.json"
 
 # Logging functions
 log_info() {
@@ -59,10 +60,11 @@ fetch_n8n_repo() {
     git clone --depth 1 --single-branch --branch master "${N8N_REPO}" "${CACHE_DIR}"
   fi
 
-  # Get version
+  # Get version, from the n8n package as the shallow clone has no tags
   cd "${CACHE_DIR}"
   local version
-  version=$(git describe --tags --abbrev=0 2>/dev/null || echo "unknown")
+  version=$(node -p "require('./packages/cli/package.json').version" 2>/dev/null ||
+    git describe --tags --abbrev=0 2>/dev/null || echo "unknown")
   echo "${version}" >"${VERSION_FILE}"
 
   log_success "Repository fetched successfully (version: ${version})"
@@ -117,6 +119,20 @@ generate_code() {
   log_success "Go code generated"
 }
 
+# Generate the node catalog embedded in the provider
+generate_catalog() {
+  log_info "Generating node catalog..."
+
+  if [ ! -f "${REGISTRY_FILE}" ]; then
+    log_warn "No registry found, skipping catalog generation"
+    return
+  fi
+
+  node "${SCRIPT_DIR}/generate-node-catalog.js" "${DATA_DIR}" "${CATALOG_FILE}"
+
+  log_success "Node catalog generated"
+}
+
 # Generate examples
 generate_examples() {
   log_info "Generating Terraform examples..."
@@ -162,8 +178,12 @@ case "${1:-help}" in
     ;;
   generate)
     generate_code
+    generate_catalog
     generate_examples
     ;;
+  catalog)
+    generate_catalog
+    ;;
   stats)
     show_stats
     ;;
@@ -182,6 +202,8 @@ case "${1:-help}" in
     echo ""
     generate_code
     echo ""
+    generate_catalog
+    echo ""
     generate_examples
     echo ""
     log_success "Synchronization completed!"
@@ -200,7 +222,8 @@ case "${1:-help}" in
     echo "  fetch      - Fetch n8n repository"
     echo "  parse      - Parse nodes and generate registry"
     echo "  diff       - Generate changelog from differences"
-    echo "  generate   - Generate Go code and examples"
+    echo "  generate   - Generate Go code, node catalog and examples"
+    echo "  catalog    - Generate the node catalog embedded in the provider"
     echo "  stats      - Display node statistics"
     echo "  all        - Run all steps (default)"
     echo "  clean      - Clean cache directory"
@@ -0,0 +1,268 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/models"
+)
+
+// API key helper settings.
+const (
+	// API_KEY_COMMAND_TIMEOUT bounds the execution of api_key_command.
+	API_KEY_COMMAND_TIMEOUT time.Duration = time.Minute
+
+	// MAX_COMMAND_STDERR_BYTES is the number of stderr bytes reported when api_key_command fails.
+	MAX_COMMAND_STDERR_BYTES int = 1024
+)
+
+// apiKeySourceAttributes are the mutually exclusive attributes providing the API key.
+var apiKeySourceAttributes []string = []string{"api_key", "api_key_file", "api_key_command"}
+
+// apiKeyCommandCache keeps the output of each api_key_command for the life of the provider process,
+// so that the helper runs once even when Terraform configures the provider several times.
+var apiKeyCommandCache = struct {
+	sync.Mutex
+	keys map[string]string
+}{keys: make(map[string]string)}
+
+// resolveAPIKey resolves the API key from the provider configuration.
+// api_key, api_key_file and api_key_command are mutually exclusive; when none is set,
+// the N8N_API_KEY environment variable is used.
+//
+// Params:
+//   - ctx: context bounding the helper command
+//   - config: provider configuration model
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - string: API key, empty when none is configured or on error
+func resolveAPIKey(ctx context.Context, config *models.N8nProviderModel, diags *diag.Diagnostics) string {
+	hasKey := isSetString(config.APIKey)
+	hasFile := isSetString(config.APIKeyFile)
+	hasCommand := !config.APIKeyCommand.IsNull() && !config.APIKeyCommand.IsUnknown()
+
+	// Reject ambiguous configuration.
+	if countTrue(hasKey, hasFile, hasCommand) > 1 {
+		addAPIKeyConflictError(diags)
+		// Return nothing.
+		return ""
+	}
+
+	// Resolve the configured source.
+	switch {
+	case hasKey:
+		// Return inline key.
+		return config.APIKey.ValueString()
+	case hasFile:
+		// Return key read from file.
+		return readAPIKeyFile(config.APIKeyFile.ValueString(), diags)
+	case hasCommand:
+		// Return key printed by the helper.
+		return resolveAPIKeyCommand(ctx, config.APIKeyCommand, diags)
+	default:
+		// Use N8N_API_KEY environment variable if nothing is configured.
+		return getEnvAPIKey()
+	}
+}
+
+// readAPIKeyFile reads the API key from a file, ignoring surrounding whitespace.
+//
+// Params:
+//   - filePath: path of the file holding the key
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - string: API key, empty on error
+func readAPIKeyFile(filePath string, diags *diag.Diagnostics) string {
+	data, err := os.ReadFile(filePath)
+	// Check for error.
+	if err != nil {
+		diags.AddAttributeError(path.Root("api_key_file"), "Unable to Read File", fmt.Sprintf("Could not read API key file: %s", err.Error()))
+		// Return nothing.
+		return ""
+	}
+
+	key := strings.TrimSpace(string(data))
+	// Check for empty value.
+	if key == "" {
+		diags.AddAttributeError(path.Root("api_key_file"), "Empty API Key", fmt.Sprintf("The API key file %s is empty.", filePath))
+	}
+
+	// Return key.
+	return key
+}
+
+// resolveAPIKeyCommand runs the API key helper once per process and returns its output.
+//
+// Params:
+//   - ctx: context bounding the helper command
+//   - value: configured command and arguments
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - string: API key, empty on error
+func resolveAPIKeyCommand(ctx context.Context, value types.List, diags *diag.Diagnostics) string {
+	var argv []string
+	diags.Append(value.ElementsAs(ctx, &argv, false)...)
+	// Check for error.
+	if diags.HasError() {
+		// Return nothing.
+		return ""
+	}
+
+	// The first element is the program to run.
+	if len(argv) == 0 || argv[0] == "" {
+		diags.AddAttributeError(path.Root("api_key_command"), "Invalid API Key Command", "api_key_command must hold at least the program to run.")
+		// Return nothing.
+		return ""
+	}
+
+	cacheKey := strings.Join(argv, "\x00")
+	apiKeyCommandCache.Lock()
+	defer apiKeyCommandCache.Unlock()
+
+	// Reuse the output of a previous run.
+	if key, ok := apiKeyCommandCache.keys[cacheKey]; ok {
+		// Return cached key.
+		return key
+	}
+
+	key, err := runAPIKeyCommand(ctx, argv)
+	// Check for error.
+	if err != nil {
+		diags.AddAttributeError(path.Root("api_key_command"), "API Key Command Failed", err.Error())
+		// Return nothing.
+		return ""
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Resolved API key from api_key_command %q", argv[0]))
+	apiKeyCommandCache.keys[cacheKey] = key
+	// Return key.
+	return key
+}
+
+// runAPIKeyCommand executes the helper and returns its trimmed standard output.
+// The command is run directly, without a shell.
+//
+// Params:
+//   - ctx: context bounding the helper command
+//   - argv: program and arguments
+//
+// Returns:
+//   - string: API key printed by the helper
+//   - error: error if the helper fails, exits non-zero or prints nothing
+func runAPIKeyCommand(ctx context.Context, argv []string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, API_KEY_COMMAND_TIMEOUT)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, argv[0], argv[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	// Check for error.
+	if err != nil {
+		var exitErr *exec.ExitError
+		// Report the exit status and the helper message.
+		if errors.As(err, &exitErr) {
+			// Return error.
+			return "", fmt.Errorf("%s exited with status %d: %s", argv[0], exitErr.ExitCode(), truncateOutput(stderr.String()))
+		}
+		// Return error.
+		return "", fmt.Errorf("could not run %s: %w", argv[0], err)
+	}
+
+	key := strings.TrimSpace(stdout.String())
+	// Check for empty value.
+	if key == "" {
+		// Return error.
+		return "", fmt.Errorf("%s printed no API key on its standard output", argv[0])
+	}
+
+	// Return key.
+	return key, nil
+}
+
+// truncateOutput trims and shortens helper output for diagnostics.
+//
+// Params:
+//   - output: raw output
+//
+// Returns:
+//   - string: output of at most MAX_COMMAND_STDERR_BYTES bytes
+func truncateOutput(output string) string {
+	output = strings.TrimSpace(output)
+	// Check for long output.
+	if len(output) > MAX_COMMAND_STDERR_BYTES {
+		// Return truncated output.
+		return output[:MAX_COMMAND_STDERR_BYTES] + "...(truncated)"
+	}
+	// Check for empty output.
+	if output == "" {
+		// Return placeholder.
+		return "(no output)"
+	}
+	// Return output.
+	return output
+}
+
+// validateAPIKeyConfig checks that at most one API key source is configured before Configure runs.
+// Unknown values are treated as set so conflicts are reported as early as possible.
+//
+// Params:
+//   - config: provider configuration model
+//   - diags: diagnostics for error reporting
+func validateAPIKeyConfig(config *models.N8nProviderModel, diags *diag.Diagnostics) {
+	// Check for conflict.
+	if countTrue(!config.APIKey.IsNull(), !config.APIKeyFile.IsNull(), !config.APIKeyCommand.IsNull()) > 1 {
+		addAPIKeyConflictError(diags)
+	}
+}
+
+// addAPIKeyConflictError reports that several API key sources are configured.
+//
+// Params:
+//   - diags: diagnostics for error reporting
+func addAPIKeyConflictError(diags *diag.Diagnostics) {
+	diags.AddError(
+		"Conflicting API Key Configuration",
+		fmt.Sprintf("Only one of %s can be set.", strings.Join(apiKeySourceAttributes, ", ")),
+	)
+}
+
+// countTrue counts the true values.
+//
+// Params:
+//   - values: values to count
+//
+// Returns:
+//   - int: number of true values
+func countTrue(values ...bool) int {
+	count := 0
+	// Count each true value.
+	for _, value := range values {
+		// Check value.
+		if value {
+			count++
+		}
+	}
+	// Return count.
+	return count
+}
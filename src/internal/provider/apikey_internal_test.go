@@ -0,0 +1,252 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// commandValue builds an api_key_command value.
+func commandValue(argv ...string) types.List {
+	return types.ListValueMust(types.StringType, stringValues(argv))
+}
+
+// stringValues converts strings to attribute values.
+func stringValues(values []string) []attr.Value {
+	result := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+	return result
+}
+
+// Test_resolveAPIKey tests the resolveAPIKey function.
+func Test_resolveAPIKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(t *testing.T, config *models.N8nProviderModel)
+		envValue  string
+		want      string
+		wantErr   string
+	}{
+		{
+			name: "uses api_key",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.APIKey = types.StringValue("inline-key")
+			},
+			envValue: "env-key",
+			want:     "inline-key",
+		},
+		{
+			name: "reads api_key_file",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.APIKeyFile = types.StringValue(writeTestFile(t, "api-key", "file-key\n"))
+			},
+			envValue: "env-key",
+			want:     "file-key",
+		},
+		{
+			name: "runs api_key_command",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.APIKeyCommand = commandValue("sh", "-c", "echo command-key")
+			},
+			envValue: "env-key",
+			want:     "command-key",
+		},
+		{
+			name:      "falls back to N8N_API_KEY",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {},
+			envValue:  "env-key",
+			want:      "env-key",
+		},
+		{
+			name: "falls back to N8N_API_KEY when api_key is empty",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.APIKey = types.StringValue("")
+			},
+			envValue: "env-key",
+			want:     "env-key",
+		},
+		{
+			name: "error case - conflicting sources",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.APIKey = types.StringValue("inline-key")
+				config.APIKeyFile = types.StringValue("api-key")
+			},
+			wantErr: "Conflicting API Key Configuration",
+		},
+		{
+			name: "error case - missing file",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.APIKeyFile = types.StringValue(filepath.Join(t.TempDir(), "missing"))
+			},
+			wantErr: "Unable to Read File",
+		},
+		{
+			name: "error case - empty file",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.APIKeyFile = types.StringValue(writeTestFile(t, "api-key", "  \n"))
+			},
+			wantErr: "Empty API Key",
+		},
+		{
+			name: "error case - empty command",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.APIKeyCommand = commandValue()
+			},
+			wantErr: "Invalid API Key Command",
+		},
+		{
+			name: "error case - command exits non-zero",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.APIKeyCommand = commandValue("sh", "-c", "echo 'permission denied' >&2; exit 3")
+			},
+			wantErr: "API Key Command Failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("N8N_API_KEY", tt.envValue)
+
+			config := &models.N8nProviderModel{}
+			tt.configure(t, config)
+			diags := diag.Diagnostics{}
+
+			got := resolveAPIKey(context.Background(), config, &diags)
+
+			// Check diagnostics.
+			if tt.wantErr != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, tt.wantErr, diags.Errors()[0].Summary())
+				assert.Empty(t, got)
+				return
+			}
+			assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// Test_resolveAPIKeyCommand_Cache tests that the helper runs once per process.
+func Test_resolveAPIKeyCommand_Cache(t *testing.T) {
+	t.Parallel()
+
+	counter := filepath.Join(t.TempDir(), "runs")
+	command := commandValue("sh", "-c", "echo run >> "+counter+"; echo cached-key")
+
+	for i := 0; i < 3; i++ {
+		diags := diag.Diagnostics{}
+		assert.Equal(t, "cached-key", resolveAPIKeyCommand(context.Background(), command, &diags))
+		assert.False(t, diags.HasError())
+	}
+
+	runs, err := os.ReadFile(counter)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(runs), "run"), "api_key_command should run once")
+}
+
+// Test_runAPIKeyCommand tests the runAPIKeyCommand function.
+func Test_runAPIKeyCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		argv    []string
+		want    string
+		wantErr string
+	}{
+		{name: "trims the output", argv: []string{"sh", "-c", "printf '  key-value \\n'"}, want: "key-value"},
+		{name: "error case - reports exit status and stderr", argv: []string{"sh", "-c", "echo 'token expired' >&2; exit 2"}, wantErr: "sh exited with status 2: token expired"},
+		{name: "error case - empty output", argv: []string{"sh", "-c", "true"}, wantErr: "sh printed no API key on its standard output"},
+		{name: "error case - unknown program", argv: []string{filepath.Join(t.TempDir(), "missing-helper")}, wantErr: "could not run"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := runAPIKeyCommand(context.Background(), tt.argv)
+
+			// Check error.
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// Test_validateAPIKeyConfig tests the validateAPIKeyConfig function.
+func Test_validateAPIKeyConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		configure func(config *models.N8nProviderModel)
+		wantErrs  int
+	}{
+		{name: "accepts empty configuration", configure: func(config *models.N8nProviderModel) {}},
+		{name: "accepts a single source", configure: func(config *models.N8nProviderModel) {
+			config.APIKeyCommand = types.ListUnknown(types.StringType)
+		}},
+		{
+			name: "error case - unknown value conflicts",
+			configure: func(config *models.N8nProviderModel) {
+				config.APIKey = types.StringUnknown()
+				config.APIKeyCommand = commandValue("helper")
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "error case - every source set",
+			configure: func(config *models.N8nProviderModel) {
+				config.APIKey = types.StringValue("key")
+				config.APIKeyFile = types.StringValue("api-key")
+				config.APIKeyCommand = commandValue("helper")
+			},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := &models.N8nProviderModel{}
+			tt.configure(config)
+			diags := diag.Diagnostics{}
+
+			validateAPIKeyConfig(config, &diags)
+
+			assert.Equal(t, tt.wantErrs, diags.ErrorsCount())
+		})
+	}
+}
+
+// Test_truncateOutput tests the truncateOutput function.
+func Test_truncateOutput(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("e", MAX_COMMAND_STDERR_BYTES+10)
+
+	assert.Equal(t, "denied", truncateOutput(" denied\n"))
+	assert.Equal(t, long[:MAX_COMMAND_STDERR_BYTES]+"...(truncated)", truncateOutput(long))
+	assert.Equal(t, "(no output)", truncateOutput("\n"), "error case - empty output")
+}
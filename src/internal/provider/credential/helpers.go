@@ -13,10 +13,13 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/credential/models"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 )
 
 // FLOAT64_BIT_SIZE is the bit size for float64 parsing.
@@ -51,9 +54,12 @@ func (r *CredentialResource) createNewCredential(ctx context.Context, name, cred
 
 	// Check for error during credential creation.
 	if err != nil {
-		diags.AddError(
+		shared.AddAPIAttributeError(
+			diags,
+			path.Root("data"),
 			"Error creating new credential during rotation",
-			fmt.Sprintf("Could not create new credential: %s\nHTTP Response: %v", err.Error(), httpResp),
+			"Could not create new credential",
+			err, httpResp,
 		)
 		// Return nil to indicate failure.
 		return nil
@@ -76,40 +82,28 @@ func (r *CredentialResource) createNewCredential(ctx context.Context, name, cred
 //   - []models.WorkflowBackup: List of workflows using the old credential
 //   - bool: True if scan succeeded, false otherwise
 func (r *CredentialResource) scanAffectedWorkflows(ctx context.Context, oldCredID, newCredID string, diags *diag.Diagnostics) ([]models.WorkflowBackup, bool) {
-	workflowList, httpResp, err := r.client.APIClient.WorkflowAPI.WorkflowsGet(ctx).Execute()
-	// Close response body if present.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
-	// Check for error listing workflows.
-	if err != nil {
-		// Rollback: delete new credential
-		tflog.Error(ctx, "Failed to list workflows, rolling back")
-		r.deleteCredentialBestEffort(ctx, newCredID)
-
-		diags.AddError(
-			"Error scanning workflows during rotation",
-			fmt.Sprintf("Could not list workflows: %s", err.Error()),
-		)
-		// Return empty slice and failure status.
-		return []models.WorkflowBackup{}, false
-	}
-
-	// Find affected workflows
+	// Find affected workflows on every page
 	affectedWorkflows := []models.WorkflowBackup{}
-	// Check if workflow data is available.
-	if workflowList.Data != nil {
-		// Iterate through workflows to find those using the credential.
-		for _, workflow := range workflowList.Data {
-			// Create a copy to avoid loop pointer aliasing
-			wf := workflow
-			// Check if workflow uses the old credential.
-			if usesCredential(&wf, oldCredID) {
-				affectedWorkflows = append(affectedWorkflows, models.WorkflowBackup{
-					ID:       *wf.Id,
-					Original: &wf,
-				})
-			}
+	// Iterate through workflows to find those using the credential.
+	for workflow, err := range client.Paginate(r.client.APIClient.WorkflowAPI.WorkflowsGet(ctx), r.client.PageSize) {
+		// Check for error listing workflows.
+		if err != nil {
+			// Rollback: delete new credential
+			tflog.Error(ctx, "Failed to list workflows, rolling back")
+			r.deleteCredentialBestEffort(ctx, newCredID)
+
+			shared.AddAPIError(diags, "Error scanning workflows during rotation", "Could not list workflows", err, nil)
+			// Return empty slice and failure status.
+			return []models.WorkflowBackup{}, false
+		}
+		// Create a copy to avoid loop pointer aliasing
+		wf := workflow
+		// Check if workflow uses the old credential.
+		if usesCredential(&wf, oldCredID) {
+			affectedWorkflows = append(affectedWorkflows, models.WorkflowBackup{
+				ID:       *wf.Id,
+				Original: &wf,
+			})
 		}
 	}
 
@@ -153,9 +147,20 @@ func (r *CredentialResource) updateAffectedWorkflows(ctx context.Context, affect
 	// Iterate through all affected workflows.
 	for i, backup := range affectedWorkflows {
 		// Throttle to avoid rate limiting
-		// Skip sleep for first iteration.
+		// Skip the wait for first iteration.
 		if i > 0 {
-			time.Sleep(time.Duration(ROTATION_THROTTLE_MILLISECONDS) * time.Millisecond)
+			// Stop waiting once the operation deadline expires.
+			if err := waitRotationThrottle(ctx); err != nil {
+				tflog.Error(ctx, fmt.Sprintf("Rotation stopped before workflow %s, rolling back", backup.ID))
+				r.rollbackRotation(ctx, newCredID, affectedWorkflows, updatedWorkflows)
+
+				diags.AddError(
+					"Error updating workflows during rotation",
+					fmt.Sprintf("Rotation stopped before workflow %s: %s\nRotation rolled back.", backup.ID, err.Error()),
+				)
+				// Return partial results and failure status.
+				return updatedWorkflows, false
+			}
 		}
 
 		// Get fresh workflow data
@@ -192,9 +197,11 @@ func (r *CredentialResource) updateAffectedWorkflows(ctx context.Context, affect
 			tflog.Error(ctx, fmt.Sprintf("Failed to update workflow %s, rolling back", backup.ID))
 			r.rollbackRotation(ctx, newCredID, affectedWorkflows, updatedWorkflows)
 
-			diags.AddError(
+			shared.AddAPIError(
+				diags,
 				"Error updating workflow during rotation",
-				fmt.Sprintf("Could not update workflow %s: %s\nRotation rolled back.", backup.ID, err.Error()),
+				fmt.Sprintf("Could not update workflow %s, rotation rolled back", backup.ID),
+				err, httpResp,
 			)
 			// Return partial results and failure status.
 			return updatedWorkflows, false
@@ -208,6 +215,25 @@ func (r *CredentialResource) updateAffectedWorkflows(ctx context.Context, affect
 	return updatedWorkflows, true
 }
 
+// waitRotationThrottle waits between two workflow updates of a rotation.
+//
+// Params:
+//   - ctx: Context bounding the rotation
+//
+// Returns:
+//   - error: context error when the context is done before the end of the wait
+func waitRotationThrottle(ctx context.Context) error {
+	// Wait for the throttle or the end of the context.
+	select {
+	case <-ctx.Done():
+		// Return context error.
+		return ctx.Err()
+	case <-time.After(time.Duration(ROTATION_THROTTLE_MILLISECONDS) * time.Millisecond):
+		// Return success.
+		return nil
+	}
+}
+
 // deleteCredentialBestEffort attempts to delete a credential but does not fail if unsuccessful.
 // Used for cleanup operations where failure is not critical.
 //
@@ -215,6 +241,10 @@ func (r *CredentialResource) updateAffectedWorkflows(ctx context.Context, affect
 //   - ctx: Context for the API call
 //   - credID: ID of the credential to delete
 func (r *CredentialResource) deleteCredentialBestEffort(ctx context.Context, credID string) {
+	// Detach from the operation deadline so that the cleanup still runs after a timeout.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), ROLLBACK_TIMEOUT)
+	defer cancel()
+
 	_, httpResp, err := r.client.APIClient.CredentialAPI.DeleteCredential(ctx, credID).Execute()
 	// Close response body if present.
 	if httpResp != nil && httpResp.Body != nil {
@@ -408,9 +438,12 @@ func (r *CredentialResource) transferCredentialToProject(ctx context.Context, cr
 
 	// Check for error.
 	if err != nil {
-		diags.AddError(
+		shared.AddAPIAttributeError(
+			diags,
+			path.Root("project_id"),
 			"Error transferring credential to project",
-			fmt.Sprintf("Could not transfer credential ID %s to project %s: %s\nHTTP Response: %v", credentialID, projectID, err.Error(), httpResp),
+			fmt.Sprintf("Could not transfer credential ID %s to project %s", credentialID, projectID),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
@@ -5,13 +5,17 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/credential/models"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestCredentialResource_createNewCredential tests the createNewCredential helper.
@@ -625,6 +629,68 @@ func TestCredentialResource_updateAffectedWorkflows_ErrorCases(t *testing.T) {
 	}
 }
 
+// TestCredentialResource_updateAffectedWorkflows_Deadline tests a deadline expiring between two workflow updates.
+func TestCredentialResource_updateAffectedWorkflows_Deadline(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var requests []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		// Credential deletions answer without body.
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"id":          strings.TrimPrefix(r.URL.Path, "/workflows/"),
+			"name":        "Workflow",
+			"nodes":       []any{map[string]any{"credentials": map[string]any{"api": map[string]any{"id": "old-cred"}}}},
+			"connections": map[string]any{},
+			"settings":    map[string]any{},
+		})
+	})
+	n8nClient, server := setupTestClient(t, handler)
+	defer server.Close()
+
+	backups := make([]models.WorkflowBackup, 0, 2)
+	for _, id := range []string{"wf-1", "wf-2"} {
+		backups = append(backups, models.WorkflowBackup{ID: id, Original: &n8nsdk.Workflow{
+			Id:          strPtr(id),
+			Name:        "Workflow",
+			Nodes:       []n8nsdk.Node{{Credentials: map[string]interface{}{"api": map[string]interface{}{"id": "old-cred"}}}},
+			Connections: map[string]interface{}{},
+			Settings:    n8nsdk.WorkflowSettings{},
+		}})
+	}
+	r := &CredentialResource{client: n8nClient}
+	diags := diag.Diagnostics{}
+	// Expire the deadline during the throttle after the first workflow.
+	timeout := func(context.Context, time.Duration) (time.Duration, diag.Diagnostics) {
+		return time.Duration(ROTATION_THROTTLE_MILLISECONDS) * time.Millisecond / 2, nil
+	}
+
+	ctx, done := shared.WithTimeout(context.Background(), shared.OPERATION_UPDATE, "rotating credential", timeout, &diags)
+	shared.SetTimeoutStep(ctx, "updating workflows")
+	updatedIDs, success := r.updateAffectedWorkflows(ctx, backups, "old-cred", "new-cred", &diags)
+	done()
+
+	assert.False(t, success, "Rotation should stop at the deadline")
+	assert.Equal(t, []string{"wf-1"}, updatedIDs)
+	mu.Lock()
+	assert.NotContains(t, requests, "GET /workflows/wf-2", "The second workflow should not be read")
+	assert.Contains(t, requests, "DELETE /credentials/new-cred", "The new credential should be rolled back")
+	mu.Unlock()
+	errs := diags.Errors()
+	require.Len(t, errs, 2)
+	assert.Contains(t, errs[0].Detail(), "Rotation stopped before workflow wf-2: context deadline exceeded")
+	assert.Equal(t, "Operation Timed Out", errs[1].Summary())
+	assert.Contains(t, errs[1].Detail(), `during step "updating workflows"`)
+}
+
 // mockFailingBody is a mock io.ReadCloser that fails on Close().
 type mockFailingBody struct{}
 
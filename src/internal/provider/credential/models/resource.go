@@ -6,17 +6,22 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Resource describes the resource data model.
 // Maps n8n credential attributes to Terraform schema, storing credential metadata and sensitive data.
+// DataWO is write-only: it is only read from the configuration and is always null in state.
 type Resource struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Type      types.String `tfsdk:"type"`
-	Data      types.Map    `tfsdk:"data"`
-	ProjectID types.String `tfsdk:"project_id"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	ID            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Type          types.String   `tfsdk:"type"`
+	Data          types.Map      `tfsdk:"data"`
+	DataWO        types.Map      `tfsdk:"data_wo"`
+	DataWOVersion types.Int64    `tfsdk:"data_wo_version"`
+	ProjectID     types.String   `tfsdk:"project_id"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	UpdatedAt     types.String   `tfsdk:"updated_at"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
@@ -0,0 +1,30 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+// Package models contains data models for the credential domain.
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SchemaDataSource describes the credential schema data source data model.
+// Maps the JSON schema of an n8n credential type to Terraform attributes.
+type SchemaDataSource struct {
+	CredentialType       types.String  `tfsdk:"credential_type"`
+	Fields               []SchemaField `tfsdk:"fields"`
+	Required             types.List    `tfsdk:"required"`
+	AdditionalProperties types.Bool    `tfsdk:"additional_properties"`
+	SchemaJSON           types.String  `tfsdk:"schema_json"`
+}
+
+// SchemaField describes a single field of a credential type schema.
+type SchemaField struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Required    types.Bool   `tfsdk:"required"`
+	Options     types.List   `tfsdk:"options"`
+	Default     types.String `tfsdk:"default"`
+	Description types.String `tfsdk:"description"`
+}
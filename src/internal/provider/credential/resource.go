@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,18 +19,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/credential/models"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 )
 
 // ROTATION_THROTTLE_MILLISECONDS is the delay between workflow updates during credential rotation.
 const ROTATION_THROTTLE_MILLISECONDS int = 100
 
+// ROLLBACK_TIMEOUT bounds the cleanup of a failed rotation, which runs even after the update deadline.
+const ROLLBACK_TIMEOUT time.Duration = 2 * time.Minute
+
 // Ensure CredentialResource implements required interfaces.
 var (
-	_ resource.Resource                = &CredentialResource{}
-	_ CredentialResourceInterface      = &CredentialResource{}
-	_ resource.ResourceWithConfigure   = &CredentialResource{}
-	_ resource.ResourceWithImportState = &CredentialResource{}
+	_ resource.Resource                   = &CredentialResource{}
+	_ CredentialResourceInterface         = &CredentialResource{}
+	_ resource.ResourceWithConfigure      = &CredentialResource{}
+	_ resource.ResourceWithImportState    = &CredentialResource{}
+	_ resource.ResourceWithModifyPlan     = &CredentialResource{}
+	_ resource.ResourceWithValidateConfig = &CredentialResource{}
 )
 
 // CredentialResourceInterface defines the interface for CredentialResource.
@@ -43,6 +50,8 @@ type CredentialResourceInterface interface {
 	Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse)
 	Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse)
 	ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse)
+	ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse)
+	ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse)
 }
 
 // CredentialResource defines the resource implementation for n8n credentials.
@@ -87,7 +96,7 @@ func (r *CredentialResource) Metadata(_ctx context.Context, req resource.Metadat
 //   - ctx: Context for the request
 //   - req: Schema request
 //   - resp: Schema response
-func (r *CredentialResource) Schema(_ctx context.Context, _req resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *CredentialResource) Schema(ctx context.Context, _req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "n8n credential resource with automatic rotation on update.\n\n" +
 			"**Update Behavior**: When updated, the credential is rotated:\n" +
@@ -95,8 +104,13 @@ func (r *CredentialResource) Schema(_ctx context.Context, _req resource.SchemaRe
 			"2. All workflows using the old credential are updated\n" +
 			"3. Old credential is deleted\n" +
 			"4. If any step fails, automatic rollback is performed\n\n" +
-			"**Note**: The credential ID will change after an update, but this is handled automatically.",
+			"**Note**: The credential ID will change after an update, but this is handled automatically.\n\n" +
+			"**Write-only data**: With Terraform 1.11 and later, set `data_wo` instead of `data` to keep secrets out of the state. " +
+			"Terraform cannot detect changes to `data_wo`: bump `data_wo_version` to rotate the credential.",
 		Attributes: r.schemaAttributes(),
+		Blocks: map[string]schema.Block{
+			"timeouts": shared.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -108,19 +122,28 @@ func (r *CredentialResource) schemaAttributes() map[string]schema.Attribute {
 	// Data attribute description with type conversion info.
 	dataDesc := "Credential data (secrets, passwords, API keys, etc.). " +
 		"String values are automatically converted to the correct type (number, boolean) " +
-		"based on the credential schema."
+		"based on the credential schema. Stored in state: prefer `data_wo`. Exactly one of `data` or `data_wo` must be set."
+	// Write-only data attribute description.
+	dataWODesc := "Write-only credential data, never stored in state (requires Terraform 1.11+). " +
+		"Values are converted like `data`. Changes are only applied when `data_wo_version` changes."
+	// Write-only data version attribute description.
+	dataWOVersionDesc := "Version of `data_wo`. Change it to rotate the credential with the current `data_wo`."
 	// Project ID attribute description.
 	projectDesc := "Project ID to assign the credential to. " +
-		"If not set, credential is created in personal space (General)."
+		"If not set, the provider `default_project_id` is used, or the credential is created in personal space (General)."
 	// Return credential schema attributes.
 	return map[string]schema.Attribute{
-		"id":         schema.StringAttribute{MarkdownDescription: "Credential identifier", Computed: true},
-		"name":       schema.StringAttribute{MarkdownDescription: "Credential name", Required: true},
-		"type":       schema.StringAttribute{MarkdownDescription: "Credential type (e.g., httpHeaderAuth)", Required: true},
-		"data":       schema.MapAttribute{MarkdownDescription: dataDesc, ElementType: types.StringType, Required: true, Sensitive: true},
-		"project_id": schema.StringAttribute{MarkdownDescription: projectDesc, Optional: true, Computed: true},
-		"created_at": schema.StringAttribute{MarkdownDescription: "Timestamp when the credential was created", Computed: true},
-		"updated_at": schema.StringAttribute{MarkdownDescription: "Timestamp when the credential was last updated", Computed: true},
+		"id":   schema.StringAttribute{MarkdownDescription: "Credential identifier", Computed: true},
+		"name": schema.StringAttribute{MarkdownDescription: "Credential name", Required: true},
+		"type": schema.StringAttribute{MarkdownDescription: "Credential type (e.g., httpHeaderAuth)", Required: true},
+		"data": schema.MapAttribute{MarkdownDescription: dataDesc, ElementType: types.StringType, Optional: true, Sensitive: true},
+		"data_wo": schema.MapAttribute{
+			MarkdownDescription: dataWODesc, ElementType: types.StringType, Optional: true, Sensitive: true, WriteOnly: true,
+		},
+		"data_wo_version": schema.Int64Attribute{MarkdownDescription: dataWOVersionDesc, Optional: true},
+		"project_id":      schema.StringAttribute{MarkdownDescription: projectDesc, Optional: true, Computed: true},
+		"created_at":      schema.StringAttribute{MarkdownDescription: "Timestamp when the credential was created", Computed: true},
+		"updated_at":      schema.StringAttribute{MarkdownDescription: "Timestamp when the credential was last updated", Computed: true},
 	}
 }
 
@@ -151,6 +174,35 @@ func (r *CredentialResource) Configure(_ctx context.Context, req resource.Config
 	r.client = clientData
 }
 
+// ModifyPlan plans the project of the credential.
+//
+// Params:
+//   - ctx: Context for the operation
+//   - req: ModifyPlan request containing config, state and plan
+//   - resp: ModifyPlan response to update the plan
+func (r *CredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	shared.ModifyPlanProjectID(ctx, r.client, req, resp)
+}
+
+// ValidateConfig checks that the credential data is set exactly once, in data or data_wo.
+//
+// Params:
+//   - ctx: Context for the operation
+//   - req: ValidateConfig request containing the configuration
+//   - resp: ValidateConfig response collecting diagnostics
+func (r *CredentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.Resource
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	// Check if config read succeeded.
+	if resp.Diagnostics.HasError() {
+		// Return with error.
+		return
+	}
+
+	validateCredentialData(&config, &resp.Diagnostics)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 //
 // Params:
@@ -167,12 +219,22 @@ func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_CREATE, "creating credential", plan.Timeouts.Create, &resp.Diagnostics)
+	defer done()
+
+	// Write-only data is only available in the configuration.
+	if !loadWriteOnlyData(ctx, req.Config, plan, &resp.Diagnostics) {
+		// Return with error.
+		return
+	}
+
 	// Execute create logic
 	if !r.executeCreateLogic(ctx, plan, resp) {
 		// Return with error.
 		return
 	}
 
+	clearWriteOnlyData(plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -220,7 +282,7 @@ func extractCredentialData(ctx context.Context, data types.Map) (map[string]any,
 //   - bool: True if creation succeeded, false otherwise
 func (r *CredentialResource) executeCreateLogic(ctx context.Context, plan *models.Resource, resp *resource.CreateResponse) bool {
 	// Extract credential data from Terraform types
-	credData, diags := extractCredentialData(ctx, plan.Data)
+	credData, diags := extractCredentialData(ctx, credentialDataSource(plan))
 	resp.Diagnostics.Append(diags...)
 	// Check if credential data extraction succeeded.
 	if resp.Diagnostics.HasError() {
@@ -256,9 +318,12 @@ func (r *CredentialResource) executeCreateLogicWithData(ctx context.Context, pla
 
 	// Check if credential creation failed.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIAttributeError(
+			&resp.Diagnostics,
+			path.Root("data"),
 			"Error creating credential",
-			fmt.Sprintf("Could not create credential: %s\nHTTP Response: %v", err.Error(), httpResp),
+			"Could not create credential",
+			err, httpResp,
 		)
 		// Return failure.
 		return false
@@ -346,6 +411,9 @@ func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_READ, "reading credential", state.Timeouts.Read, &resp.Diagnostics)
+	defer done()
+
 	// WORKAROUND: No API call - n8n doesn't support GET /credentials/{id}.
 	tflog.Debug(ctx, fmt.Sprintf(
 		"Read credential %s (state-only, no API verification)",
@@ -375,12 +443,22 @@ func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_UPDATE, "rotating credential", plan.Timeouts.Update, &resp.Diagnostics)
+	defer done()
+
+	// Write-only data is only available in the configuration.
+	if !loadWriteOnlyData(ctx, req.Config, plan, &resp.Diagnostics) {
+		// Return with error.
+		return
+	}
+
 	// Execute update logic
 	if !r.executeUpdateLogic(ctx, plan, state, resp) {
 		// Return with error.
 		return
 	}
 
+	clearWriteOnlyData(plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -397,7 +475,7 @@ func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequ
 //   - bool: True if update succeeded, false otherwise
 func (r *CredentialResource) executeUpdateLogic(ctx context.Context, plan, state *models.Resource, resp *resource.UpdateResponse) bool {
 	// Extract credential data from Terraform types
-	credData, diags := extractCredentialData(ctx, plan.Data)
+	credData, diags := extractCredentialData(ctx, credentialDataSource(plan))
 	resp.Diagnostics.Append(diags...)
 	// Check if credential data extraction succeeded.
 	if resp.Diagnostics.HasError() {
@@ -429,6 +507,7 @@ func (r *CredentialResource) executeUpdateLogicWithData(ctx context.Context, pla
 	convertedData := r.convertDataToSchemaTypes(ctx, plan.Type.ValueString(), credData)
 
 	// STEP 1: Create new credential
+	shared.SetTimeoutStep(ctx, "creating new credential")
 	newCred := r.createNewCredential(ctx, plan.Name.ValueString(), plan.Type.ValueString(), convertedData, &resp.Diagnostics)
 	// Check if new credential creation succeeded.
 	if resp.Diagnostics.HasError() {
@@ -440,6 +519,7 @@ func (r *CredentialResource) executeUpdateLogicWithData(ctx context.Context, pla
 	tflog.Info(ctx, fmt.Sprintf("Created new credential %s", newCredID))
 
 	// STEP 2: Scan workflows using old credential
+	shared.SetTimeoutStep(ctx, "scanning workflows")
 	affectedWorkflows, success := r.scanAffectedWorkflows(ctx, oldCredID, newCredID, &resp.Diagnostics)
 	// Check if workflow scan succeeded.
 	if !success {
@@ -448,6 +528,7 @@ func (r *CredentialResource) executeUpdateLogicWithData(ctx context.Context, pla
 	}
 
 	// STEP 3: Update each workflow
+	shared.SetTimeoutStep(ctx, "updating workflows")
 	updatedWorkflows, success := r.updateAffectedWorkflows(ctx, affectedWorkflows, oldCredID, newCredID, &resp.Diagnostics)
 	// Check if all workflow updates succeeded.
 	if !success {
@@ -456,12 +537,14 @@ func (r *CredentialResource) executeUpdateLogicWithData(ctx context.Context, pla
 	}
 
 	// STEP 4: Delete old credential
+	shared.SetTimeoutStep(ctx, "deleting old credential")
 	r.deleteOldCredential(ctx, oldCredID, newCredID)
 
 	// STEP 5: Handle project assignment if project_id changed or is set.
 	// Note: On update with rotation, the new credential needs to be transferred
 	// to the target project since it was created in personal space.
 	if !plan.ProjectID.IsNull() && !plan.ProjectID.IsUnknown() {
+		shared.SetTimeoutStep(ctx, "transferring credential")
 		// Transfer the new credential to the specified project.
 		if !r.transferCredentialToProject(ctx, newCredID, plan.ProjectID.ValueString(), &resp.Diagnostics) {
 			// Return failure - project assignment failed.
@@ -504,6 +587,9 @@ func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_DELETE, "deleting credential", state.Timeouts.Delete, &resp.Diagnostics)
+	defer done()
+
 	_, httpResp, err := r.client.APIClient.CredentialAPI.DeleteCredential(ctx, state.ID.ValueString()).Execute()
 	// Close HTTP response body if present.
 	if httpResp != nil && httpResp.Body != nil {
@@ -519,9 +605,16 @@ func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	// Check if credential deletion failed.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// The credential was already deleted outside Terraform.
+		if shared.IgnoreNotFound(ctx, err, httpResp, "credential", state.ID.ValueString()) {
+			// Return success.
+			return
+		}
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error deleting credential",
-			fmt.Sprintf("Could not delete credential ID %s: %s\nHTTP Response: %v", state.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not delete credential ID %s", state.ID.ValueString()),
+			err, httpResp,
 		)
 	}
 }
@@ -556,6 +649,10 @@ func (r *CredentialResource) rollbackRotation(
 	affectedWorkflows []models.WorkflowBackup,
 	updatedWorkflows []string,
 ) {
+	// Detach from the operation deadline so that the rollback still runs after a timeout.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), ROLLBACK_TIMEOUT)
+	defer cancel()
+
 	tflog.Error(ctx, "Rolling back credential rotation")
 
 	r.deleteNewCredential(ctx, newCredID)
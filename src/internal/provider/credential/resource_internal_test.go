@@ -1883,13 +1883,16 @@ func TestCredentialResource_Read_CRUD(t *testing.T) {
 					"key": tftypes.NewValue(tftypes.String, "value"),
 				}
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":         tftypes.NewValue(tftypes.String, "cred-123"),
-					"name":       tftypes.NewValue(tftypes.String, "test-credential"),
-					"type":       tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
-					"data":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
-					"project_id": tftypes.NewValue(tftypes.String, nil),
-					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
-					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"id":              tftypes.NewValue(tftypes.String, "cred-123"),
+					"name":            tftypes.NewValue(tftypes.String, "test-credential"),
+					"type":            tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
+					"data":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
+					"data_wo":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"data_wo_version": tftypes.NewValue(tftypes.Number, nil),
+					"project_id":      tftypes.NewValue(tftypes.String, nil),
+					"created_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"updated_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				req := resource.ReadRequest{
@@ -1985,13 +1988,16 @@ func TestCredentialResource_Delete_CRUD(t *testing.T) {
 					"key": tftypes.NewValue(tftypes.String, "value"),
 				}
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":         tftypes.NewValue(tftypes.String, "cred-123"),
-					"name":       tftypes.NewValue(tftypes.String, "test-credential"),
-					"type":       tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
-					"data":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
-					"project_id": tftypes.NewValue(tftypes.String, nil),
-					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
-					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"id":              tftypes.NewValue(tftypes.String, "cred-123"),
+					"name":            tftypes.NewValue(tftypes.String, "test-credential"),
+					"type":            tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
+					"data":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
+					"data_wo":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"data_wo_version": tftypes.NewValue(tftypes.Number, nil),
+					"project_id":      tftypes.NewValue(tftypes.String, nil),
+					"created_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"updated_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				req := resource.DeleteRequest{
@@ -2037,13 +2043,16 @@ func TestCredentialResource_Delete_CRUD(t *testing.T) {
 					"key": tftypes.NewValue(tftypes.String, "value"),
 				}
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":         tftypes.NewValue(tftypes.String, "cred-123"),
-					"name":       tftypes.NewValue(tftypes.String, "test-credential"),
-					"type":       tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
-					"data":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
-					"project_id": tftypes.NewValue(tftypes.String, nil),
-					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
-					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"id":              tftypes.NewValue(tftypes.String, "cred-123"),
+					"name":            tftypes.NewValue(tftypes.String, "test-credential"),
+					"type":            tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
+					"data":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
+					"data_wo":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"data_wo_version": tftypes.NewValue(tftypes.Number, nil),
+					"project_id":      tftypes.NewValue(tftypes.String, nil),
+					"created_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"updated_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				req := resource.DeleteRequest{
@@ -2129,13 +2138,16 @@ func TestCredentialResource_Delete_CRUD(t *testing.T) {
 					"key": tftypes.NewValue(tftypes.String, "value"),
 				}
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":         tftypes.NewValue(tftypes.String, "cred-123"),
-					"name":       tftypes.NewValue(tftypes.String, "test-credential"),
-					"type":       tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
-					"data":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
-					"project_id": tftypes.NewValue(tftypes.String, nil),
-					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
-					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"id":              tftypes.NewValue(tftypes.String, "cred-123"),
+					"name":            tftypes.NewValue(tftypes.String, "test-credential"),
+					"type":            tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
+					"data":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
+					"data_wo":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"data_wo_version": tftypes.NewValue(tftypes.Number, nil),
+					"project_id":      tftypes.NewValue(tftypes.String, nil),
+					"created_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"updated_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				req := resource.DeleteRequest{
@@ -2448,13 +2460,16 @@ func TestCredentialResource_Create_CRUD(t *testing.T) {
 				}
 
 				planRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":         tftypes.NewValue(tftypes.String, nil),
-					"name":       tftypes.NewValue(tftypes.String, "Test Credential"),
-					"type":       tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
-					"data":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
-					"project_id": tftypes.NewValue(tftypes.String, nil),
-					"created_at": tftypes.NewValue(tftypes.String, nil),
-					"updated_at": tftypes.NewValue(tftypes.String, nil),
+					"id":              tftypes.NewValue(tftypes.String, nil),
+					"name":            tftypes.NewValue(tftypes.String, "Test Credential"),
+					"type":            tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
+					"data":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
+					"data_wo":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"data_wo_version": tftypes.NewValue(tftypes.Number, nil),
+					"project_id":      tftypes.NewValue(tftypes.String, nil),
+					"created_at":      tftypes.NewValue(tftypes.String, nil),
+					"updated_at":      tftypes.NewValue(tftypes.String, nil),
+					"timeouts":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				req := resource.CreateRequest{
@@ -2516,13 +2531,16 @@ func TestCredentialResource_Update_CRUD(t *testing.T) {
 				}
 
 				validStateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":         tftypes.NewValue(tftypes.String, "old-cred-123"),
-					"name":       tftypes.NewValue(tftypes.String, "old-cred"),
-					"type":       tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
-					"data":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
-					"project_id": tftypes.NewValue(tftypes.String, nil),
-					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
-					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"id":              tftypes.NewValue(tftypes.String, "old-cred-123"),
+					"name":            tftypes.NewValue(tftypes.String, "old-cred"),
+					"type":            tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
+					"data":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
+					"data_wo":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"data_wo_version": tftypes.NewValue(tftypes.Number, nil),
+					"project_id":      tftypes.NewValue(tftypes.String, nil),
+					"created_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"updated_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				req := resource.UpdateRequest{
@@ -2570,13 +2588,16 @@ func TestCredentialResource_Update_CRUD(t *testing.T) {
 				}
 
 				validPlanRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":         tftypes.NewValue(tftypes.String, "old-cred-123"),
-					"name":       tftypes.NewValue(tftypes.String, "updated-cred"),
-					"type":       tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
-					"data":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
-					"project_id": tftypes.NewValue(tftypes.String, nil),
-					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
-					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"id":              tftypes.NewValue(tftypes.String, "old-cred-123"),
+					"name":            tftypes.NewValue(tftypes.String, "updated-cred"),
+					"type":            tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
+					"data":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
+					"data_wo":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"data_wo_version": tftypes.NewValue(tftypes.Number, nil),
+					"project_id":      tftypes.NewValue(tftypes.String, nil),
+					"created_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"updated_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				stateRaw := tftypes.NewValue(tftypes.String, "invalid")
@@ -2637,23 +2658,29 @@ func TestCredentialResource_Update_CRUD(t *testing.T) {
 				}
 
 				planRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":         tftypes.NewValue(tftypes.String, "old-cred-123"),
-					"name":       tftypes.NewValue(tftypes.String, "updated-cred"),
-					"type":       tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
-					"data":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
-					"project_id": tftypes.NewValue(tftypes.String, nil),
-					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
-					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"id":              tftypes.NewValue(tftypes.String, "old-cred-123"),
+					"name":            tftypes.NewValue(tftypes.String, "updated-cred"),
+					"type":            tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
+					"data":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
+					"data_wo":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"data_wo_version": tftypes.NewValue(tftypes.Number, nil),
+					"project_id":      tftypes.NewValue(tftypes.String, nil),
+					"created_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"updated_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":         tftypes.NewValue(tftypes.String, "old-cred-123"),
-					"name":       tftypes.NewValue(tftypes.String, "old-cred"),
-					"type":       tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
-					"data":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
-					"project_id": tftypes.NewValue(tftypes.String, nil),
-					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
-					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"id":              tftypes.NewValue(tftypes.String, "old-cred-123"),
+					"name":            tftypes.NewValue(tftypes.String, "old-cred"),
+					"type":            tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
+					"data":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
+					"data_wo":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"data_wo_version": tftypes.NewValue(tftypes.Number, nil),
+					"project_id":      tftypes.NewValue(tftypes.String, nil),
+					"created_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"updated_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				req := resource.UpdateRequest{
@@ -2731,23 +2758,29 @@ func TestCredentialResource_Update_CRUD(t *testing.T) {
 				}
 
 				planRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":         tftypes.NewValue(tftypes.String, "old-cred-123"),
-					"name":       tftypes.NewValue(tftypes.String, "updated-cred"),
-					"type":       tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
-					"data":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
-					"project_id": tftypes.NewValue(tftypes.String, nil),
-					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
-					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"id":              tftypes.NewValue(tftypes.String, "old-cred-123"),
+					"name":            tftypes.NewValue(tftypes.String, "updated-cred"),
+					"type":            tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
+					"data":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
+					"data_wo":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"data_wo_version": tftypes.NewValue(tftypes.Number, nil),
+					"project_id":      tftypes.NewValue(tftypes.String, nil),
+					"created_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"updated_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":         tftypes.NewValue(tftypes.String, "old-cred-123"),
-					"name":       tftypes.NewValue(tftypes.String, "old-cred"),
-					"type":       tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
-					"data":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
-					"project_id": tftypes.NewValue(tftypes.String, nil),
-					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
-					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"id":              tftypes.NewValue(tftypes.String, "old-cred-123"),
+					"name":            tftypes.NewValue(tftypes.String, "old-cred"),
+					"type":            tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
+					"data":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataMap),
+					"data_wo":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"data_wo_version": tftypes.NewValue(tftypes.Number, nil),
+					"project_id":      tftypes.NewValue(tftypes.String, nil),
+					"created_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"updated_at":      tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				req := resource.UpdateRequest{
@@ -0,0 +1,351 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package credential
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/credential/models"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+)
+
+// Ensure CredentialSchemaDataSource implements required interfaces.
+var (
+	_ datasource.DataSource               = &CredentialSchemaDataSource{}
+	_ CredentialSchemaDataSourceInterface = &CredentialSchemaDataSource{}
+	_ datasource.DataSourceWithConfigure  = &CredentialSchemaDataSource{}
+)
+
+// CredentialSchemaDataSourceInterface defines the interface for CredentialSchemaDataSource.
+type CredentialSchemaDataSourceInterface interface {
+	datasource.DataSource
+	Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse)
+	Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse)
+	Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse)
+	Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse)
+}
+
+// CredentialSchemaDataSource is a Terraform datasource that exposes the schema of an n8n credential type.
+// It lets modules validate credential data and document it before creating an n8n_credential.
+type CredentialSchemaDataSource struct {
+	// client is the N8n API client used for operations.
+	client *client.N8nClient
+}
+
+// NewCredentialSchemaDataSource creates a new CredentialSchemaDataSource instance.
+//
+// Returns:
+//   - *CredentialSchemaDataSource: A new CredentialSchemaDataSource instance
+func NewCredentialSchemaDataSource() *CredentialSchemaDataSource {
+	// Return result.
+	return &CredentialSchemaDataSource{}
+}
+
+// NewCredentialSchemaDataSourceWrapper creates a new CredentialSchemaDataSource instance for Terraform.
+// This wrapper function is used by the provider to maintain compatibility with the framework.
+//
+// Returns:
+//   - datasource.DataSource: the wrapped CredentialSchemaDataSource instance
+func NewCredentialSchemaDataSourceWrapper() datasource.DataSource {
+	// Return the wrapped datasource instance.
+	return NewCredentialSchemaDataSource()
+}
+
+// Metadata returns the data source type name.
+//
+// Params:
+//   - ctx: The request context
+//   - req: The metadata request containing provider type information
+//   - resp: The metadata response to populate with type name
+func (d *CredentialSchemaDataSource) Metadata(_ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_schema"
+}
+
+// Schema defines the schema for the data source.
+//
+// Params:
+//   - ctx: The request context
+//   - req: The schema request from Terraform
+//   - resp: The schema response to populate
+func (d *CredentialSchemaDataSource) Schema(_ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the schema of an n8n credential type, listing its fields with their types, " +
+			"options and defaults. Use it to validate inputs or document a module before creating an `n8n_credential`. " +
+			"The schema holds no secrets.",
+
+		Attributes: map[string]schema.Attribute{
+			"credential_type": schema.StringAttribute{
+				MarkdownDescription: "Credential type name (e.g., httpHeaderAuth)",
+				Required:            true,
+			},
+			"fields": schema.ListNestedAttribute{
+				MarkdownDescription: "Fields of the credential type, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: d.fieldAttributes(),
+				},
+			},
+			"required": schema.ListAttribute{
+				MarkdownDescription: "Names of the fields that are always required",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"additional_properties": schema.BoolAttribute{
+				MarkdownDescription: "Whether fields not listed in the schema are accepted",
+				Computed:            true,
+			},
+			"schema_json": schema.StringAttribute{
+				MarkdownDescription: "Complete JSON schema, including conditional requirements",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// fieldAttributes returns the attribute definitions of a credential schema field.
+//
+// Returns:
+//   - map[string]schema.Attribute: the field attribute definitions
+func (d *CredentialSchemaDataSource) fieldAttributes() map[string]schema.Attribute {
+	// Return field attributes.
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Field name, used as key in `data` or `data_wo`",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "JSON schema type of the field (string, number, boolean, ...)",
+			Computed:            true,
+		},
+		"required": schema.BoolAttribute{
+			MarkdownDescription: "Whether the field is always required",
+			Computed:            true,
+		},
+		"options": schema.ListAttribute{
+			MarkdownDescription: "Allowed values, when the field is an enumeration",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"default": schema.StringAttribute{
+			MarkdownDescription: "Default value as a string, like the values of `data`",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Field description",
+			Computed:            true,
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+//
+// Params:
+//   - ctx: The request context
+//   - req: The configure request containing provider data
+//   - resp: The configure response to handle errors
+func (d *CredentialSchemaDataSource) Configure(_ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Check for nil provider data.
+	if req.ProviderData == nil {
+		// Return result.
+		return
+	}
+
+	clientData, ok := req.ProviderData.(*client.N8nClient)
+	// Check if provider data is correct type.
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.N8nClient, got: %T", req.ProviderData),
+		)
+		// Return result.
+		return
+	}
+
+	d.client = clientData
+}
+
+// Read fetches the credential type schema.
+//
+// Params:
+//   - ctx: The request context
+//   - req: The read request containing configuration
+//   - resp: The read response to populate with state
+func (d *CredentialSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := &models.SchemaDataSource{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, data)...)
+	// If there are errors from config parsing, return early.
+	if resp.Diagnostics.HasError() {
+		// Return with error.
+		return
+	}
+
+	credType := data.CredentialType.ValueString()
+	credSchema, httpResp, err := d.client.APIClient.CredentialAPI.
+		CredentialsSchemaCredentialTypeNameGet(ctx, credType).
+		Execute()
+	// Close HTTP response body if present.
+	if httpResp != nil && httpResp.Body != nil {
+		defer httpResp.Body.Close()
+	}
+	// Check if API call returned an error.
+	if err != nil {
+		shared.AddAPIError(
+			&resp.Diagnostics,
+			"Error retrieving credential schema",
+			fmt.Sprintf("Could not retrieve schema of credential type %s", credType),
+			err, httpResp,
+		)
+		// Return with error.
+		return
+	}
+
+	mapCredentialSchemaToModel(ctx, credSchema, data, &resp.Diagnostics)
+	// Check for mapping errors.
+	if resp.Diagnostics.HasError() {
+		// Return with error.
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// mapCredentialSchemaToModel maps a credential type JSON schema to the data source model.
+//
+// Params:
+//   - ctx: The request context
+//   - credSchema: The JSON schema returned by the n8n API
+//   - data: The data source model to update
+//   - diags: Diagnostics for error reporting
+func mapCredentialSchemaToModel(ctx context.Context, credSchema map[string]any, data *models.SchemaDataSource, diags *diag.Diagnostics) {
+	required := schemaStrings(credSchema["required"])
+	properties, _ := credSchema["properties"].(map[string]any)
+
+	names := make([]string, 0, len(properties))
+	// Collect property names.
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data.Fields = make([]models.SchemaField, 0, len(names))
+	// Map each property.
+	for _, name := range names {
+		property, _ := properties[name].(map[string]any)
+		data.Fields = append(data.Fields, mapSchemaField(ctx, name, property, slices.Contains(required, name), diags))
+	}
+
+	requiredList, listDiags := types.ListValueFrom(ctx, types.StringType, required)
+	diags.Append(listDiags...)
+	data.Required = requiredList
+
+	additional, ok := credSchema["additionalProperties"].(bool)
+	// JSON schema accepts additional properties by default.
+	data.AdditionalProperties = types.BoolValue(!ok || additional)
+
+	schemaJSON, err := json.Marshal(credSchema)
+	// Check for encoding error.
+	if err != nil {
+		diags.AddError("Invalid credential schema", fmt.Sprintf("Could not encode credential schema: %s", err.Error()))
+		// Return with error.
+		return
+	}
+	data.SchemaJSON = types.StringValue(string(schemaJSON))
+}
+
+// mapSchemaField maps a JSON schema property to a credential schema field.
+//
+// Params:
+//   - ctx: The request context
+//   - name: The property name
+//   - property: The property JSON schema
+//   - required: Whether the property is required
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - models.SchemaField: The mapped field
+func mapSchemaField(ctx context.Context, name string, property map[string]any, required bool, diags *diag.Diagnostics) models.SchemaField {
+	field := models.SchemaField{
+		Name:        types.StringValue(name),
+		Type:        types.StringNull(),
+		Required:    types.BoolValue(required),
+		Options:     types.ListNull(types.StringType),
+		Default:     types.StringNull(),
+		Description: types.StringNull(),
+	}
+
+	// Set type if available.
+	if fieldType, ok := property["type"].(string); ok {
+		field.Type = types.StringValue(fieldType)
+	}
+	// Set options if available.
+	if enum, ok := property["enum"].([]any); ok {
+		options, listDiags := types.ListValueFrom(ctx, types.StringType, schemaStrings(enum))
+		diags.Append(listDiags...)
+		field.Options = options
+	}
+	// Set default if available.
+	if defaultValue, ok := property["default"]; ok && defaultValue != nil {
+		field.Default = types.StringValue(schemaValueString(defaultValue))
+	}
+	// Set description if available.
+	if description, ok := property["description"].(string); ok {
+		field.Description = types.StringValue(description)
+	}
+
+	// Return field.
+	return field
+}
+
+// schemaStrings converts a JSON schema array to strings.
+//
+// Params:
+//   - value: The JSON array, or nil
+//
+// Returns:
+//   - []string: The values converted to strings
+func schemaStrings(value any) []string {
+	items, _ := value.([]any)
+	result := make([]string, 0, len(items))
+	// Convert each item.
+	for _, item := range items {
+		result = append(result, schemaValueString(item))
+	}
+	// Return strings.
+	return result
+}
+
+// schemaValueString converts a JSON value to the string form used in credential data.
+// Strings are kept as-is, other values are JSON encoded (e.g., true, 993).
+//
+// Params:
+//   - value: The JSON value
+//
+// Returns:
+//   - string: The string form of the value
+func schemaValueString(value any) string {
+	// Keep strings as-is.
+	if s, ok := value.(string); ok {
+		// Return string.
+		return s
+	}
+	encoded, err := json.Marshal(value)
+	// Fall back to the Go format.
+	if err != nil {
+		// Return formatted value.
+		return fmt.Sprint(value)
+	}
+	// Return encoded value.
+	return string(encoded)
+}
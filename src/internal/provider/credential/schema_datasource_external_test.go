@@ -0,0 +1,122 @@
+package credential_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/credential"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/credential/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialSchemaDataSource_Metadata tests the Metadata method.
+func TestCredentialSchemaDataSource_Metadata(t *testing.T) {
+	t.Parallel()
+
+	d := credential.NewCredentialSchemaDataSourceWrapper()
+	resp := &datasource.MetadataResponse{}
+
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "n8n"}, resp)
+
+	assert.Equal(t, "n8n_credential_schema", resp.TypeName)
+}
+
+// TestCredentialSchemaDataSource_Read tests the Read method.
+func TestCredentialSchemaDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr bool
+	}{
+		{
+			name:   "schema is mapped",
+			status: http.StatusOK,
+			body: `{"additionalProperties":false,"type":"object","required":["user","host"],"properties":{` +
+				`"user":{"type":"string"},"host":{"type":"string","description":"Server host"},` +
+				`"port":{"type":"number","default":993},"secure":{"type":"boolean","default":true},` +
+				`"mode":{"type":"string","enum":["plain","tls"]}}}`,
+		},
+		{
+			name:    "error case - unknown credential type",
+			status:  http.StatusNotFound,
+			body:    `{"message":"Not Found"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/credentials/schema/imap", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+			n8nClient, server := setupTestClient(t, handler)
+			defer server.Close()
+
+			d := credential.NewCredentialSchemaDataSource()
+			d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: n8nClient}, &datasource.ConfigureResponse{})
+
+			ctx := context.Background()
+			schemaResp := &datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+			schemaType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			fieldsType := schemaType.AttributeTypes["fields"]
+			config := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"credential_type":       tftypes.NewValue(tftypes.String, "imap"),
+				"fields":                tftypes.NewValue(fieldsType, nil),
+				"required":              tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"additional_properties": tftypes.NewValue(tftypes.Bool, nil),
+				"schema_json":           tftypes.NewValue(tftypes.String, nil),
+			})
+
+			req := datasource.ReadRequest{Config: tfsdk.Config{Raw: config, Schema: schemaResp.Schema}}
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, req, resp)
+
+			// Check for error.
+			if tt.wantErr {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "Read should succeed: %v", resp.Diagnostics)
+
+			var state models.SchemaDataSource
+			require.False(t, resp.State.Get(ctx, &state).HasError())
+			assert.False(t, state.AdditionalProperties.ValueBool())
+			assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("user"), types.StringValue("host")}), state.Required)
+			require.Len(t, state.Fields, 5)
+
+			fields := make(map[string]models.SchemaField, len(state.Fields))
+			names := make([]string, 0, len(state.Fields))
+			for _, field := range state.Fields {
+				fields[field.Name.ValueString()] = field
+				names = append(names, field.Name.ValueString())
+			}
+			assert.Equal(t, []string{"host", "mode", "port", "secure", "user"}, names)
+			assert.True(t, fields["host"].Required.ValueBool())
+			assert.Equal(t, "Server host", fields["host"].Description.ValueString())
+			assert.False(t, fields["port"].Required.ValueBool())
+			assert.Equal(t, "number", fields["port"].Type.ValueString())
+			assert.Equal(t, "993", fields["port"].Default.ValueString())
+			assert.Equal(t, "true", fields["secure"].Default.ValueString())
+			assert.True(t, fields["user"].Default.IsNull())
+			assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("plain"), types.StringValue("tls")}), fields["mode"].Options)
+			assert.True(t, fields["user"].Options.IsNull())
+			assert.Contains(t, state.SchemaJSON.ValueString(), `"required":["user","host"]`)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package credential
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/credential/models"
+)
+
+// validateCredentialData checks that exactly one of data and data_wo is set,
+// and that data_wo_version is only set along with data_wo.
+// Unknown values count as set.
+//
+// Params:
+//   - config: The resource configuration
+//   - diags: Diagnostics for error reporting
+func validateCredentialData(config *models.Resource, diags *diag.Diagnostics) {
+	hasData := !config.Data.IsNull()
+	hasDataWO := !config.DataWO.IsNull()
+
+	// Check for conflict.
+	if hasData && hasDataWO {
+		diags.AddAttributeError(
+			path.Root("data_wo"),
+			"Conflicting Credential Data",
+			"Only one of data or data_wo can be set.",
+		)
+		// Return early.
+		return
+	}
+	// Check for missing data.
+	if !hasData && !hasDataWO {
+		diags.AddAttributeError(
+			path.Root("data"),
+			"Missing Credential Data",
+			"One of data or data_wo must be set. Prefer data_wo (Terraform 1.11+) to keep secrets out of the state.",
+		)
+		// Return early.
+		return
+	}
+	// Check that the version accompanies write-only data.
+	if !config.DataWOVersion.IsNull() && !hasDataWO {
+		diags.AddAttributeError(
+			path.Root("data_wo_version"),
+			"Invalid Credential Data Version",
+			"data_wo_version can only be set along with data_wo.",
+		)
+	}
+}
+
+// loadWriteOnlyData reads data_wo from the configuration into the plan.
+// Write-only values are always null in the plan and are only sent in the configuration.
+//
+// Params:
+//   - ctx: Context for the request
+//   - config: The resource configuration
+//   - plan: The planned resource data to update
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - bool: True if the configuration was read, false otherwise
+func loadWriteOnlyData(ctx context.Context, config tfsdk.Config, plan *models.Resource, diags *diag.Diagnostics) bool {
+	// Plain data does not need the configuration.
+	if !plan.Data.IsNull() {
+		// Return success.
+		return true
+	}
+
+	var dataWO types.Map
+	diags.Append(config.GetAttribute(ctx, path.Root("data_wo"), &dataWO)...)
+	// Check if config read succeeded.
+	if diags.HasError() {
+		// Return failure.
+		return false
+	}
+	plan.DataWO = dataWO
+
+	// Return success.
+	return true
+}
+
+// clearWriteOnlyData nullifies data_wo so that it is never stored in state.
+//
+// Params:
+//   - plan: The resource data about to be stored
+func clearWriteOnlyData(plan *models.Resource) {
+	plan.DataWO = types.MapNull(types.StringType)
+}
+
+// credentialDataSource returns the attribute holding the credential data: data, or data_wo when data is not set.
+//
+// Params:
+//   - plan: The planned resource data
+//
+// Returns:
+//   - types.Map: The credential data
+func credentialDataSource(plan *models.Resource) types.Map {
+	// Prefer plain data when set.
+	if !plan.Data.IsNull() {
+		// Return plain data.
+		return plan.Data
+	}
+
+	// Return write-only data.
+	return plan.DataWO
+}
@@ -0,0 +1,169 @@
+package credential
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/credential/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeOnlyTestValue builds a credential resource value using data_wo.
+func writeOnlyTestValue(t *testing.T, ctx context.Context, schemaResp *resource.SchemaResponse, dataWO tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	// Return value.
+	return tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, nil),
+		"name":            tftypes.NewValue(tftypes.String, "Test Credential"),
+		"type":            tftypes.NewValue(tftypes.String, "httpHeaderAuth"),
+		"data":            tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		"data_wo":         dataWO,
+		"data_wo_version": tftypes.NewValue(tftypes.Number, 1),
+		"project_id":      tftypes.NewValue(tftypes.String, nil),
+		"created_at":      tftypes.NewValue(tftypes.String, nil),
+		"updated_at":      tftypes.NewValue(tftypes.String, nil),
+		"timeouts":        tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
+	})
+}
+
+func Test_validateCredentialData(t *testing.T) {
+	t.Parallel()
+
+	data := types.MapValueMust(types.StringType, map[string]attr.Value{"value": types.StringValue("secret")})
+	noData := types.MapNull(types.StringType)
+
+	tests := []struct {
+		name     string
+		config   models.Resource
+		wantErr  bool
+		wantPath path.Path
+	}{
+		{
+			name:   "data only",
+			config: models.Resource{Data: data, DataWO: noData, DataWOVersion: types.Int64Null()},
+		},
+		{
+			name:   "write-only data with version",
+			config: models.Resource{Data: noData, DataWO: data, DataWOVersion: types.Int64Value(1)},
+		},
+		{
+			name:   "unknown write-only data",
+			config: models.Resource{Data: noData, DataWO: types.MapUnknown(types.StringType), DataWOVersion: types.Int64Null()},
+		},
+		{
+			name:     "error case - both data and data_wo",
+			config:   models.Resource{Data: data, DataWO: data, DataWOVersion: types.Int64Null()},
+			wantErr:  true,
+			wantPath: path.Root("data_wo"),
+		},
+		{
+			name:     "error case - no data",
+			config:   models.Resource{Data: noData, DataWO: noData, DataWOVersion: types.Int64Null()},
+			wantErr:  true,
+			wantPath: path.Root("data"),
+		},
+		{
+			name:     "error case - version without data_wo",
+			config:   models.Resource{Data: data, DataWO: noData, DataWOVersion: types.Int64Value(1)},
+			wantErr:  true,
+			wantPath: path.Root("data_wo_version"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			validateCredentialData(&tt.config, &diags)
+
+			// Check for success.
+			if !tt.wantErr {
+				assert.False(t, diags.HasError())
+				return
+			}
+			require.Len(t, diags.Errors(), 1)
+			withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantPath, withPath.Path())
+		})
+	}
+}
+
+func Test_credentialDataSource(t *testing.T) {
+	t.Parallel()
+
+	data := types.MapValueMust(types.StringType, map[string]attr.Value{"value": types.StringValue("plain")})
+	dataWO := types.MapValueMust(types.StringType, map[string]attr.Value{"value": types.StringValue("write-only")})
+
+	assert.Equal(t, data, credentialDataSource(&models.Resource{Data: data, DataWO: types.MapNull(types.StringType)}))
+	assert.Equal(t, dataWO, credentialDataSource(&models.Resource{Data: types.MapNull(types.StringType), DataWO: dataWO}))
+}
+
+func TestCredentialResource_Create_WriteOnlyData(t *testing.T) {
+	t.Parallel()
+
+	var sentData map[string]any
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Handle schema request (type conversion feature)
+		if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/credentials/schema/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPost && r.URL.Path == "/credentials" {
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			sentData, _ = body["data"].(map[string]any)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id":"cred-123","name":"Test Credential","type":"httpHeaderAuth","createdAt":"2024-01-01T00:00:00Z","updatedAt":"2024-01-01T00:00:00Z"}`))
+			return
+		}
+		t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+
+	n8nClient, server := setupTestClient(t, handler)
+	defer server.Close()
+
+	r := &CredentialResource{client: n8nClient}
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	secret := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"value": tftypes.NewValue(tftypes.String, "s3cr3t"),
+	})
+	// Terraform sends write-only values in the configuration only.
+	req := resource.CreateRequest{
+		Config: tfsdk.Config{Raw: writeOnlyTestValue(t, ctx, schemaResp, secret), Schema: schemaResp.Schema},
+		Plan: tfsdk.Plan{
+			Raw:    writeOnlyTestValue(t, ctx, schemaResp, tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)),
+			Schema: schemaResp.Schema,
+		},
+	}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Create(ctx, req, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Create should succeed: %v", resp.Diagnostics)
+	assert.Equal(t, map[string]any{"value": "s3cr3t"}, sentData)
+
+	var state models.Resource
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "cred-123", state.ID.ValueString())
+	assert.True(t, state.Data.IsNull(), "data must not be stored")
+	assert.True(t, state.DataWO.IsNull(), "data_wo must never be stored")
+	assert.Equal(t, int64(1), state.DataWOVersion.ValueInt64())
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+)
+
+// detectInstance probes the version and licensed features of the configured instance.
+// Probe failures are reported as warnings: the instance may not be running yet when
+// Terraform creates it in the same run, and the API stays the source of truth.
+//
+// Params:
+//   - ctx: context for the probes
+//   - n8nClient: configured client
+//   - diags: diagnostics for warning reporting
+//
+// Returns:
+//   - *client.InstanceInfo: detected information, never nil
+func detectInstance(ctx context.Context, n8nClient *client.N8nClient, diags *diag.Diagnostics) *client.InstanceInfo {
+	info, failures := client.DetectInstance(ctx, n8nClient.BaseURL, n8nClient.APIClient)
+	tflog.Info(ctx, fmt.Sprintf("Detected n8n instance: %s, features: %v", info, info.Features))
+
+	// Nothing to report when every probe answered.
+	if len(failures) == 0 {
+		// Return information.
+		return info
+	}
+
+	messages := make([]string, 0, len(failures))
+	// Collect failure messages.
+	for _, failure := range failures {
+		messages = append(messages, failure.Error())
+	}
+
+	// Distinguish an unreachable instance from a partial detection.
+	if len(failures) == 1 && errors.Is(failures[0], client.ErrInstanceUnreachable) {
+		diags.AddWarning(
+			"Unable to Reach n8n Instance",
+			fmt.Sprintf("The provider could not reach %s to detect its version and licensed features: %s. "+
+				"Feature checks are skipped during plan.", n8nClient.BaseURL, messages[0]),
+		)
+		// Return information.
+		return info
+	}
+
+	diags.AddWarning(
+		"Incomplete n8n Instance Detection",
+		fmt.Sprintf("Some features of %s could not be detected and are not checked during plan:\n- %s",
+			n8nClient.BaseURL, strings.Join(messages, "\n- ")),
+	)
+	// Return information.
+	return info
+}
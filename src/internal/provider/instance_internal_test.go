@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_detectInstance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		status      int
+		settings    string
+		closed      bool
+		wantVersion string
+		wantWarning string
+	}{
+		{
+			name:        "detects every feature from settings",
+			status:      http.StatusOK,
+			settings:    `{"data":{"versionCli":"1.64.0","enterprise":{"variables":true,"projects":{"team":{"limit":-1}}}}}`,
+			wantVersion: "1.64.0",
+		},
+		{
+			name:        "error case - features left undetected",
+			status:      http.StatusInternalServerError,
+			wantWarning: "Incomplete n8n Instance Detection",
+		},
+		{
+			name:        "error case - unreachable instance",
+			closed:      true,
+			wantWarning: "Unable to Reach n8n Instance",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.settings))
+			}))
+			// Simulate an instance that is not running.
+			if tt.closed {
+				server.Close()
+			}
+			defer server.Close()
+
+			n8nClient := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{})
+			diags := diag.Diagnostics{}
+
+			info := detectInstance(context.Background(), n8nClient, &diags)
+
+			require.NotNil(t, info)
+			assert.Equal(t, tt.wantVersion, info.Version)
+			assert.False(t, diags.HasError(), "detection failures should only warn")
+			// Check warnings.
+			if tt.wantWarning == "" {
+				assert.Empty(t, diags.Warnings())
+				return
+			}
+			require.Len(t, diags.Warnings(), 1)
+			assert.Equal(t, tt.wantWarning, diags.Warnings()[0].Summary())
+		})
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/models"
+)
+
+// FLOAT64_BIT_SIZE is the bit size used when parsing float64 environment values.
+const FLOAT64_BIT_SIZE int = 64
+
+// buildClientOptions resolves the HTTP client options from the provider configuration.
+// Configuration attributes take precedence over environment variables; unset
+// attributes keep the values from client.DefaultClientOptions.
+//
+// Params:
+//   - config: provider configuration model
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - client.ClientOptions: resolved client options
+func buildClientOptions(config *models.N8nProviderModel, diags *diag.Diagnostics) client.ClientOptions {
+	opts := client.DefaultClientOptions()
+
+	// Override retry count when configured.
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		opts.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	opts.RetryWaitMin = resolveDuration(config.RetryWaitMin, "retry_wait_min", opts.RetryWaitMin, diags)
+	opts.RetryWaitMax = resolveDuration(config.RetryWaitMax, "retry_wait_max", opts.RetryWaitMax, diags)
+
+	opts.RequestsPerSecond = resolveRequestsPerSecond(config.RequestsPerSecond, diags)
+	opts.MaxConcurrentRequests = resolveMaxConcurrentRequests(config.MaxConcurrentRequests, diags)
+	opts.TLSConfig = buildTLSConfig(config, diags)
+	opts.Headers = resolveHeaders(config.Headers, diags)
+	opts.ProxyURL = resolveProxyURL(config.ProxyURL, diags)
+	opts.ReadOnly = resolveReadOnly(config.ReadOnly, diags)
+
+	// Raise the default maximum to a greater configured minimum.
+	if opts.RetryWaitMin > opts.RetryWaitMax && !isConfiguredString(config.RetryWaitMax) {
+		opts.RetryWaitMax = opts.RetryWaitMin
+	}
+
+	// Reject inconsistent backoff bounds.
+	if opts.RetryWaitMin > opts.RetryWaitMax {
+		diags.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid Retry Configuration",
+			"retry_wait_min must be lower than or equal to retry_wait_max.",
+		)
+	}
+
+	// Return resolved options.
+	return opts
+}
+
+// isConfiguredString checks whether a string attribute is set to a non-empty value.
+//
+// Params:
+//   - value: configured attribute value
+//
+// Returns:
+//   - bool: true if the attribute is set
+func isConfiguredString(value types.String) bool {
+	// Return result.
+	return !value.IsNull() && !value.IsUnknown() && value.ValueString() != ""
+}
+
+// resolveDuration parses a duration attribute, falling back to a default when unset.
+//
+// Params:
+//   - value: configured attribute value
+//   - attribute: attribute name used for diagnostics
+//   - fallback: value used when the attribute is unset
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - time.Duration: resolved duration
+func resolveDuration(value types.String, attribute string, fallback time.Duration, diags *diag.Diagnostics) time.Duration {
+	// Keep the default when unset.
+	if !isConfiguredString(value) {
+		// Return fallback.
+		return fallback
+	}
+
+	duration, err := parseDuration(value.ValueString())
+	// Check for error.
+	if err != nil {
+		diags.AddAttributeError(path.Root(attribute), "Invalid Duration", err.Error())
+		// Return fallback.
+		return fallback
+	}
+
+	// Return parsed duration.
+	return duration
+}
+
+// resolveRequestsPerSecond resolves the request rate limit from config or N8N_REQUESTS_PER_SECOND.
+//
+// Params:
+//   - value: configured attribute value
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - float64: requests per second, 0 when unlimited
+func resolveRequestsPerSecond(value types.Float64, diags *diag.Diagnostics) float64 {
+	// Prefer the provider configuration.
+	if !value.IsNull() && !value.IsUnknown() {
+		// Return configured value.
+		return value.ValueFloat64()
+	}
+
+	raw := getEnvRequestsPerSecond()
+	// Unlimited when the environment does not set it.
+	if raw == "" {
+		// Return unlimited.
+		return 0
+	}
+
+	rate, err := strconv.ParseFloat(raw, FLOAT64_BIT_SIZE)
+	// Check for invalid or negative value.
+	if err != nil || rate < 0 {
+		diags.AddError(
+			"Invalid N8N_REQUESTS_PER_SECOND",
+			fmt.Sprintf("N8N_REQUESTS_PER_SECOND must be a non-negative number, got: %q", raw),
+		)
+		// Return unlimited.
+		return 0
+	}
+
+	// Return rate from environment.
+	return rate
+}
+
+// resolveMaxConcurrentRequests resolves the concurrency cap from config or N8N_MAX_CONCURRENT_REQUESTS.
+//
+// Params:
+//   - value: configured attribute value
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - int: maximum in-flight requests, 0 when unlimited
+func resolveMaxConcurrentRequests(value types.Int64, diags *diag.Diagnostics) int {
+	// Prefer the provider configuration.
+	if !value.IsNull() && !value.IsUnknown() {
+		// Return configured value.
+		return int(value.ValueInt64())
+	}
+
+	raw := getEnvMaxConcurrentRequests()
+	// Unlimited when the environment does not set it.
+	if raw == "" {
+		// Return unlimited.
+		return 0
+	}
+
+	limit, err := strconv.Atoi(raw)
+	// Check for invalid or negative value.
+	if err != nil || limit < 0 {
+		diags.AddError(
+			"Invalid N8N_MAX_CONCURRENT_REQUESTS",
+			fmt.Sprintf("N8N_MAX_CONCURRENT_REQUESTS must be a non-negative integer, got: %q", raw),
+		)
+		// Return unlimited.
+		return 0
+	}
+
+	// Return limit from environment.
+	return limit
+}
+
+// resolveReadOnly resolves the read-only flag from config or N8N_READ_ONLY.
+//
+// Params:
+//   - value: configured attribute value
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - bool: true when mutating requests must be refused
+func resolveReadOnly(value types.Bool, diags *diag.Diagnostics) bool {
+	// Prefer the provider configuration.
+	if !value.IsNull() && !value.IsUnknown() {
+		// Return configured value.
+		return value.ValueBool()
+	}
+
+	raw := getEnvReadOnly()
+	// Writes are allowed when the environment does not set it.
+	if raw == "" {
+		// Return default.
+		return false
+	}
+
+	readOnly, err := strconv.ParseBool(raw)
+	// Check for invalid value.
+	if err != nil {
+		diags.AddError(
+			"Invalid N8N_READ_ONLY",
+			fmt.Sprintf("N8N_READ_ONLY must be a boolean, got: %q", raw),
+		)
+		// Return default.
+		return false
+	}
+
+	// Return flag from environment.
+	return readOnly
+}
+
+// resolveHeaders converts the configured custom headers to a plain map.
+//
+// Params:
+//   - value: configured headers attribute
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - map[string]string: custom headers, nil when unset
+func resolveHeaders(value types.Map, diags *diag.Diagnostics) map[string]string {
+	// No custom headers when unset.
+	if value.IsNull() || value.IsUnknown() {
+		// Return nothing.
+		return nil
+	}
+
+	headers := make(map[string]string, len(value.Elements()))
+	diags.Append(value.ElementsAs(context.Background(), &headers, false)...)
+
+	// Return headers.
+	return headers
+}
+
+// resolveProxyURL parses the configured proxy URL.
+//
+// Params:
+//   - value: configured proxy_url attribute
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - *url.URL: proxy URL, nil to use the proxy environment variables
+func resolveProxyURL(value types.String, diags *diag.Diagnostics) *url.URL {
+	// Fall back to HTTP_PROXY/HTTPS_PROXY when unset.
+	if !isSetString(value) {
+		// Return nothing.
+		return nil
+	}
+
+	proxyURL, err := parseProxyURL(value.ValueString())
+	// Check for error.
+	if err != nil {
+		diags.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL", err.Error())
+		// Return nothing.
+		return nil
+	}
+
+	// Return parsed URL.
+	return proxyURL
+}
+
+// buildDefaultTags creates the default tag resolver from the default_tags block.
+//
+// Params:
+//   - ctx: context for the conversion
+//   - block: default_tags block, nil when not configured
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - *client.DefaultTags: resolver, nil when no default tag is configured
+func buildDefaultTags(ctx context.Context, block *models.DefaultTagsModel, diags *diag.Diagnostics) *client.DefaultTags {
+	// No default tags without block or names.
+	if block == nil || block.Names.IsNull() || block.Names.IsUnknown() {
+		// Return nothing.
+		return nil
+	}
+
+	var names []string
+	diags.Append(block.Names.ElementsAs(ctx, &names, false)...)
+	// Check for conversion errors.
+	if diags.HasError() {
+		// Return nothing.
+		return nil
+	}
+
+	// Return resolver.
+	return client.NewDefaultTags(names)
+}
+
+// buildCommunityNodeAllowlist reads the node types and packages skipped by the node catalog validation.
+//
+// Params:
+//   - ctx: context for the conversion
+//   - value: community_node_allowlist attribute
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - []string: allowlisted node types and packages, nil when unset
+func buildCommunityNodeAllowlist(ctx context.Context, value types.Set, diags *diag.Diagnostics) []string {
+	// No allowlist when unset.
+	if value.IsNull() || value.IsUnknown() {
+		// Return nothing.
+		return nil
+	}
+
+	var allowlist []string
+	diags.Append(value.ElementsAs(ctx, &allowlist, false)...)
+	// Check for conversion errors.
+	if diags.HasError() {
+		// Return nothing.
+		return nil
+	}
+
+	// Return allowlist.
+	return allowlist
+}
@@ -0,0 +1,417 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/models"
+)
+
+// PROFILE_CONFIG_PATH is the location of the profiles file, relative to the user configuration directory.
+const PROFILE_CONFIG_PATH string = "n8n/credentials.toml"
+
+// profileConfig holds the provider settings of a named profile.
+// Pointer fields distinguish unset keys from zero values.
+type profileConfig struct {
+	BaseURL               *string           `toml:"base_url"`
+	APIKey                *string           `toml:"api_key"`
+	APIKeyFile            *string           `toml:"api_key_file"`
+	APIKeyCommand         []string          `toml:"api_key_command"`
+	DefaultProjectID      *string           `toml:"default_project_id"`
+	MaxRetries            *int64            `toml:"max_retries"`
+	RetryWaitMin          *string           `toml:"retry_wait_min"`
+	RetryWaitMax          *string           `toml:"retry_wait_max"`
+	RequestsPerSecond     *float64          `toml:"requests_per_second"`
+	MaxConcurrentRequests *int64            `toml:"max_concurrent_requests"`
+	PageSize              *int64            `toml:"page_size"`
+	CACertPEM             *string           `toml:"ca_cert_pem"`
+	CACertFile            *string           `toml:"ca_cert_file"`
+	ClientCertPEM         *string           `toml:"client_cert_pem"`
+	ClientCertFile        *string           `toml:"client_cert_file"`
+	ClientKeyPEM          *string           `toml:"client_key_pem"`
+	ClientKeyFile         *string           `toml:"client_key_file"`
+	InsecureSkipVerify    *bool             `toml:"insecure_skip_verify"`
+	Headers               map[string]string `toml:"headers"`
+	ProxyURL              *string           `toml:"proxy_url"`
+	ReadOnly              *bool             `toml:"read_only"`
+}
+
+// applyProfile fills the attributes missing from the provider configuration with the selected profile.
+// The profile is selected by the profile attribute or N8N_PROFILE; nothing is loaded otherwise.
+// Attributes set in the provider block win over the environment variables, which win over the profile.
+//
+// Params:
+//   - ctx: context for the profile validation
+//   - config: provider configuration model, updated in place
+//   - diags: diagnostics for error reporting
+func applyProfile(ctx context.Context, config *models.N8nProviderModel, diags *diag.Diagnostics) {
+	name := config.Profile.ValueString()
+	// Use N8N_PROFILE environment variable if not set in config
+	if name == "" {
+		name = getEnvProfile()
+	}
+	// Nothing to load without profile.
+	if name == "" {
+		// Return early.
+		return
+	}
+
+	configFile := config.ConfigFile.ValueString()
+	// Use N8N_CONFIG_FILE environment variable if not set in config
+	if configFile == "" {
+		configFile = getEnvConfigFile()
+	}
+	// Use the default location otherwise.
+	if configFile == "" {
+		configFile = defaultProfileConfigFile()
+	}
+
+	profile, err := loadProfile(expandHome(configFile), name)
+	// Check for error.
+	if err != nil {
+		diags.AddAttributeError(path.Root("profile"), "Unable to Load Profile", err.Error())
+		// Return early.
+		return
+	}
+
+	configured := *config
+	dropEnvOverrides(profile)
+	mergeProfile(config, profile)
+	validateProfile(ctx, name, &configured, config, diags)
+}
+
+// loadProfile reads a named profile from a TOML profiles file.
+// Unknown keys are rejected to surface typos.
+//
+// Params:
+//   - configFile: path of the profiles file
+//   - name: profile name
+//
+// Returns:
+//   - *profileConfig: profile settings
+//   - error: error if the file cannot be read or the profile is missing or invalid
+func loadProfile(configFile, name string) (*profileConfig, error) {
+	var profiles map[string]profileConfig
+	meta, err := toml.DecodeFile(configFile, &profiles)
+	// Check for error.
+	if err != nil {
+		// Return error.
+		return nil, fmt.Errorf("could not read profiles file %s: %w", configFile, err)
+	}
+
+	// Reject unknown keys.
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		keys := make([]string, 0, len(undecoded))
+		// Collect each unknown key.
+		for _, key := range undecoded {
+			keys = append(keys, key.String())
+		}
+		// Return error.
+		return nil, fmt.Errorf("profiles file %s has unknown keys: %s", configFile, strings.Join(keys, ", "))
+	}
+
+	profile, ok := profiles[name]
+	// Check for missing profile.
+	if !ok {
+		names := make([]string, 0, len(profiles))
+		// Collect available profiles.
+		for available := range profiles {
+			names = append(names, available)
+		}
+		sort.Strings(names)
+		// Return error.
+		return nil, fmt.Errorf("profile %q not found in %s (available: %s)", name, configFile, strings.Join(names, ", "))
+	}
+
+	// Return profile.
+	return &profile, nil
+}
+
+// dropEnvOverrides clears the profile settings whose environment variable is set,
+// so that the environment variables take precedence over the profile.
+//
+// Params:
+//   - profile: profile settings, updated in place
+func dropEnvOverrides(profile *profileConfig) {
+	// Check N8N_API_URL.
+	if getEnvBaseURL() != "" {
+		profile.BaseURL = nil
+	}
+	// N8N_API_KEY replaces every API key source of the profile.
+	if getEnvAPIKey() != "" {
+		profile.APIKey = nil
+		profile.APIKeyFile = nil
+		profile.APIKeyCommand = nil
+	}
+	// Check N8N_PROJECT_ID.
+	if getEnvProjectID() != "" {
+		profile.DefaultProjectID = nil
+	}
+	// Check N8N_REQUESTS_PER_SECOND.
+	if getEnvRequestsPerSecond() != "" {
+		profile.RequestsPerSecond = nil
+	}
+	// Check N8N_MAX_CONCURRENT_REQUESTS.
+	if getEnvMaxConcurrentRequests() != "" {
+		profile.MaxConcurrentRequests = nil
+	}
+	// Check N8N_READ_ONLY.
+	if getEnvReadOnly() != "" {
+		profile.ReadOnly = nil
+	}
+}
+
+// mergeProfile copies the profile settings into the configuration attributes that are not set.
+// Mutually exclusive attributes are only taken from the profile when none of them is configured,
+// so that the provider block can switch an API key or certificate source without conflict.
+//
+// Params:
+//   - config: provider configuration model, updated in place
+//   - profile: profile settings
+func mergeProfile(config *models.N8nProviderModel, profile *profileConfig) {
+	mergeString(&config.BaseURL, profile.BaseURL)
+	mergeString(&config.DefaultProjectID, profile.DefaultProjectID)
+	mergeString(&config.RetryWaitMin, profile.RetryWaitMin)
+	mergeString(&config.RetryWaitMax, profile.RetryWaitMax)
+	mergeString(&config.ProxyURL, profile.ProxyURL)
+
+	// Check for unset value.
+	if config.MaxRetries.IsNull() && profile.MaxRetries != nil {
+		config.MaxRetries = types.Int64Value(*profile.MaxRetries)
+	}
+	// Check for unset value.
+	if config.RequestsPerSecond.IsNull() && profile.RequestsPerSecond != nil {
+		config.RequestsPerSecond = types.Float64Value(*profile.RequestsPerSecond)
+	}
+	// Check for unset value.
+	if config.MaxConcurrentRequests.IsNull() && profile.MaxConcurrentRequests != nil {
+		config.MaxConcurrentRequests = types.Int64Value(*profile.MaxConcurrentRequests)
+	}
+	// Check for unset value.
+	if config.PageSize.IsNull() && profile.PageSize != nil {
+		config.PageSize = types.Int64Value(*profile.PageSize)
+	}
+	// Check for unset value.
+	if config.InsecureSkipVerify.IsNull() && profile.InsecureSkipVerify != nil {
+		config.InsecureSkipVerify = types.BoolValue(*profile.InsecureSkipVerify)
+	}
+	// Check for unset value.
+	if config.ReadOnly.IsNull() && profile.ReadOnly != nil {
+		config.ReadOnly = types.BoolValue(*profile.ReadOnly)
+	}
+	// Check for unset value.
+	if config.Headers.IsNull() && profile.Headers != nil {
+		headers := make(map[string]attr.Value, len(profile.Headers))
+		// Convert each header.
+		for name, value := range profile.Headers {
+			headers[name] = types.StringValue(value)
+		}
+		config.Headers = types.MapValueMust(types.StringType, headers)
+	}
+
+	// The API key sources are mutually exclusive.
+	if config.APIKey.IsNull() && config.APIKeyFile.IsNull() && config.APIKeyCommand.IsNull() {
+		mergeString(&config.APIKey, profile.APIKey)
+		mergeString(&config.APIKeyFile, profile.APIKeyFile)
+		// Check for configured command.
+		if profile.APIKeyCommand != nil {
+			argv := make([]attr.Value, 0, len(profile.APIKeyCommand))
+			// Convert each argument.
+			for _, arg := range profile.APIKeyCommand {
+				argv = append(argv, types.StringValue(arg))
+			}
+			config.APIKeyCommand = types.ListValueMust(types.StringType, argv)
+		}
+	}
+
+	// Each inline certificate conflicts with its file variant.
+	mergeStringPair(&config.CACertPEM, &config.CACertFile, profile.CACertPEM, profile.CACertFile)
+	mergeStringPair(&config.ClientCertPEM, &config.ClientCertFile, profile.ClientCertPEM, profile.ClientCertFile)
+	mergeStringPair(&config.ClientKeyPEM, &config.ClientKeyFile, profile.ClientKeyPEM, profile.ClientKeyFile)
+}
+
+// validateProfile checks the settings merged from a profile with the validators of the provider attributes.
+// Errors are reported against the profile attribute, since the values are not set in the provider block.
+//
+// Params:
+//   - ctx: context for the validators
+//   - name: profile name
+//   - configured: provider configuration before the merge
+//   - merged: provider configuration after the merge
+//   - diags: diagnostics for error reporting
+func validateProfile(ctx context.Context, name string, configured, merged *models.N8nProviderModel, diags *diag.Diagnostics) {
+	var profileDiags diag.Diagnostics
+	validateProfileString(ctx, "retry_wait_min", configured.RetryWaitMin, merged.RetryWaitMin, durationValidator{}, &profileDiags)
+	validateProfileString(ctx, "retry_wait_max", configured.RetryWaitMax, merged.RetryWaitMax, durationValidator{}, &profileDiags)
+	validateProfileInt64(ctx, "max_retries", configured.MaxRetries, merged.MaxRetries, nonNegativeInt64Validator{}, &profileDiags)
+	validateProfileFloat64(ctx, "requests_per_second", configured.RequestsPerSecond, merged.RequestsPerSecond, nonNegativeFloat64Validator{}, &profileDiags)
+	validateProfileInt64(ctx, "max_concurrent_requests", configured.MaxConcurrentRequests, merged.MaxConcurrentRequests, nonNegativeInt64Validator{}, &profileDiags)
+	validateProfileInt64(ctx, "page_size", configured.PageSize, merged.PageSize, pageSizeValidator{}, &profileDiags)
+	validateProfileString(ctx, "ca_cert_pem", configured.CACertPEM, merged.CACertPEM, certificatePEMValidator{}, &profileDiags)
+	validateProfileString(ctx, "ca_cert_file", configured.CACertFile, merged.CACertFile, certificatePEMValidator{fromFile: true}, &profileDiags)
+	validateProfileString(ctx, "client_cert_pem", configured.ClientCertPEM, merged.ClientCertPEM, certificatePEMValidator{}, &profileDiags)
+	validateProfileString(ctx, "client_cert_file", configured.ClientCertFile, merged.ClientCertFile, certificatePEMValidator{fromFile: true}, &profileDiags)
+	validateProfileString(ctx, "client_key_pem", configured.ClientKeyPEM, merged.ClientKeyPEM, privateKeyPEMValidator{}, &profileDiags)
+	validateProfileString(ctx, "client_key_file", configured.ClientKeyFile, merged.ClientKeyFile, privateKeyPEMValidator{fromFile: true}, &profileDiags)
+	validateProfileString(ctx, "proxy_url", configured.ProxyURL, merged.ProxyURL, proxyURLValidator{}, &profileDiags)
+	// Check for merged headers.
+	if configured.Headers.IsNull() && !merged.Headers.IsNull() {
+		resp := &validator.MapResponse{}
+		headersValidator{}.ValidateMap(ctx, validator.MapRequest{Path: path.Root("headers"), ConfigValue: merged.Headers}, resp)
+		profileDiags.Append(resp.Diagnostics...)
+	}
+
+	// Report each error against the profile.
+	for _, d := range profileDiags.Errors() {
+		key := ""
+		// Name the profile key of the value.
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			key = withPath.Path().String()
+		}
+		diags.AddAttributeError(
+			path.Root("profile"),
+			d.Summary(),
+			fmt.Sprintf("Profile %q sets an invalid %s: %s", name, key, d.Detail()),
+		)
+	}
+}
+
+// validateProfileString validates a string attribute merged from a profile.
+//
+// Params:
+//   - ctx: context for the validator
+//   - key: attribute name
+//   - configured: attribute value before the merge
+//   - merged: attribute value after the merge
+//   - v: attribute validator
+//   - diags: diagnostics for error reporting
+func validateProfileString(ctx context.Context, key string, configured, merged types.String, v validator.String, diags *diag.Diagnostics) {
+	// Skip values set in the provider block.
+	if !configured.IsNull() || merged.IsNull() {
+		// Return early.
+		return
+	}
+	resp := &validator.StringResponse{}
+	v.ValidateString(ctx, validator.StringRequest{Path: path.Root(key), ConfigValue: merged}, resp)
+	diags.Append(resp.Diagnostics...)
+}
+
+// validateProfileInt64 validates an int64 attribute merged from a profile.
+//
+// Params:
+//   - ctx: context for the validator
+//   - key: attribute name
+//   - configured: attribute value before the merge
+//   - merged: attribute value after the merge
+//   - v: attribute validator
+//   - diags: diagnostics for error reporting
+func validateProfileInt64(ctx context.Context, key string, configured, merged types.Int64, v validator.Int64, diags *diag.Diagnostics) {
+	// Skip values set in the provider block.
+	if !configured.IsNull() || merged.IsNull() {
+		// Return early.
+		return
+	}
+	resp := &validator.Int64Response{}
+	v.ValidateInt64(ctx, validator.Int64Request{Path: path.Root(key), ConfigValue: merged}, resp)
+	diags.Append(resp.Diagnostics...)
+}
+
+// validateProfileFloat64 validates a float64 attribute merged from a profile.
+//
+// Params:
+//   - ctx: context for the validator
+//   - key: attribute name
+//   - configured: attribute value before the merge
+//   - merged: attribute value after the merge
+//   - v: attribute validator
+//   - diags: diagnostics for error reporting
+func validateProfileFloat64(ctx context.Context, key string, configured, merged types.Float64, v validator.Float64, diags *diag.Diagnostics) {
+	// Skip values set in the provider block.
+	if !configured.IsNull() || merged.IsNull() {
+		// Return early.
+		return
+	}
+	resp := &validator.Float64Response{}
+	v.ValidateFloat64(ctx, validator.Float64Request{Path: path.Root(key), ConfigValue: merged}, resp)
+	diags.Append(resp.Diagnostics...)
+}
+
+// mergeString sets an unset string attribute from a profile value.
+//
+// Params:
+//   - target: configured attribute
+//   - value: profile value, nil when unset
+func mergeString(target *types.String, value *string) {
+	// Check for unset attribute.
+	if target.IsNull() && value != nil {
+		*target = types.StringValue(*value)
+	}
+}
+
+// mergeStringPair sets an inline/file attribute pair from the profile when neither is configured.
+//
+// Params:
+//   - inline: configured inline attribute
+//   - file: configured file attribute
+//   - inlineValue: profile inline value
+//   - fileValue: profile file value
+func mergeStringPair(inline, file *types.String, inlineValue, fileValue *string) {
+	// Keep the configured source.
+	if !inline.IsNull() || !file.IsNull() {
+		// Return early.
+		return
+	}
+	mergeString(inline, inlineValue)
+	mergeString(file, fileValue)
+}
+
+// defaultProfileConfigFile returns the default profiles file, under XDG_CONFIG_HOME or ~/.config.
+//
+// Returns:
+//   - string: path of the profiles file
+func defaultProfileConfigFile() string {
+	// Honor the XDG base directory.
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		// Return XDG location.
+		return filepath.Join(dir, PROFILE_CONFIG_PATH)
+	}
+	// Return home location.
+	return filepath.Join("~", ".config", PROFILE_CONFIG_PATH)
+}
+
+// expandHome replaces a leading "~" with the home directory of the user.
+//
+// Params:
+//   - filePath: path to expand
+//
+// Returns:
+//   - string: expanded path, unchanged when it does not start with "~"
+func expandHome(filePath string) string {
+	// Only expand the home prefix.
+	if filePath != "~" && !strings.HasPrefix(filePath, "~"+string(filepath.Separator)) && !strings.HasPrefix(filePath, "~/") {
+		// Return path unchanged.
+		return filePath
+	}
+	home, err := os.UserHomeDir()
+	// Check for error.
+	if err != nil {
+		// Return path unchanged.
+		return filePath
+	}
+	// Return expanded path.
+	return filepath.Join(home, filePath[1:])
+}
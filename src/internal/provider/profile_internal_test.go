@@ -0,0 +1,287 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testProfiles is a profiles file with a development and a production instance.
+const testProfiles string = `
+[dev]
+base_url = "https://dev.n8n.example.com"
+api_key = "dev-key"
+default_project_id = "project-dev"
+max_retries = 5
+requests_per_second = 2.5
+read_only = false
+headers = { "CF-Access-Client-Id" = "dev-client" }
+
+[prod]
+base_url = "https://n8n.example.com"
+api_key_command = ["vault", "kv", "get", "-field=key", "secret/n8n"]
+proxy_url = "http://proxy.example.com:3128"
+read_only = true
+`
+
+// Test_applyProfile tests the applyProfile function.
+func Test_applyProfile(t *testing.T) {
+	tests := []struct {
+		name       string
+		profiles   string
+		configure  func(config *models.N8nProviderModel)
+		envProfile string
+		check      func(t *testing.T, config *models.N8nProviderModel)
+		wantErr    string
+	}{
+		{
+			name:     "fills unset attributes from the profile",
+			profiles: testProfiles,
+			configure: func(config *models.N8nProviderModel) {
+				config.Profile = types.StringValue("dev")
+			},
+			check: func(t *testing.T, config *models.N8nProviderModel) {
+				assert.Equal(t, "https://dev.n8n.example.com", config.BaseURL.ValueString())
+				assert.Equal(t, "dev-key", config.APIKey.ValueString())
+				assert.Equal(t, "project-dev", config.DefaultProjectID.ValueString())
+				assert.Equal(t, int64(5), config.MaxRetries.ValueInt64())
+				assert.InDelta(t, 2.5, config.RequestsPerSecond.ValueFloat64(), 0)
+				assert.False(t, config.ReadOnly.ValueBool())
+				assert.Equal(t, types.StringValue("dev-client"), config.Headers.Elements()["CF-Access-Client-Id"])
+			},
+		},
+		{
+			name:     "keeps attributes set in the provider block",
+			profiles: testProfiles,
+			configure: func(config *models.N8nProviderModel) {
+				config.Profile = types.StringValue("dev")
+				config.BaseURL = types.StringValue("https://override.example.com")
+				config.APIKeyFile = types.StringValue("/run/secrets/n8n")
+			},
+			check: func(t *testing.T, config *models.N8nProviderModel) {
+				assert.Equal(t, "https://override.example.com", config.BaseURL.ValueString())
+				assert.True(t, config.APIKey.IsNull(), "profile api_key should not conflict with api_key_file")
+				assert.Equal(t, "project-dev", config.DefaultProjectID.ValueString())
+			},
+		},
+		{
+			name:       "selects the profile from N8N_PROFILE",
+			profiles:   testProfiles,
+			configure:  func(config *models.N8nProviderModel) {},
+			envProfile: "prod",
+			check: func(t *testing.T, config *models.N8nProviderModel) {
+				var argv []string
+				config.APIKeyCommand.ElementsAs(t.Context(), &argv, false)
+				assert.Equal(t, "https://n8n.example.com", config.BaseURL.ValueString())
+				assert.Equal(t, []string{"vault", "kv", "get", "-field=key", "secret/n8n"}, argv)
+				assert.Equal(t, "http://proxy.example.com:3128", config.ProxyURL.ValueString())
+				assert.True(t, config.ReadOnly.ValueBool())
+			},
+		},
+		{
+			name:     "keeps the configured certificate source",
+			profiles: "[prod]\nca_cert_file = \"/etc/ssl/n8n-ca.pem\"\n",
+			configure: func(config *models.N8nProviderModel) {
+				config.Profile = types.StringValue("prod")
+				config.CACertPEM = types.StringValue("pem")
+			},
+			check: func(t *testing.T, config *models.N8nProviderModel) {
+				assert.True(t, config.CACertFile.IsNull(), "profile ca_cert_file should not conflict with ca_cert_pem")
+			},
+		},
+		{
+			name:      "loads nothing without profile",
+			profiles:  "not toml",
+			configure: func(config *models.N8nProviderModel) {},
+			check: func(t *testing.T, config *models.N8nProviderModel) {
+				assert.True(t, config.BaseURL.IsNull())
+			},
+		},
+		{
+			name:     "error case - unknown profile",
+			profiles: testProfiles,
+			configure: func(config *models.N8nProviderModel) {
+				config.Profile = types.StringValue("staging")
+			},
+			wantErr: `profile "staging" not found`,
+		},
+		{
+			name:     "error case - unknown key",
+			profiles: "[dev]\nbase_ulr = \"https://dev.n8n.example.com\"\n",
+			configure: func(config *models.N8nProviderModel) {
+				config.Profile = types.StringValue("dev")
+			},
+			wantErr: "unknown keys: dev.base_ulr",
+		},
+		{
+			name:     "error case - page size out of range",
+			profiles: "[dev]\npage_size = 500\n",
+			configure: func(config *models.N8nProviderModel) {
+				config.Profile = types.StringValue("dev")
+			},
+			wantErr: `Profile "dev" sets an invalid page_size`,
+		},
+		{
+			name:     "error case - negative requests per second",
+			profiles: "[dev]\nrequests_per_second = -1.0\n",
+			configure: func(config *models.N8nProviderModel) {
+				config.Profile = types.StringValue("dev")
+			},
+			wantErr: `Profile "dev" sets an invalid requests_per_second`,
+		},
+		{
+			name:     "error case - negative max concurrent requests",
+			profiles: "[dev]\nmax_concurrent_requests = -2\n",
+			configure: func(config *models.N8nProviderModel) {
+				config.Profile = types.StringValue("dev")
+			},
+			wantErr: `Profile "dev" sets an invalid max_concurrent_requests`,
+		},
+		{
+			name:     "error case - invalid file",
+			profiles: "[dev\n",
+			configure: func(config *models.N8nProviderModel) {
+				config.Profile = types.StringValue("dev")
+			},
+			wantErr: "could not read profiles file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("N8N_PROFILE", tt.envProfile)
+			t.Setenv("N8N_CONFIG_FILE", writeTestFile(t, "credentials.toml", tt.profiles))
+
+			config := &models.N8nProviderModel{}
+			tt.configure(config)
+			diags := diag.Diagnostics{}
+
+			applyProfile(context.Background(), config, &diags)
+
+			// Check diagnostics.
+			if tt.wantErr != "" {
+				require.True(t, diags.HasError())
+				assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+				return
+			}
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			tt.check(t, config)
+		})
+	}
+}
+
+// Test_applyProfile_DefaultLocation tests that profiles are read from XDG_CONFIG_HOME by default.
+func Test_applyProfile_DefaultLocation(t *testing.T) {
+	configHome := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(configHome, "n8n"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(configHome, PROFILE_CONFIG_PATH), []byte(testProfiles), 0o600))
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	t.Setenv("N8N_CONFIG_FILE", "")
+	t.Setenv("N8N_PROFILE", "")
+
+	config := &models.N8nProviderModel{Profile: types.StringValue("dev")}
+	diags := diag.Diagnostics{}
+
+	applyProfile(context.Background(), config, &diags)
+
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	assert.Equal(t, "https://dev.n8n.example.com", config.BaseURL.ValueString())
+}
+
+// Test_defaultProfileConfigFile tests the defaultProfileConfigFile function.
+func Test_defaultProfileConfigFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	assert.Equal(t, filepath.Join("/xdg", PROFILE_CONFIG_PATH), defaultProfileConfigFile())
+
+	t.Setenv("XDG_CONFIG_HOME", "")
+	assert.Equal(t, filepath.Join("~", ".config", PROFILE_CONFIG_PATH), defaultProfileConfigFile())
+}
+
+// Test_expandHome tests the expandHome function.
+func Test_expandHome(t *testing.T) {
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		filePath string
+		want     string
+	}{
+		{name: "expands the home prefix", filePath: "~/.config/n8n/credentials.toml", want: filepath.Join(home, ".config/n8n/credentials.toml")},
+		{name: "keeps absolute paths", filePath: "/etc/n8n/credentials.toml", want: "/etc/n8n/credentials.toml"},
+		{name: "error case - keeps other users' homes", filePath: "~alice/credentials.toml", want: "~alice/credentials.toml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, expandHome(tt.filePath))
+		})
+	}
+}
+
+// Test_dropEnvOverrides tests that environment variables take precedence over the profile.
+func Test_dropEnvOverrides(t *testing.T) {
+	baseURL, apiKey, projectID := "https://profile.example.com", "profile-key", "profile-project"
+	readOnly := true
+
+	tests := []struct {
+		name  string
+		env   map[string]string
+		check func(t *testing.T, profile *profileConfig)
+	}{
+		{
+			name: "keeps the profile without environment variables",
+			env:  map[string]string{},
+			check: func(t *testing.T, profile *profileConfig) {
+				assert.Equal(t, &baseURL, profile.BaseURL)
+				assert.Equal(t, &apiKey, profile.APIKey)
+				assert.Equal(t, &projectID, profile.DefaultProjectID)
+				assert.Equal(t, &readOnly, profile.ReadOnly)
+			},
+		},
+		{
+			name: "drops the settings set by environment variables",
+			env:  map[string]string{"N8N_API_URL": "https://env.example.com", "N8N_API_KEY": "env-key", "N8N_READ_ONLY": "false"},
+			check: func(t *testing.T, profile *profileConfig) {
+				assert.Nil(t, profile.BaseURL)
+				assert.Nil(t, profile.APIKey)
+				assert.Nil(t, profile.APIKeyCommand)
+				assert.Nil(t, profile.ReadOnly)
+				assert.Equal(t, &projectID, profile.DefaultProjectID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Clear every environment variable of the provider.
+			for _, name := range []string{"N8N_API_URL", "N8N_API_KEY", "N8N_PROJECT_ID", "N8N_REQUESTS_PER_SECOND", "N8N_MAX_CONCURRENT_REQUESTS", "N8N_READ_ONLY"} {
+				t.Setenv(name, tt.env[name])
+			}
+			profile := &profileConfig{
+				BaseURL:          &baseURL,
+				APIKey:           &apiKey,
+				APIKeyCommand:    []string{"echo", "key"},
+				DefaultProjectID: &projectID,
+				ReadOnly:         &readOnly,
+			}
+
+			dropEnvOverrides(profile)
+
+			tt.check(t, profile)
+		})
+	}
+}
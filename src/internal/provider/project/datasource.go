@@ -11,8 +11,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/project/models"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 )
 
@@ -167,28 +167,16 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// List all projects and filter client-side (API limitation).
-	projectList, httpResp, err := d.client.APIClient.ProjectsAPI.ProjectsGet(ctx).Execute()
-	// Close response body if available.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	projects, httpResp, err := client.ListAll(d.client.APIClient.ProjectsAPI.ProjectsGet(ctx), d.client.PageSize)
 	// Check for API errors.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing projects",
-			fmt.Sprintf("Could not list projects: %s\nHTTP Response: %v", err.Error(), httpResp),
-		)
+		shared.AddAPIError(&resp.Diagnostics, "Error listing projects", "Could not list projects", err, httpResp)
 		// Return with error.
 		return
 	}
 
 	// Find project by ID or name.
-	var project *n8nsdk.Project
-	var found bool
-	// Search for project in the list.
-	if projectList.Data != nil {
-		project, found = findProjectByIDOrName(projectList.Data, data.ID, data.Name)
-	}
+	project, found := findProjectByIDOrName(projects, data.ID, data.Name)
 
 	// Check if project was found.
 	if !found {
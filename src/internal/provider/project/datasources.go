@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/project/models"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/constants"
 )
@@ -172,29 +173,19 @@ func (d *ProjectsDataSource) Configure(_ctx context.Context, req datasource.Conf
 func (d *ProjectsDataSource) Read(ctx context.Context, _req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data models.DataSources
 
-	projectList, httpResp, err := d.client.APIClient.ProjectsAPI.ProjectsGet(ctx).Execute()
-	// Close the HTTP response body if it is not nil to prevent resource leaks.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	projects, httpResp, err := client.ListAll(d.client.APIClient.ProjectsAPI.ProjectsGet(ctx), d.client.PageSize)
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing projects",
-			fmt.Sprintf("Could not list projects: %s\nHTTP Response: %v", err.Error(), httpResp),
-		)
+		shared.AddAPIError(&resp.Diagnostics, "Error listing projects", "Could not list projects", err, httpResp)
 		// Return result.
 		return
 	}
 
 	data.Projects = make([]models.Item, 0, constants.DEFAULT_LIST_CAPACITY)
-	// Iterate through all projects and convert them to the model format.
-	if projectList.Data != nil {
-		// Convert each project from the API response to the Item format.
-		for _, project := range projectList.Data {
-			item := mapProjectToItem(&project)
-			data.Projects = append(data.Projects, item)
-		}
+	// Convert each project from the API response to the Item format.
+	for _, project := range projects {
+		item := mapProjectToItem(&project)
+		data.Projects = append(data.Projects, item)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/project/models"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 )
 
@@ -24,6 +25,7 @@ var (
 	_ ProjectResourceInterface         = &ProjectResource{}
 	_ resource.ResourceWithConfigure   = &ProjectResource{}
 	_ resource.ResourceWithImportState = &ProjectResource{}
+	_ resource.ResourceWithModifyPlan  = &ProjectResource{}
 )
 
 // ProjectResourceInterface defines the interface for ProjectResource.
@@ -32,6 +34,7 @@ type ProjectResourceInterface interface {
 	Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse)
 	Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse)
 	Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse)
+	ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse)
 	Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse)
 	Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse)
 	Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse)
@@ -82,9 +85,9 @@ func (r *ProjectResource) Metadata(_ctx context.Context, req resource.MetadataRe
 //   - ctx: context for request cancellation
 //   - req: schema request
 //   - resp: schema response
-func (r *ProjectResource) Schema(_ctx context.Context, _req resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ProjectResource) Schema(ctx context.Context, _req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "n8n project resource. Note: API limitations require workarounds for Read operations.",
+		MarkdownDescription: "n8n project resource. Note: API limitations require workarounds for Read operations. Requires the Enterprise projects feature; planning fails on instances without it.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -100,6 +103,9 @@ func (r *ProjectResource) Schema(_ctx context.Context, _req resource.SchemaReque
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": shared.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -130,6 +136,16 @@ func (r *ProjectResource) Configure(_ctx context.Context, req resource.Configure
 	r.client = clientData
 }
 
+// ModifyPlan fails the plan when the n8n instance does not license team projects.
+//
+// Params:
+//   - ctx: Context for the operation
+//   - req: ModifyPlan request containing config, state and plan
+//   - resp: ModifyPlan response receiving diagnostics
+func (r *ProjectResource) ModifyPlan(ctx context.Context, _req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	shared.RequireFeature(r.client, client.FEATURE_PROJECTS, "n8n_project", resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 // Workaround: API returns 201 with no body, so we must call LIST to get the ID.
 //
@@ -147,6 +163,9 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_CREATE, "creating project", plan.Timeouts.Create, &resp.Diagnostics)
+	defer done()
+
 	// Execute create logic
 	if !r.executeCreateLogic(ctx, &plan, resp) {
 		// Return with error.
@@ -215,9 +234,12 @@ func (r *ProjectResource) createProject(
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIAttributeError(
+			&resp.Diagnostics,
+			path.Root("name"),
 			"Error creating project",
-			fmt.Sprintf("Could not create project: %s\nHTTP Response: %v", err.Error(), httpResp),
+			"Could not create project",
+			err, httpResp,
 		)
 		// Return failure status.
 		return false
@@ -241,17 +263,15 @@ func (r *ProjectResource) findCreatedProject(
 	plan *models.Resource,
 	resp *resource.CreateResponse,
 ) *n8nsdk.Project {
-	projectList, httpResp, err := r.client.APIClient.ProjectsAPI.ProjectsGet(ctx).Execute()
-	// Check for non-nil value.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	projects, httpResp, err := client.ListAll(r.client.APIClient.ProjectsAPI.ProjectsGet(ctx), r.client.PageSize)
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error reading project after creation",
-			fmt.Sprintf("Project was created but could not retrieve ID: %s\nHTTP Response: %v", err.Error(), httpResp),
+			"Project was created but could not retrieve ID",
+			err, httpResp,
 		)
 		// Return nil to indicate failure.
 		return nil
@@ -259,15 +279,12 @@ func (r *ProjectResource) findCreatedProject(
 
 	// Find our project by name
 	var foundProject *n8nsdk.Project
-	// Check for non-nil value.
-	if projectList.Data != nil {
-		// Iterate over items.
-		for _, p := range projectList.Data {
-			// Check condition.
-			if p.Name == plan.Name.ValueString() {
-				foundProject = &p
-				break
-			}
+	// Iterate over items.
+	for _, p := range projects {
+		// Check condition.
+		if p.Name == plan.Name.ValueString() {
+			foundProject = &p
+			break
 		}
 	}
 
@@ -319,6 +336,9 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_READ, "reading project", state.Timeouts.Read, &resp.Diagnostics)
+	defer done()
+
 	// Execute read logic
 	if !r.executeReadLogic(ctx, &state, resp) {
 		// Return with error.
@@ -366,17 +386,15 @@ func (r *ProjectResource) findProjectByID(
 	state *models.Resource,
 	resp *resource.ReadResponse,
 ) *n8nsdk.Project {
-	projectList, httpResp, err := r.client.APIClient.ProjectsAPI.ProjectsGet(ctx).Execute()
-	// Check for non-nil value.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	projects, httpResp, err := client.ListAll(r.client.APIClient.ProjectsAPI.ProjectsGet(ctx), r.client.PageSize)
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error reading project",
-			fmt.Sprintf("Could not read project ID %s: %s\nHTTP Response: %v", state.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not read project ID %s", state.ID.ValueString()),
+			err, httpResp,
 		)
 		// Return nil to indicate failure.
 		return nil
@@ -384,15 +402,12 @@ func (r *ProjectResource) findProjectByID(
 
 	// Find our project by ID
 	var foundProject *n8nsdk.Project
-	// Check for non-nil value.
-	if projectList.Data != nil {
-		// Iterate over items.
-		for _, p := range projectList.Data {
-			// Check for non-nil value.
-			if p.Id != nil && *p.Id == state.ID.ValueString() {
-				foundProject = &p
-				break
-			}
+	// Iterate over items.
+	for _, p := range projects {
+		// Check for non-nil value.
+		if p.Id != nil && *p.Id == state.ID.ValueString() {
+			foundProject = &p
+			break
 		}
 	}
 
@@ -442,6 +457,9 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_UPDATE, "updating project", plan.Timeouts.Update, &resp.Diagnostics)
+	defer done()
+
 	// Execute update logic
 	if !r.executeUpdateLogic(ctx, &plan, &state, resp) {
 		// Return with error.
@@ -516,9 +534,12 @@ func (r *ProjectResource) executeProjectUpdate(ctx context.Context, projectID st
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIAttributeError(
+			&resp.Diagnostics,
+			path.Root("name"),
 			"Error updating project",
-			fmt.Sprintf("Could not update project ID %s: %s\nHTTP Response: %v", projectID, err.Error(), httpResp),
+			fmt.Sprintf("Could not update project ID %s", projectID),
+			err, httpResp,
 		)
 		return false
 	}
@@ -536,17 +557,15 @@ func (r *ProjectResource) executeProjectUpdate(ctx context.Context, projectID st
 // Returns:
 //   - *n8nsdk.Project: found project or nil if not found
 func (r *ProjectResource) findProjectAfterUpdate(ctx context.Context, projectID string, resp *resource.UpdateResponse) *n8nsdk.Project {
-	projectList, httpResp, err := r.client.APIClient.ProjectsAPI.ProjectsGet(ctx).Execute()
-	// Check for non-nil value.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	projects, httpResp, err := client.ListAll(r.client.APIClient.ProjectsAPI.ProjectsGet(ctx), r.client.PageSize)
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error reading project after update",
-			fmt.Sprintf("Project was updated but could not verify: %s\nHTTP Response: %v", err.Error(), httpResp),
+			"Project was updated but could not verify",
+			err, httpResp,
 		)
 		// Return with error.
 		return nil
@@ -554,15 +573,12 @@ func (r *ProjectResource) findProjectAfterUpdate(ctx context.Context, projectID
 
 	// Find our project by ID
 	var foundProject *n8nsdk.Project
-	// Check for non-nil value.
-	if projectList.Data != nil {
-		// Iterate over items.
-		for _, p := range projectList.Data {
-			// Check for non-nil value.
-			if p.Id != nil && *p.Id == projectID {
-				foundProject = &p
-				break
-			}
+	// Iterate over items.
+	for _, p := range projects {
+		// Check for non-nil value.
+		if p.Id != nil && *p.Id == projectID {
+			foundProject = &p
+			break
 		}
 	}
 
@@ -609,6 +625,9 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_DELETE, "deleting project", state.Timeouts.Delete, &resp.Diagnostics)
+	defer done()
+
 	// Execute delete logic
 	r.executeDeleteLogic(ctx, &state, resp)
 }
@@ -633,9 +652,16 @@ func (r *ProjectResource) executeDeleteLogic(ctx context.Context, state *models.
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// The project was already deleted outside Terraform.
+		if shared.IgnoreNotFound(ctx, err, httpResp, "project", state.ID.ValueString()) {
+			// Return success.
+			return true
+		}
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error deleting project",
-			fmt.Sprintf("Could not delete project ID %s: %s\nHTTP Response: %v", state.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not delete project ID %s", state.ID.ValueString()),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
@@ -267,9 +267,10 @@ func TestProjectResource_Create(t *testing.T) {
 
 				// Build plan using tftypes
 				planRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":   tftypes.NewValue(tftypes.String, nil),
-					"name": tftypes.NewValue(tftypes.String, "test-project"),
-					"type": tftypes.NewValue(tftypes.String, "team"),
+					"id":       tftypes.NewValue(tftypes.String, nil),
+					"name":     tftypes.NewValue(tftypes.String, "test-project"),
+					"type":     tftypes.NewValue(tftypes.String, "team"),
+					"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				plan := tfsdk.Plan{
@@ -346,9 +347,10 @@ func TestProjectResource_Create(t *testing.T) {
 				r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
 
 				planRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":   tftypes.NewValue(tftypes.String, nil),
-					"name": tftypes.NewValue(tftypes.String, "test-project"),
-					"type": tftypes.NewValue(tftypes.String, "team"),
+					"id":       tftypes.NewValue(tftypes.String, nil),
+					"name":     tftypes.NewValue(tftypes.String, "test-project"),
+					"type":     tftypes.NewValue(tftypes.String, "team"),
+					"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				plan := tfsdk.Plan{
@@ -410,9 +412,10 @@ func TestProjectResource_Read(t *testing.T) {
 
 				// Build state
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":   tftypes.NewValue(tftypes.String, "proj-123"),
-					"name": tftypes.NewValue(tftypes.String, "test-project"),
-					"type": tftypes.NewValue(tftypes.String, "team"),
+					"id":       tftypes.NewValue(tftypes.String, "proj-123"),
+					"name":     tftypes.NewValue(tftypes.String, "test-project"),
+					"type":     tftypes.NewValue(tftypes.String, "team"),
+					"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -485,9 +488,10 @@ func TestProjectResource_Read(t *testing.T) {
 				r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
 
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":   tftypes.NewValue(tftypes.String, "proj-123"),
-					"name": tftypes.NewValue(tftypes.String, "test-project"),
-					"type": tftypes.NewValue(tftypes.String, "team"),
+					"id":       tftypes.NewValue(tftypes.String, "proj-123"),
+					"name":     tftypes.NewValue(tftypes.String, "test-project"),
+					"type":     tftypes.NewValue(tftypes.String, "team"),
+					"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -555,9 +559,10 @@ func TestProjectResource_Update(t *testing.T) {
 
 				// Build plan
 				planRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":   tftypes.NewValue(tftypes.String, "proj-123"),
-					"name": tftypes.NewValue(tftypes.String, "updated-project"),
-					"type": tftypes.NewValue(tftypes.String, "team"),
+					"id":       tftypes.NewValue(tftypes.String, "proj-123"),
+					"name":     tftypes.NewValue(tftypes.String, "updated-project"),
+					"type":     tftypes.NewValue(tftypes.String, "team"),
+					"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				plan := tfsdk.Plan{
@@ -567,9 +572,10 @@ func TestProjectResource_Update(t *testing.T) {
 
 				// Build state
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":   tftypes.NewValue(tftypes.String, "proj-123"),
-					"name": tftypes.NewValue(tftypes.String, "old-project"),
-					"type": tftypes.NewValue(tftypes.String, "team"),
+					"id":       tftypes.NewValue(tftypes.String, "proj-123"),
+					"name":     tftypes.NewValue(tftypes.String, "old-project"),
+					"type":     tftypes.NewValue(tftypes.String, "team"),
+					"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -612,9 +618,10 @@ func TestProjectResource_Update(t *testing.T) {
 
 				// Create valid state (required since Update reads from both plan and state)
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":   tftypes.NewValue(tftypes.String, "proj-123"),
-					"name": tftypes.NewValue(tftypes.String, "existing-project"),
-					"type": tftypes.NewValue(tftypes.String, "team"),
+					"id":       tftypes.NewValue(tftypes.String, "proj-123"),
+					"name":     tftypes.NewValue(tftypes.String, "existing-project"),
+					"type":     tftypes.NewValue(tftypes.String, "team"),
+					"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -656,9 +663,10 @@ func TestProjectResource_Update(t *testing.T) {
 				r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
 
 				planRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":   tftypes.NewValue(tftypes.String, "proj-123"),
-					"name": tftypes.NewValue(tftypes.String, "updated-project"),
-					"type": tftypes.NewValue(tftypes.String, "team"),
+					"id":       tftypes.NewValue(tftypes.String, "proj-123"),
+					"name":     tftypes.NewValue(tftypes.String, "updated-project"),
+					"type":     tftypes.NewValue(tftypes.String, "team"),
+					"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				plan := tfsdk.Plan{
@@ -667,9 +675,10 @@ func TestProjectResource_Update(t *testing.T) {
 				}
 
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":   tftypes.NewValue(tftypes.String, "proj-123"),
-					"name": tftypes.NewValue(tftypes.String, "old-project"),
-					"type": tftypes.NewValue(tftypes.String, "team"),
+					"id":       tftypes.NewValue(tftypes.String, "proj-123"),
+					"name":     tftypes.NewValue(tftypes.String, "old-project"),
+					"type":     tftypes.NewValue(tftypes.String, "team"),
+					"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -726,9 +735,10 @@ func TestProjectResource_Delete(t *testing.T) {
 
 				// Build state
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":   tftypes.NewValue(tftypes.String, "proj-123"),
-					"name": tftypes.NewValue(tftypes.String, "test-project"),
-					"type": tftypes.NewValue(tftypes.String, "team"),
+					"id":       tftypes.NewValue(tftypes.String, "proj-123"),
+					"name":     tftypes.NewValue(tftypes.String, "test-project"),
+					"type":     tftypes.NewValue(tftypes.String, "team"),
+					"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -799,9 +809,10 @@ func TestProjectResource_Delete(t *testing.T) {
 				r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
 
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-					"id":   tftypes.NewValue(tftypes.String, "proj-123"),
-					"name": tftypes.NewValue(tftypes.String, "test-project"),
-					"type": tftypes.NewValue(tftypes.String, "team"),
+					"id":       tftypes.NewValue(tftypes.String, "proj-123"),
+					"name":     tftypes.NewValue(tftypes.String, "test-project"),
+					"type":     tftypes.NewValue(tftypes.String, "team"),
+					"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -839,9 +850,10 @@ func TestProjectResource_ImportState(t *testing.T) {
 
 		// Build empty state
 		emptyValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
-			"id":   tftypes.NewValue(tftypes.String, nil),
-			"name": tftypes.NewValue(tftypes.String, nil),
-			"type": tftypes.NewValue(tftypes.String, nil),
+			"id":       tftypes.NewValue(tftypes.String, nil),
+			"name":     tftypes.NewValue(tftypes.String, nil),
+			"type":     tftypes.NewValue(tftypes.String, nil),
+			"timeouts": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 		})
 
 		req := resource.ImportStateRequest{
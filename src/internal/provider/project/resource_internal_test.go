@@ -8,7 +8,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/project/models"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
@@ -382,13 +384,13 @@ func TestProjectResource_executeDeleteLogic(t *testing.T) {
 			expectError: false,
 		},
 		{
-			name:      "project not found",
+			name:      "project already deleted",
 			projectID: "proj-404",
 			setupHandler: func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusNotFound)
 				w.Write([]byte(`{"message": "Project not found"}`))
 			},
-			expectError: true,
+			expectError: false,
 		},
 		{
 			name:      "API error",
@@ -751,14 +753,14 @@ func TestProjectUserResource_executeDeleteLogic(t *testing.T) {
 			expectError: false,
 		},
 		{
-			name:      "user not found in project",
+			name:      "user already removed from project",
 			projectID: "proj-123",
 			userID:    "user-404",
 			setupHandler: func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusNotFound)
 				w.Write([]byte(`{"message": "User not found in project"}`))
 			},
-			expectError: true,
+			expectError: false,
 		},
 		{
 			name:      "API error",
@@ -1437,3 +1439,68 @@ func TestProjectResource_updateModelFromProject(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+// TestProjectResources_ModifyPlan tests the feature gating of the project resources.
+func TestProjectResources_ModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	community := `{"data":{"versionCli":"1.64.0","enterprise":{"projects":{"team":{"limit":0}}}}}`
+	enterprise := `{"data":{"versionCli":"1.64.0","enterprise":{"projects":{"team":{"limit":-1}}}}}`
+
+	tests := []struct {
+		name     string
+		resource func() resource.ResourceWithModifyPlan
+		settings string
+		wantErr  string
+	}{
+		{name: "project on licensed instance", resource: func() resource.ResourceWithModifyPlan { return NewProjectResource() }, settings: enterprise},
+		{name: "project user on licensed instance", resource: func() resource.ResourceWithModifyPlan { return NewProjectUserResource() }, settings: enterprise},
+		{
+			name:     "error case - project on community instance",
+			resource: func() resource.ResourceWithModifyPlan { return NewProjectResource() },
+			settings: community,
+			wantErr:  "n8n_project requires the Enterprise projects feature; instance is community 1.64.0",
+		},
+		{
+			name:     "error case - project user on community instance",
+			resource: func() resource.ResourceWithModifyPlan { return NewProjectUserResource() },
+			settings: community,
+			wantErr:  "n8n_project_user requires the Enterprise projects feature; instance is community 1.64.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Write([]byte(tt.settings))
+			}))
+			defer server.Close()
+			n8nClient := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{})
+			n8nClient.Instance, _ = client.DetectInstance(context.Background(), n8nClient.BaseURL, n8nClient.APIClient)
+
+			r := tt.resource()
+			r.(resource.ResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{ProviderData: n8nClient}, &resource.ConfigureResponse{})
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+			planAttrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attrType := range objectType.AttributeTypes {
+				planAttrs[name] = tftypes.NewValue(attrType, tftypes.UnknownValue)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, planAttrs)}}
+
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{}, resp)
+
+			// Check diagnostics.
+			if tt.wantErr == "" {
+				assert.False(t, resp.Diagnostics.HasError())
+				return
+			}
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/project/models"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 )
 
@@ -28,6 +29,7 @@ var (
 	_ ProjectUserResourceInterface     = &ProjectUserResource{}
 	_ resource.ResourceWithConfigure   = &ProjectUserResource{}
 	_ resource.ResourceWithImportState = &ProjectUserResource{}
+	_ resource.ResourceWithModifyPlan  = &ProjectUserResource{}
 )
 
 // ProjectUserResourceInterface defines the interface for ProjectUserResource.
@@ -36,6 +38,7 @@ type ProjectUserResourceInterface interface {
 	Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse)
 	Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse)
 	Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse)
+	ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse)
 	Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse)
 	Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse)
 	Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse)
@@ -88,9 +91,9 @@ func (r *ProjectUserResource) Metadata(_ctx context.Context, req resource.Metada
 //
 // Returns:
 //   - none
-func (r *ProjectUserResource) Schema(_ctx context.Context, _req resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ProjectUserResource) Schema(ctx context.Context, _req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages user membership and roles within n8n projects. Allows adding users to projects, changing their roles, and removing them from projects.",
+		MarkdownDescription: "Manages user membership and roles within n8n projects. Allows adding users to projects, changing their roles, and removing them from projects. Requires the Enterprise projects feature; planning fails on instances without it.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -110,6 +113,9 @@ func (r *ProjectUserResource) Schema(_ctx context.Context, _req resource.SchemaR
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": shared.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -142,6 +148,16 @@ func (r *ProjectUserResource) Configure(_ctx context.Context, req resource.Confi
 	r.client = clientData
 }
 
+// ModifyPlan fails the plan when the n8n instance does not license team projects.
+//
+// Params:
+//   - ctx: Context for the operation
+//   - req: ModifyPlan request containing config, state and plan
+//   - resp: ModifyPlan response receiving diagnostics
+func (r *ProjectUserResource) ModifyPlan(ctx context.Context, _req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	shared.RequireFeature(r.client, client.FEATURE_PROJECTS, "n8n_project_user", resp)
+}
+
 // Create adds a user to a project with the specified role.
 // Params:
 //   - ctx: context
@@ -160,6 +176,9 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_CREATE, "creating project user", plan.Timeouts.Create, &resp.Diagnostics)
+	defer done()
+
 	// Execute create logic
 	if !r.executeCreateLogic(ctx, plan, resp) {
 		// Return with error.
@@ -197,10 +216,12 @@ func (r *ProjectUserResource) executeCreateLogic(ctx context.Context, plan *mode
 	}
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIAttributeError(
+			&resp.Diagnostics,
+			path.Root("user_id"),
 			"Error adding user to project",
-			fmt.Sprintf("Could not add user %s to project %s: %s\nHTTP Response: %v",
-				plan.UserID.ValueString(), plan.ProjectID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not add user %s to project %s", plan.UserID.ValueString(), plan.ProjectID.ValueString()),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
@@ -231,6 +252,9 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_READ, "reading project user", state.Timeouts.Read, &resp.Diagnostics)
+	defer done()
+
 	// Execute read logic
 	if !r.executeReadLogic(ctx, state, resp) {
 		// Return with error.
@@ -276,27 +300,26 @@ func (r *ProjectUserResource) findUserInProject(
 	state *models.UserResource,
 	resp *resource.ReadResponse,
 ) bool {
-	userList, httpResp, err := r.client.APIClient.UserAPI.UsersGet(ctx).
-		ProjectId(state.ProjectID.ValueString()).
-		IncludeRole(true).
-		Execute()
-	// Check for non-nil value.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	users, httpResp, err := client.ListAll(
+		r.client.APIClient.UserAPI.UsersGet(ctx).
+			ProjectId(state.ProjectID.ValueString()).
+			IncludeRole(true),
+		r.client.PageSize,
+	)
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error reading project users",
-			fmt.Sprintf("Could not read users for project %s: %s\nHTTP Response: %v",
-				state.ProjectID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not read users for project %s", state.ProjectID.ValueString()),
+			err, httpResp,
 		)
 		// Return false to indicate failure.
 		return false
 	}
 
 	// Find the user in the project.
-	found := r.searchUserInList(userList, state)
+	found := r.searchUserInList(users, state)
 
 	// Check condition.
 	if !found {
@@ -313,23 +336,17 @@ func (r *ProjectUserResource) findUserInProject(
 // searchUserInList searches for the user in the user list and updates state.
 //
 // Params:
-//   - userList: list of users from API
+//   - users: users of the project returned by the API
 //   - state: current resource state to update
 //
 // Returns:
 //   - found: true if user was found in the list
 func (r *ProjectUserResource) searchUserInList(
-	userList *n8nsdk.UserList,
+	users []n8nsdk.User,
 	state *models.UserResource,
 ) bool {
-	// Check for non-nil value.
-	if userList.Data == nil {
-		// Return false if no data available.
-		return false
-	}
-
 	// Iterate over items.
-	for _, user := range userList.Data {
+	for _, user := range users {
 		// Check for non-nil value.
 		if user.Id != nil && *user.Id == state.UserID.ValueString() {
 			// Update role if available.
@@ -364,6 +381,9 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_UPDATE, "updating project user", plan.Timeouts.Update, &resp.Diagnostics)
+	defer done()
+
 	// Execute update logic
 	if !r.executeUpdateLogic(ctx, plan, state, resp) {
 		// Return with error.
@@ -410,10 +430,12 @@ func (r *ProjectUserResource) executeUpdateLogic(ctx context.Context, plan, stat
 
 		// Check for error.
 		if err != nil {
-			resp.Diagnostics.AddError(
+			shared.AddAPIAttributeError(
+				&resp.Diagnostics,
+				path.Root("role"),
 				"Error updating user role in project",
-				fmt.Sprintf("Could not update role for user %s in project %s: %s\nHTTP Response: %v",
-					plan.UserID.ValueString(), plan.ProjectID.ValueString(), err.Error(), httpResp),
+				fmt.Sprintf("Could not update role for user %s in project %s", plan.UserID.ValueString(), plan.ProjectID.ValueString()),
+				err, httpResp,
 			)
 			// Return failure.
 			return false
@@ -442,6 +464,9 @@ func (r *ProjectUserResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_DELETE, "deleting project user", state.Timeouts.Delete, &resp.Diagnostics)
+	defer done()
+
 	// Execute delete logic
 	r.executeDeleteLogic(ctx, state, resp)
 }
@@ -470,10 +495,16 @@ func (r *ProjectUserResource) executeDeleteLogic(ctx context.Context, state *mod
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// The user was already removed from the project outside Terraform.
+		if shared.IgnoreNotFound(ctx, err, httpResp, "project user", state.ID.ValueString()) {
+			// Return success.
+			return true
+		}
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error removing user from project",
-			fmt.Sprintf("Could not remove user %s from project %s: %s\nHTTP Response: %v",
-				state.UserID.ValueString(), state.ProjectID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not remove user %s from project %s", state.UserID.ValueString(), state.ProjectID.ValueString()),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
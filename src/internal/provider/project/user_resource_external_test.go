@@ -249,6 +249,7 @@ func TestProjectUserResource_ImportState(t *testing.T) {
 				"project_id": tftypes.NewValue(tftypes.String, nil),
 				"user_id":    tftypes.NewValue(tftypes.String, nil),
 				"role":       tftypes.NewValue(tftypes.String, nil),
+				"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 			})
 
 			req := resource.ImportStateRequest{
@@ -339,6 +340,7 @@ func TestProjectUserResource_Create(t *testing.T) {
 					"project_id": tftypes.NewValue(tftypes.String, "proj-123"),
 					"user_id":    tftypes.NewValue(tftypes.String, "user-456"),
 					"role":       tftypes.NewValue(tftypes.String, "project:admin"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 			}
 
@@ -441,6 +443,7 @@ func TestProjectUserResource_Read(t *testing.T) {
 					"project_id": tftypes.NewValue(tftypes.String, "proj-123"),
 					"user_id":    tftypes.NewValue(tftypes.String, "user-456"),
 					"role":       tftypes.NewValue(tftypes.String, "project:admin"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 			}
 
@@ -557,6 +560,7 @@ func TestProjectUserResource_Update(t *testing.T) {
 					"project_id": tftypes.NewValue(tftypes.String, "proj-123"),
 					"user_id":    tftypes.NewValue(tftypes.String, "user-456"),
 					"role":       tftypes.NewValue(tftypes.String, "project:editor"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 			} else if tt.invalidPlan {
 				// Create invalid plan with wrong type to trigger req.Plan.Get() error
@@ -565,6 +569,7 @@ func TestProjectUserResource_Update(t *testing.T) {
 					"project_id": tftypes.NewValue(tftypes.String, "proj-123"),
 					"user_id":    tftypes.NewValue(tftypes.String, "user-456"),
 					"role":       tftypes.NewValue(tftypes.String, "project:admin"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 				planRaw = tftypes.NewValue(tftypes.String, "invalid")
 			} else {
@@ -573,6 +578,7 @@ func TestProjectUserResource_Update(t *testing.T) {
 					"project_id": tftypes.NewValue(tftypes.String, "proj-123"),
 					"user_id":    tftypes.NewValue(tftypes.String, "user-456"),
 					"role":       tftypes.NewValue(tftypes.String, "project:admin"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				planRaw = tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
@@ -580,6 +586,7 @@ func TestProjectUserResource_Update(t *testing.T) {
 					"project_id": tftypes.NewValue(tftypes.String, "proj-123"),
 					"user_id":    tftypes.NewValue(tftypes.String, "user-456"),
 					"role":       tftypes.NewValue(tftypes.String, "project:editor"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 			}
 
@@ -675,6 +682,7 @@ func TestProjectUserResource_Delete(t *testing.T) {
 					"project_id": tftypes.NewValue(tftypes.String, "proj-123"),
 					"user_id":    tftypes.NewValue(tftypes.String, "user-456"),
 					"role":       tftypes.NewValue(tftypes.String, "project:admin"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 			}
 
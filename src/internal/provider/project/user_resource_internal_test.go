@@ -247,6 +247,7 @@ func TestProjectUserResource_findUserInProject(t *testing.T) {
 				"project_id": tftypes.NewValue(tftypes.String, tt.projectID),
 				"user_id":    tftypes.NewValue(tftypes.String, tt.userID),
 				"role":       tftypes.NewValue(tftypes.String, nil),
+				"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 			}
 			tfState := tfsdk.State{
 				Raw: tftypes.NewValue(tftypes.Object{
@@ -255,6 +256,7 @@ func TestProjectUserResource_findUserInProject(t *testing.T) {
 						"project_id": tftypes.String,
 						"user_id":    tftypes.String,
 						"role":       tftypes.String,
+						"timeouts":   tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}, rawState),
 				Schema: testSchema,
@@ -461,7 +463,7 @@ func TestProjectUserResource_searchUserInList(t *testing.T) {
 			state := &models.UserResource{}
 			state.UserID = types.StringValue(tt.stateUserID)
 
-			found := r.searchUserInList(tt.userList, state)
+			found := r.searchUserInList(tt.userList.Data, state)
 
 			if tt.expectFound {
 				assert.True(t, found)
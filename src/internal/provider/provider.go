@@ -10,9 +10,12 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/credential"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/project"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
@@ -25,13 +28,15 @@ import (
 
 // Compile-time assertions to ensure N8nProvider implements required interfaces.
 var (
-	_ provider.Provider = &N8nProvider{}
-	_ TerraformProvider = &N8nProvider{}
+	_ provider.Provider                   = &N8nProvider{}
+	_ provider.ProviderWithValidateConfig = &N8nProvider{}
+	_ provider.ProviderWithFunctions      = &N8nProvider{}
+	_ TerraformProvider                   = &N8nProvider{}
 )
 
 // TerraformProvider defines the complete interface for a Terraform provider implementation.
 // This interface encompasses all provider lifecycle methods including metadata, schema,
-// configuration, and resource/data source registration.
+// configuration, and resource/data source/function registration.
 type TerraformProvider interface {
 	// Metadata populates provider metadata including type name and version
 	Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse)
@@ -47,6 +52,9 @@ type TerraformProvider interface {
 
 	// DataSources returns the list of data sources supported by this provider
 	DataSources(ctx context.Context) []func() datasource.DataSource
+
+	// Functions returns the list of provider functions supported by this provider
+	Functions(ctx context.Context) []func() function.Function
 }
 
 // N8nProvider implements the TerraformProvider interface for n8n automation platform.
@@ -69,7 +77,8 @@ func (p *N8nProvider) Metadata(_ctx context.Context, _req provider.MetadataReque
 }
 
 // Schema defines the provider configuration schema.
-// Requires API key and base URL for n8n instance authentication.
+// Requires API key and base URL for n8n instance authentication,
+// and exposes optional HTTP client settings such as retries and rate limits.
 //
 // Params:
 //   - ctx: context for the operation
@@ -80,18 +89,158 @@ func (p *N8nProvider) Schema(_ctx context.Context, _req provider.SchemaRequest,
 		MarkdownDescription: "Terraform provider for n8n automation platform",
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key for n8n instance authentication. Can also be set via N8N_API_KEY environment variable.",
+				MarkdownDescription: "API key for n8n instance authentication. Conflicts with `api_key_file` and `api_key_command`. When none of them is set, the N8N_API_KEY environment variable is used.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a file holding the API key. Surrounding whitespace is ignored. Conflicts with `api_key` and `api_key_command`.",
+				Optional:            true,
+			},
+			"api_key_command": schema.ListAttribute{
+				MarkdownDescription: "Command printing the API key on its standard output, as the program followed by its arguments (e.g., `[\"vault\", \"kv\", \"get\", \"-field=key\", \"secret/n8n\"]`). It runs without a shell, once per provider process, and must exit with status `0`. Conflicts with `api_key` and `api_key_file`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 			"base_url": schema.StringAttribute{
 				MarkdownDescription: "Base URL of the n8n instance (e.g., https://n8n.example.com). Can also be set via N8N_API_URL environment variable.",
 				Optional:            true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for transient API failures (HTTP 429, 502, 503, 504 and connection errors). Only idempotent requests are retried on server errors. Defaults to `3`; set to `0` to disable retries.",
+				Optional:            true,
+				Validators:          []validator.Int64{nonNegativeInt64Validator{}},
+			},
+			"retry_wait_min": schema.StringAttribute{
+				MarkdownDescription: "Minimum wait between retries as a duration (e.g., `500ms`, `1s`). The wait doubles on each retry, with jitter. Defaults to `1s`.",
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"retry_wait_max": schema.StringAttribute{
+				MarkdownDescription: "Maximum wait between retries as a duration (e.g., `30s`). Also caps the `Retry-After` value sent by the server. Defaults to `30s`, or to `retry_wait_min` when it is greater.",
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of API requests per second sent to the n8n instance, shared by all resources and data sources. Unlimited when unset or `0`. Can also be set via N8N_REQUESTS_PER_SECOND environment variable.",
+				Optional:            true,
+				Validators:          []validator.Float64{nonNegativeFloat64Validator{}},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of API requests in flight at the same time. Unlimited when unset or `0`. Can also be set via N8N_MAX_CONCURRENT_REQUESTS environment variable.",
+				Optional:            true,
+				Validators:          []validator.Int64{nonNegativeInt64Validator{}},
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: "Number of items fetched per page when listing workflows, users, projects, variables and tags. Every page is fetched, so the value only trades the number of requests against their size. Must be between `1` and `250`. Defaults to `100`.",
+				Optional:            true,
+				Validators:          []validator.Int64{pageSizeValidator{}},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates trusted in addition to the system pool when connecting to the n8n instance. Conflicts with `ca_cert_file`.",
+				Optional:            true,
+				Validators:          []validator.String{certificatePEMValidator{}},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a file holding PEM encoded CA certificates trusted in addition to the system pool. Conflicts with `ca_cert_pem`.",
+				Optional:            true,
+				Validators:          []validator.String{certificatePEMValidator{fromFile: true}},
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate presented for mutual TLS. Requires `client_key_pem` or `client_key_file`. Conflicts with `client_cert_file`.",
+				Optional:            true,
+				Validators:          []validator.String{certificatePEMValidator{}},
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate. Conflicts with `client_key_file`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators:          []validator.String{privateKeyPEMValidator{}},
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a file holding the PEM encoded client certificate presented for mutual TLS. Conflicts with `client_cert_pem`.",
+				Optional:            true,
+				Validators:          []validator.String{certificatePEMValidator{fromFile: true}},
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a file holding the PEM encoded private key of the client certificate. Conflicts with `client_key_pem`.",
+				Optional:            true,
+				Validators:          []validator.String{privateKeyPEMValidator{fromFile: true}},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disable verification of the n8n server certificate. Only intended for testing; prefer `ca_cert_pem` or `ca_cert_file`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Extra HTTP headers sent with every API request alongside `X-N8N-API-KEY`, e.g. `CF-Access-Client-Id` or `Authorization` for an authenticating reverse proxy. `X-N8N-API-KEY` itself cannot be overridden.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				Validators:          []validator.Map{headersValidator{}},
+			},
+			"default_project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID used by `n8n_workflow`, `n8n_credential` and `n8n_variable` resources that do not set `project_id`. Can also be set via N8N_PROJECT_ID environment variable.",
+				Optional:            true,
+			},
+			"community_node_allowlist": schema.SetAttribute{
+				MarkdownDescription: "Node types (e.g., `n8n-nodes-acme.invoice`) or node packages (e.g., `n8n-nodes-acme`) skipped when `n8n_workflow` and `n8n_workflow_node` validate their nodes against the node catalog embedded in the provider. Nodes of community packages fail validation unless allowlisted. Built-in node types missing from the catalog, such as node types released after it, get a warning that allowlisting them silences. An active workflow whose only possible triggers are allowlisted nodes gets a warning instead of an error.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy used to reach the n8n instance (e.g., `http://proxy.example.com:3128`). Supports `http`, `https` and `socks5` schemes. Defaults to the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+				Validators:          []validator.String{proxyURLValidator{}},
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Name of the profile loaded from the profiles file. Attributes set in the provider block take precedence over the environment variables, which take precedence over the profile. Can also be set via N8N_PROFILE environment variable.",
+				Optional:            true,
+			},
+			"config_file": schema.StringAttribute{
+				MarkdownDescription: "Path of the TOML profiles file read when a `profile` is selected. Can also be set via N8N_CONFIG_FILE environment variable. Defaults to `$XDG_CONFIG_HOME/n8n/credentials.toml`, or `~/.config/n8n/credentials.toml`.",
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Refuse every API request that may modify the n8n instance (POST, PUT, PATCH, DELETE), e.g. to run `terraform plan` with a production API key. Data sources and refreshes keep working; resources fail when they attempt a change. Can also be set via N8N_READ_ONLY environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				MarkdownDescription: "Tags added to every `n8n_workflow` managed by the provider. Missing tags are created on apply. The effective set of each workflow is exposed through its `tags_all` attribute.",
+				Attributes: map[string]schema.Attribute{
+					"names": schema.SetAttribute{
+						MarkdownDescription: "Names of the tags to add (e.g., `managed-by-terraform`).",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
 		},
 	}
 }
 
+// ValidateConfig checks attribute combinations of the provider configuration at plan time.
+// It reports conflicting API key sources, conflicting TLS attributes and incomplete client certificate pairs.
+//
+// Params:
+//   - ctx: context for the operation
+//   - req: validation request holding the configuration
+//   - resp: response object collecting diagnostics
+func (p *N8nProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	config := &models.N8nProviderModel{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+
+	// Exit early if configuration parsing encountered errors
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateAPIKeyConfig(config, &resp.Diagnostics)
+	validateTLSConfig(config, &resp.Diagnostics)
+}
+
 // Configure initializes the provider with the given configuration.
 // It creates an n8n SDK client and makes it available to resources and data sources.
 //
@@ -109,11 +258,18 @@ func (p *N8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
-	// Read configuration from provider block or environment variables
-	apiKey := config.APIKey.ValueString()
-	// Use N8N_API_KEY environment variable if not set in config
-	if apiKey == "" {
-		apiKey = getEnvAPIKey()
+	// Fill unset attributes from the selected profile
+	applyProfile(ctx, config, &resp.Diagnostics)
+	// Exit early if the profile could not be loaded
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API key from api_key, api_key_file, api_key_command or N8N_API_KEY
+	apiKey := resolveAPIKey(ctx, config, &resp.Diagnostics)
+	// Exit early if the API key source failed
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	baseURL := config.BaseURL.ValueString()
@@ -127,7 +283,7 @@ func (p *N8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	if apiKey == "" {
 		resp.Diagnostics.AddError(
 			"Missing API Key",
-			"The provider requires an API key. Set the api_key attribute in the provider configuration or the N8N_API_KEY environment variable.",
+			"The provider requires an API key. Set api_key, api_key_file or api_key_command in the provider configuration or the selected profile, or the N8N_API_KEY environment variable.",
 		)
 	}
 
@@ -135,7 +291,7 @@ func (p *N8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	if baseURL == "" {
 		resp.Diagnostics.AddError(
 			"Missing Base URL",
-			"The provider requires a base URL. Set the base_url attribute in the provider configuration or the N8N_API_URL environment variable.",
+			"The provider requires a base URL. Set the base_url attribute in the provider configuration or the selected profile, or the N8N_API_URL environment variable.",
 		)
 	}
 
@@ -144,8 +300,44 @@ func (p *N8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	// Resolve HTTP client options (retries, backoff, rate limits, TLS)
+	opts := buildClientOptions(config, &resp.Diagnostics)
+	// Exit early if options are invalid
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create n8n client using the generated SDK
-	n8nClient := client.NewN8nClient(baseURL, apiKey)
+	n8nClient := client.NewN8nClientWithOptions(baseURL, apiKey, opts)
+
+	// Read default project from provider block or N8N_PROJECT_ID
+	n8nClient.DefaultProjectID = config.DefaultProjectID.ValueString()
+	// Use N8N_PROJECT_ID environment variable if not set in config
+	if n8nClient.DefaultProjectID == "" {
+		n8nClient.DefaultProjectID = getEnvProjectID()
+	}
+
+	// Override the page size of list calls when configured
+	if !config.PageSize.IsNull() && !config.PageSize.IsUnknown() {
+		n8nClient.PageSize = config.PageSize.ValueInt64()
+	}
+
+	// Resolve default tags lazily, on first use by a workflow
+	n8nClient.DefaultTags = buildDefaultTags(ctx, config.DefaultTags, &resp.Diagnostics)
+	// Exit early if default tags are invalid
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip the node catalog validation for the allowlisted community nodes
+	n8nClient.CommunityNodeAllowlist = buildCommunityNodeAllowlist(ctx, config.CommunityNodeAllowlist, &resp.Diagnostics)
+	// Exit early if the allowlist is invalid
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Detect the instance version and licensed features once, for the plan-time feature checks
+	n8nClient.Instance = detectInstance(ctx, n8nClient, &resp.Diagnostics)
 
 	// Make client available to resources and data sources
 	resp.DataSourceData = n8nClient
@@ -195,6 +387,8 @@ func (p *N8nProvider) DataSources(_ctx context.Context) []func() datasource.Data
 		// Workflow domain
 		workflow.NewWorkflowDataSourceWrapper,
 		workflow.NewWorkflowsDataSourceWrapper,
+		// Credential domain
+		credential.NewCredentialSchemaDataSourceWrapper,
 		// Project domain
 		project.NewProjectDataSourceWrapper,
 		project.NewProjectsDataSourceWrapper,
@@ -210,6 +404,25 @@ func (p *N8nProvider) DataSources(_ctx context.Context) []func() datasource.Data
 	}
 }
 
+// Functions returns the list of provider functions supported by this provider.
+// Returns factory functions for all supported functions.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - []func() function.Function: list of function factory functions
+func (p *N8nProvider) Functions(_ctx context.Context) []func() function.Function {
+	// Return result.
+	return []func() function.Function{
+		// Workflow domain
+		workflow.NewNodeFunction,
+		workflow.NewConnectFunction,
+		workflow.NewMergeConnectionsFunction,
+		workflow.NewNormalizeWorkflowFunction,
+	}
+}
+
 // NewN8nProvider creates and initializes a new N8nProvider instance with the specified version.
 // This is the recommended constructor for creating provider instances.
 //
@@ -234,6 +447,60 @@ func getEnvAPIKey() string {
 	return os.Getenv("N8N_API_KEY")
 }
 
+// getEnvRequestsPerSecond retrieves the request rate limit from N8N_REQUESTS_PER_SECOND environment variable.
+//
+// Returns:
+//   - string: Rate limit from environment, or empty string if not found
+func getEnvRequestsPerSecond() string {
+	// Return rate limit from environment variable
+	return os.Getenv("N8N_REQUESTS_PER_SECOND")
+}
+
+// getEnvMaxConcurrentRequests retrieves the concurrency cap from N8N_MAX_CONCURRENT_REQUESTS environment variable.
+//
+// Returns:
+//   - string: Concurrency cap from environment, or empty string if not found
+func getEnvMaxConcurrentRequests() string {
+	// Return concurrency cap from environment variable
+	return os.Getenv("N8N_MAX_CONCURRENT_REQUESTS")
+}
+
+// getEnvReadOnly retrieves the read-only flag from N8N_READ_ONLY environment variable.
+//
+// Returns:
+//   - string: Read-only flag from environment, or empty string if not found
+func getEnvReadOnly() string {
+	// Return read-only flag from environment variable
+	return os.Getenv("N8N_READ_ONLY")
+}
+
+// getEnvProjectID retrieves the default project from N8N_PROJECT_ID environment variable.
+//
+// Returns:
+//   - string: Project ID from environment, or empty string if not found
+func getEnvProjectID() string {
+	// Return project ID from environment variable
+	return os.Getenv("N8N_PROJECT_ID")
+}
+
+// getEnvProfile retrieves the profile name from N8N_PROFILE environment variable.
+//
+// Returns:
+//   - string: Profile name from environment, or empty string if not found
+func getEnvProfile() string {
+	// Return profile name from environment variable
+	return os.Getenv("N8N_PROFILE")
+}
+
+// getEnvConfigFile retrieves the profiles file from N8N_CONFIG_FILE environment variable.
+//
+// Returns:
+//   - string: Profiles file path from environment, or empty string if not found
+func getEnvConfigFile() string {
+	// Return profiles file path from environment variable
+	return os.Getenv("N8N_CONFIG_FILE")
+}
+
 // getEnvBaseURL retrieves base URL from N8N_API_URL environment variable.
 //
 // Returns:
@@ -2,10 +2,16 @@ package provider_test
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,11 +19,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	p "github.com/kodflow/terraform-provider-n8n/src/internal/provider"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// testInstanceURL starts a server answering the instance detection probed by Configure.
+func testInstanceURL(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Only the settings endpoint is needed, whatever the base path.
+		if !strings.HasSuffix(r.URL.Path, client.SETTINGS_PATH) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"data":{"versionCli":"1.64.0","enterprise":{"variables":false,"projects":{"team":{"limit":0}}}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
 // contextKey is a custom type for context keys to avoid collisions.
 type contextKey string
 
@@ -325,7 +349,7 @@ func TestSchema(t *testing.T) {
 		{
 			name:                "defines provider schema",
 			version:             "1.0.0",
-			wantAttributes:      []string{"api_key", "base_url"},
+			wantAttributes:      []string{"api_key", "base_url", "max_retries", "retry_wait_min", "retry_wait_max", "requests_per_second", "max_concurrent_requests", "ca_cert_pem", "ca_cert_file", "client_cert_pem", "client_key_pem", "client_cert_file", "client_key_file", "insecure_skip_verify", "headers", "proxy_url", "default_project_id"},
 			wantAPIKeyRequired:  false, // Optional - reads from N8N_API_KEY env var
 			wantAPIKeySensitive: true,
 			wantBaseURLRequired: false, // Optional - reads from N8N_API_URL env var
@@ -399,7 +423,7 @@ func TestConfigure(t *testing.T) {
 			name:          "configures with valid config",
 			version:       "1.0.0",
 			apiKey:        stringPtr("test-api-key"),
-			baseURL:       stringPtr("https://n8n.example.com"),
+			baseURL:       stringPtr(testInstanceURL(t)),
 			wantErr:       false,
 			wantClientSet: true,
 		},
@@ -407,7 +431,7 @@ func TestConfigure(t *testing.T) {
 			name:            "fails with missing api_key",
 			version:         "1.0.0",
 			apiKey:          nil,
-			baseURL:         stringPtr("https://n8n.example.com"),
+			baseURL:         stringPtr(testInstanceURL(t)),
 			wantErr:         true,
 			wantErrContains: "Missing API Key",
 			wantClientSet:   false,
@@ -416,7 +440,7 @@ func TestConfigure(t *testing.T) {
 			name:            "fails with empty api_key",
 			version:         "1.0.0",
 			apiKey:          stringPtr(""),
-			baseURL:         stringPtr("https://n8n.example.com"),
+			baseURL:         stringPtr(testInstanceURL(t)),
 			wantErr:         true,
 			wantErrContains: "Missing API Key",
 			wantClientSet:   false,
@@ -476,13 +500,7 @@ func TestConfigure(t *testing.T) {
 				configAttrs["base_url"] = tftypes.NewValue(tftypes.String, nil)
 			}
 
-			configValue := tftypes.NewValue(
-				tftypes.Object{
-					AttributeTypes: map[string]tftypes.Type{
-						"api_key":  tftypes.String,
-						"base_url": tftypes.String,
-					},
-				},
+			configValue := newProviderConfigValue(
 				configAttrs,
 			)
 
@@ -605,16 +623,10 @@ func TestConfigure_ContextCancellation(t *testing.T) {
 				ctx, cancel := context.WithCancel(context.Background())
 				cancel() // Cancel immediately
 
-				configValue := tftypes.NewValue(
-					tftypes.Object{
-						AttributeTypes: map[string]tftypes.Type{
-							"api_key":  tftypes.String,
-							"base_url": tftypes.String,
-						},
-					},
+				configValue := newProviderConfigValue(
 					map[string]tftypes.Value{
 						"api_key":  tftypes.NewValue(tftypes.String, "test-api-key"),
-						"base_url": tftypes.NewValue(tftypes.String, "https://n8n.example.com"),
+						"base_url": tftypes.NewValue(tftypes.String, testInstanceURL(t)),
 					},
 				)
 
@@ -643,16 +655,10 @@ func TestConfigure_ContextCancellation(t *testing.T) {
 				prov := p.NewN8nProvider("1.0.0")
 				ctx := context.Background()
 
-				configValue := tftypes.NewValue(
-					tftypes.Object{
-						AttributeTypes: map[string]tftypes.Type{
-							"api_key":  tftypes.String,
-							"base_url": tftypes.String,
-						},
-					},
+				configValue := newProviderConfigValue(
 					map[string]tftypes.Value{
 						"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
-						"base_url": tftypes.NewValue(tftypes.String, "https://test.com"),
+						"base_url": tftypes.NewValue(tftypes.String, testInstanceURL(t)),
 					},
 				)
 
@@ -824,6 +830,45 @@ func TestDataSources(t *testing.T) {
 	}
 }
 
+func TestFunctions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		version   string
+		wantNames []string
+	}{
+		{
+			name:      "returns workflow functions",
+			version:   "1.0.0",
+			wantNames: []string{"node", "connect", "merge_connections", "normalize_workflow"},
+		},
+		{
+			name:      "error case - empty version returns functions",
+			version:   "",
+			wantNames: []string{"node", "connect", "merge_connections", "normalize_workflow"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prov := p.NewN8nProvider(tt.version)
+			functions := prov.Functions(context.Background())
+
+			names := make([]string, 0, len(functions))
+			for i, factory := range functions {
+				assert.NotNil(t, factory, "Function factory %d should not be nil", i)
+				resp := &function.MetadataResponse{}
+				factory().Metadata(context.Background(), function.MetadataRequest{}, resp)
+				names = append(names, resp.Name)
+			}
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+}
+
 func TestDataSources_Stability(t *testing.T) {
 	t.Parallel()
 
@@ -1057,16 +1102,10 @@ func TestN8nProviderModelUsage(t *testing.T) {
 
 	prov := p.NewN8nProvider("1.0.0")
 
-	configValue := tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"api_key":  tftypes.String,
-				"base_url": tftypes.String,
-			},
-		},
+	configValue := newProviderConfigValue(
 		map[string]tftypes.Value{
 			"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
-			"base_url": tftypes.NewValue(tftypes.String, "https://test.com"),
+			"base_url": tftypes.NewValue(tftypes.String, testInstanceURL(t)),
 		},
 	)
 
@@ -1200,7 +1239,7 @@ func TestConfigureClientCreation(t *testing.T) {
 		{
 			name:       "creates client with correct base URL",
 			version:    "1.0.0",
-			baseURL:    "https://n8n.example.com",
+			baseURL:    testInstanceURL(t),
 			apiKey:     "test-key",
 			wantErr:    false,
 			wantClient: true,
@@ -1208,7 +1247,7 @@ func TestConfigureClientCreation(t *testing.T) {
 		{
 			name:       "creates client with different URL",
 			version:    "1.0.0",
-			baseURL:    "https://another.example.com",
+			baseURL:    testInstanceURL(t) + "/another",
 			apiKey:     "test-key-2",
 			wantErr:    false,
 			wantClient: true,
@@ -1221,13 +1260,7 @@ func TestConfigureClientCreation(t *testing.T) {
 
 			prov := p.NewN8nProvider(tt.version)
 
-			configValue := tftypes.NewValue(
-				tftypes.Object{
-					AttributeTypes: map[string]tftypes.Type{
-						"api_key":  tftypes.String,
-						"base_url": tftypes.String,
-					},
-				},
+			configValue := newProviderConfigValue(
 				map[string]tftypes.Value{
 					"api_key":  tftypes.NewValue(tftypes.String, tt.apiKey),
 					"base_url": tftypes.NewValue(tftypes.String, tt.baseURL),
@@ -1266,3 +1299,567 @@ func TestConfigureClientCreation(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+// newProviderConfigValue builds a provider configuration object matching the
+// provider schema, leaving every attribute not present in values as null.
+func newProviderConfigValue(values map[string]tftypes.Value) tftypes.Value {
+	schemaResp := &provider.SchemaResponse{}
+	p.NewN8nProvider("test").Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	return tftypes.NewValue(objectType, attrs)
+}
+
+func TestConfigure_RetryOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		maxRetries      tftypes.Value
+		retryWaitMin    tftypes.Value
+		retryWaitMax    tftypes.Value
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:         "configures with custom retry settings",
+			maxRetries:   tftypes.NewValue(tftypes.Number, 5),
+			retryWaitMin: tftypes.NewValue(tftypes.String, "500ms"),
+			retryWaitMax: tftypes.NewValue(tftypes.String, "10s"),
+			wantErr:      false,
+		},
+		{
+			name:         "configures with retries disabled",
+			maxRetries:   tftypes.NewValue(tftypes.Number, 0),
+			retryWaitMin: tftypes.NewValue(tftypes.String, nil),
+			retryWaitMax: tftypes.NewValue(tftypes.String, nil),
+			wantErr:      false,
+		},
+		{
+			name:            "error case - invalid duration",
+			maxRetries:      tftypes.NewValue(tftypes.Number, nil),
+			retryWaitMin:    tftypes.NewValue(tftypes.String, "soon"),
+			retryWaitMax:    tftypes.NewValue(tftypes.String, nil),
+			wantErr:         true,
+			wantErrContains: "Invalid Duration",
+		},
+		{
+			name:            "error case - minimum above maximum",
+			maxRetries:      tftypes.NewValue(tftypes.Number, nil),
+			retryWaitMin:    tftypes.NewValue(tftypes.String, "1m"),
+			retryWaitMax:    tftypes.NewValue(tftypes.String, "1s"),
+			wantErr:         true,
+			wantErrContains: "Invalid Retry Configuration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prov := p.NewN8nProvider("1.0.0")
+			configValue := newProviderConfigValue(map[string]tftypes.Value{
+				"api_key":        tftypes.NewValue(tftypes.String, "test-key"),
+				"base_url":       tftypes.NewValue(tftypes.String, testInstanceURL(t)),
+				"max_retries":    tt.maxRetries,
+				"retry_wait_min": tt.retryWaitMin,
+				"retry_wait_max": tt.retryWaitMax,
+			})
+
+			schemaResp := &provider.SchemaResponse{}
+			prov.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+			req := provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configValue},
+			}
+			resp := &provider.ConfigureResponse{}
+
+			prov.Configure(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			if tt.wantErr {
+				require.NotEmpty(t, resp.Diagnostics.Errors())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.wantErrContains)
+				assert.Nil(t, resp.ResourceData, "ResourceData should not be set on error")
+			} else {
+				assert.NotNil(t, resp.ResourceData, "ResourceData should be set")
+			}
+		})
+	}
+}
+
+func TestValidateConfig_TLS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		values          map[string]tftypes.Value
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:    "accepts configuration without TLS settings",
+			values:  map[string]tftypes.Value{},
+			wantErr: false,
+		},
+		{
+			name: "accepts insecure skip verify",
+			values: map[string]tftypes.Value{
+				"insecure_skip_verify": tftypes.NewValue(tftypes.Bool, true),
+			},
+			wantErr: false,
+		},
+		{
+			name: "error case - conflicting CA attributes",
+			values: map[string]tftypes.Value{
+				"ca_cert_pem":  tftypes.NewValue(tftypes.String, "pem"),
+				"ca_cert_file": tftypes.NewValue(tftypes.String, "/etc/n8n/ca.pem"),
+			},
+			wantErr:         true,
+			wantErrContains: "Conflicting TLS Configuration",
+		},
+		{
+			name: "error case - client key without certificate",
+			values: map[string]tftypes.Value{
+				"client_key_file": tftypes.NewValue(tftypes.String, "/etc/n8n/client.key"),
+			},
+			wantErr:         true,
+			wantErrContains: "Incomplete TLS Client Certificate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prov := p.NewN8nProvider("1.0.0")
+			schemaResp := &provider.SchemaResponse{}
+			prov.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+			req := provider.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newProviderConfigValue(tt.values)},
+			}
+			resp := &provider.ValidateConfigResponse{}
+
+			prov.ValidateConfig(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			if tt.wantErr {
+				require.NotEmpty(t, resp.Diagnostics.Errors())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.wantErrContains)
+			}
+		})
+	}
+}
+
+func TestConfigure_TLSOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		values          map[string]tftypes.Value
+		wantErr         bool
+		wantWarning     bool
+		wantErrContains string
+	}{
+		{
+			name: "configures with insecure skip verify",
+			values: map[string]tftypes.Value{
+				"insecure_skip_verify": tftypes.NewValue(tftypes.Bool, true),
+			},
+			wantErr:     false,
+			wantWarning: true,
+		},
+		{
+			name: "error case - unreadable CA file",
+			values: map[string]tftypes.Value{
+				"ca_cert_file": tftypes.NewValue(tftypes.String, "/nonexistent/n8n/ca.pem"),
+			},
+			wantErr:         true,
+			wantErrContains: "Unable to Read File",
+		},
+		{
+			name: "error case - invalid CA certificate",
+			values: map[string]tftypes.Value{
+				"ca_cert_pem": tftypes.NewValue(tftypes.String, "not a certificate"),
+			},
+			wantErr:         true,
+			wantErrContains: "Invalid TLS Configuration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			values := map[string]tftypes.Value{
+				"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+				"base_url": tftypes.NewValue(tftypes.String, testInstanceURL(t)),
+			}
+			for name, value := range tt.values {
+				values[name] = value
+			}
+
+			prov := p.NewN8nProvider("1.0.0")
+			schemaResp := &provider.SchemaResponse{}
+			prov.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+			req := provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newProviderConfigValue(values)},
+			}
+			resp := &provider.ConfigureResponse{}
+
+			prov.Configure(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.wantWarning, resp.Diagnostics.WarningsCount() > 0)
+			if tt.wantErr {
+				require.NotEmpty(t, resp.Diagnostics.Errors())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.wantErrContains)
+				assert.Nil(t, resp.ResourceData, "ResourceData should not be set on error")
+			} else {
+				assert.NotNil(t, resp.ResourceData, "ResourceData should be set")
+			}
+		})
+	}
+}
+
+func TestConfigure_DefaultProjectID(t *testing.T) {
+	tests := []struct {
+		name        string
+		configValue tftypes.Value
+		envValue    string
+		want        string
+	}{
+		{name: "uses configured default project", configValue: tftypes.NewValue(tftypes.String, "project-config"), envValue: "project-env", want: "project-config"},
+		{name: "falls back to N8N_PROJECT_ID", configValue: tftypes.NewValue(tftypes.String, nil), envValue: "project-env", want: "project-env"},
+		{name: "error case - empty when unset", configValue: tftypes.NewValue(tftypes.String, nil), envValue: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("N8N_PROJECT_ID", tt.envValue)
+
+			prov := p.NewN8nProvider("1.0.0")
+			schemaResp := &provider.SchemaResponse{}
+			prov.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+			req := provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newProviderConfigValue(map[string]tftypes.Value{
+					"api_key":            tftypes.NewValue(tftypes.String, "test-key"),
+					"base_url":           tftypes.NewValue(tftypes.String, testInstanceURL(t)),
+					"default_project_id": tt.configValue,
+				})},
+			}
+			resp := &provider.ConfigureResponse{}
+
+			prov.Configure(context.Background(), req, resp)
+
+			require.False(t, resp.Diagnostics.HasError())
+			n8nClient, ok := resp.ResourceData.(*client.N8nClient)
+			require.True(t, ok, "ResourceData should be an N8nClient")
+			assert.Equal(t, tt.want, n8nClient.DefaultProjectID)
+		})
+	}
+}
+
+func TestConfigure_PageSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		configValue tftypes.Value
+		want        int64
+	}{
+		{name: "uses configured page size", configValue: tftypes.NewValue(tftypes.Number, 250), want: 250},
+		{name: "error case - defaults when unset", configValue: tftypes.NewValue(tftypes.Number, nil), want: client.DEFAULT_PAGE_SIZE},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prov := p.NewN8nProvider("1.0.0")
+			schemaResp := &provider.SchemaResponse{}
+			prov.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+			req := provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newProviderConfigValue(map[string]tftypes.Value{
+					"api_key":   tftypes.NewValue(tftypes.String, "test-key"),
+					"base_url":  tftypes.NewValue(tftypes.String, testInstanceURL(t)),
+					"page_size": tt.configValue,
+				})},
+			}
+			resp := &provider.ConfigureResponse{}
+
+			prov.Configure(context.Background(), req, resp)
+
+			require.False(t, resp.Diagnostics.HasError())
+			n8nClient, ok := resp.ResourceData.(*client.N8nClient)
+			require.True(t, ok, "ResourceData should be an N8nClient")
+			assert.Equal(t, tt.want, n8nClient.PageSize)
+		})
+	}
+}
+
+func TestConfigure_DefaultTags(t *testing.T) {
+	t.Parallel()
+
+	blockType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"names": tftypes.Set{ElementType: tftypes.String}}}
+
+	tests := []struct {
+		name        string
+		defaultTags tftypes.Value
+		want        []string
+	}{
+		{
+			name: "configures default tags",
+			defaultTags: tftypes.NewValue(blockType, map[string]tftypes.Value{
+				"names": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "managed-by-terraform"),
+				}),
+			}),
+			want: []string{"managed-by-terraform"},
+		},
+		{name: "error case - no default tags without block", defaultTags: tftypes.NewValue(blockType, nil), want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prov := p.NewN8nProvider("1.0.0")
+			schemaResp := &provider.SchemaResponse{}
+			prov.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+			req := provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newProviderConfigValue(map[string]tftypes.Value{
+					"api_key":      tftypes.NewValue(tftypes.String, "test-key"),
+					"base_url":     tftypes.NewValue(tftypes.String, testInstanceURL(t)),
+					"default_tags": tt.defaultTags,
+				})},
+			}
+			resp := &provider.ConfigureResponse{}
+
+			prov.Configure(context.Background(), req, resp)
+
+			require.False(t, resp.Diagnostics.HasError())
+			n8nClient, ok := resp.ResourceData.(*client.N8nClient)
+			require.True(t, ok, "ResourceData should be an N8nClient")
+			// Check configured names.
+			if tt.want == nil {
+				assert.Nil(t, n8nClient.DefaultTags)
+			} else {
+				require.NotNil(t, n8nClient.DefaultTags)
+				assert.Equal(t, tt.want, n8nClient.DefaultTags.Names())
+			}
+		})
+	}
+}
+
+func TestConfigure_InstanceDetection(t *testing.T) {
+	t.Parallel()
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	tests := []struct {
+		name        string
+		baseURL     string
+		wantVersion string
+		wantWarning string
+	}{
+		{name: "detects the instance", baseURL: testInstanceURL(t), wantVersion: "1.64.0"},
+		{name: "error case - unreachable instance", baseURL: unreachable.URL, wantWarning: "Unable to Reach n8n Instance"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prov := p.NewN8nProvider("1.0.0")
+			schemaResp := &provider.SchemaResponse{}
+			prov.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+			req := provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newProviderConfigValue(map[string]tftypes.Value{
+					"api_key":     tftypes.NewValue(tftypes.String, "test-key"),
+					"base_url":    tftypes.NewValue(tftypes.String, tt.baseURL),
+					"max_retries": tftypes.NewValue(tftypes.Number, 0),
+				})},
+			}
+			resp := &provider.ConfigureResponse{}
+
+			prov.Configure(context.Background(), req, resp)
+
+			// A failed detection only warns.
+			require.False(t, resp.Diagnostics.HasError())
+			n8nClient, ok := resp.ResourceData.(*client.N8nClient)
+			require.True(t, ok, "ResourceData should be an N8nClient")
+			require.NotNil(t, n8nClient.Instance, "Configure should store the detected instance")
+			assert.Equal(t, tt.wantVersion, n8nClient.Instance.Version)
+			// Check warnings.
+			if tt.wantWarning == "" {
+				assert.Empty(t, resp.Diagnostics.Warnings())
+				return
+			}
+			require.Len(t, resp.Diagnostics.Warnings(), 1)
+			assert.Equal(t, tt.wantWarning, resp.Diagnostics.Warnings()[0].Summary())
+		})
+	}
+}
+
+func TestConfigure_APIKeySources(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "api-key")
+	require.NoError(t, os.WriteFile(keyFile, []byte("file-key\n"), 0o600))
+	command := func(script string) tftypes.Value {
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "sh"),
+			tftypes.NewValue(tftypes.String, "-c"),
+			tftypes.NewValue(tftypes.String, script),
+		})
+	}
+
+	tests := []struct {
+		name    string
+		values  map[string]tftypes.Value
+		wantErr string
+	}{
+		{
+			name:   "configures with api_key_file",
+			values: map[string]tftypes.Value{"api_key_file": tftypes.NewValue(tftypes.String, keyFile)},
+		},
+		{
+			name:   "configures with api_key_command",
+			values: map[string]tftypes.Value{"api_key_command": command("echo command-key")},
+		},
+		{
+			name:    "error case - api_key_command exits non-zero",
+			values:  map[string]tftypes.Value{"api_key_command": command("echo 'vault sealed' >&2; exit 1")},
+			wantErr: "sh exited with status 1: vault sealed",
+		},
+		{
+			name: "error case - api_key conflicts with api_key_file",
+			values: map[string]tftypes.Value{
+				"api_key":      tftypes.NewValue(tftypes.String, "test-key"),
+				"api_key_file": tftypes.NewValue(tftypes.String, keyFile),
+			},
+			wantErr: "Only one of api_key, api_key_file, api_key_command can be set.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("N8N_API_KEY", "")
+
+			prov := p.NewN8nProvider("1.0.0")
+			schemaResp := &provider.SchemaResponse{}
+			prov.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+			tt.values["base_url"] = tftypes.NewValue(tftypes.String, testInstanceURL(t))
+			req := provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newProviderConfigValue(tt.values)},
+			}
+			resp := &provider.ConfigureResponse{}
+
+			prov.Configure(context.Background(), req, resp)
+
+			// Check diagnostics.
+			if tt.wantErr != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.wantErr)
+				assert.Nil(t, resp.ResourceData, "ResourceData should not be set on error")
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+			assert.NotNil(t, resp.ResourceData, "ResourceData should be set")
+		})
+	}
+}
+
+// TestConfigure_Profile tests that profiles come after the provider block and the environment variables.
+func TestConfigure_Profile(t *testing.T) {
+	instanceURL := testInstanceURL(t)
+	profiles := filepath.Join(t.TempDir(), "credentials.toml")
+	require.NoError(t, os.WriteFile(profiles, []byte(fmt.Sprintf("[staging]\nbase_url = %q\napi_key = \"staging-key\"\n", instanceURL+"/staging")), 0o600))
+
+	tests := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		envProfile  string
+		envBaseURL  string
+		wantBaseURL string
+		wantErr     string
+	}{
+		{
+			name:        "profile fills settings without environment variable",
+			values:      map[string]tftypes.Value{"profile": tftypes.NewValue(tftypes.String, "staging")},
+			wantBaseURL: instanceURL + "/staging",
+		},
+		{
+			name:        "N8N_API_URL wins over profile",
+			values:      map[string]tftypes.Value{"profile": tftypes.NewValue(tftypes.String, "staging")},
+			envBaseURL:  instanceURL + "/env",
+			wantBaseURL: instanceURL + "/env",
+		},
+		{
+			name:        "profile selected by N8N_PROFILE",
+			values:      map[string]tftypes.Value{},
+			envProfile:  "staging",
+			wantBaseURL: instanceURL + "/staging",
+		},
+		{
+			name: "provider block wins over profile",
+			values: map[string]tftypes.Value{
+				"profile":  tftypes.NewValue(tftypes.String, "staging"),
+				"base_url": tftypes.NewValue(tftypes.String, instanceURL+"/override"),
+			},
+			wantBaseURL: instanceURL + "/override",
+		},
+		{
+			name:    "error case - unknown profile",
+			values:  map[string]tftypes.Value{"profile": tftypes.NewValue(tftypes.String, "production")},
+			wantErr: `profile "production" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("N8N_API_URL", tt.envBaseURL)
+			t.Setenv("N8N_API_KEY", "env-key")
+			t.Setenv("N8N_PROFILE", tt.envProfile)
+
+			prov := p.NewN8nProvider("1.0.0")
+			schemaResp := &provider.SchemaResponse{}
+			prov.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+			tt.values["config_file"] = tftypes.NewValue(tftypes.String, profiles)
+			req := provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: newProviderConfigValue(tt.values)},
+			}
+			resp := &provider.ConfigureResponse{}
+
+			prov.Configure(context.Background(), req, resp)
+
+			// Check diagnostics.
+			if tt.wantErr != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.wantErr)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+			n8nClient, ok := resp.ResourceData.(*client.N8nClient)
+			require.True(t, ok, "ResourceData should be *client.N8nClient")
+			assert.Equal(t, tt.wantBaseURL, n8nClient.BaseURL)
+		})
+	}
+}
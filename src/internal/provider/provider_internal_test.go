@@ -112,3 +112,121 @@ func Test_getEnvBaseURL(t *testing.T) {
 		})
 	}
 }
+
+// Test_getEnvRequestsPerSecond tests the getEnvRequestsPerSecond function.
+func Test_getEnvRequestsPerSecond(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     string
+	}{
+		{name: "returns N8N_REQUESTS_PER_SECOND when set", envValue: "5", want: "5"},
+		{name: "returns fractional value", envValue: "0.5", want: "0.5"},
+		{name: "error case - returns empty string when not set", envValue: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("N8N_REQUESTS_PER_SECOND", tt.envValue)
+
+			assert.Equal(t, tt.want, getEnvRequestsPerSecond())
+		})
+	}
+}
+
+// Test_getEnvMaxConcurrentRequests tests the getEnvMaxConcurrentRequests function.
+func Test_getEnvMaxConcurrentRequests(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     string
+	}{
+		{name: "returns N8N_MAX_CONCURRENT_REQUESTS when set", envValue: "4", want: "4"},
+		{name: "error case - returns empty string when not set", envValue: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("N8N_MAX_CONCURRENT_REQUESTS", tt.envValue)
+
+			assert.Equal(t, tt.want, getEnvMaxConcurrentRequests())
+		})
+	}
+}
+
+// Test_getEnvProjectID tests the getEnvProjectID function.
+func Test_getEnvReadOnly(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     string
+	}{
+		{name: "returns N8N_READ_ONLY when set", envValue: "true", want: "true"},
+		{name: "error case - returns empty string when not set", envValue: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("N8N_READ_ONLY", tt.envValue)
+
+			assert.Equal(t, tt.want, getEnvReadOnly())
+		})
+	}
+}
+
+func Test_getEnvProjectID(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     string
+	}{
+		{name: "returns N8N_PROJECT_ID when set", envValue: "project-123", want: "project-123"},
+		{name: "error case - returns empty string when not set", envValue: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("N8N_PROJECT_ID", tt.envValue)
+
+			assert.Equal(t, tt.want, getEnvProjectID())
+		})
+	}
+}
+
+func Test_getEnvProfile(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     string
+	}{
+		{name: "returns N8N_PROFILE when set", envValue: "staging", want: "staging"},
+		{name: "error case - returns empty string when not set", envValue: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("N8N_PROFILE", tt.envValue)
+
+			assert.Equal(t, tt.want, getEnvProfile())
+		})
+	}
+}
+
+func Test_getEnvConfigFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     string
+	}{
+		{name: "returns N8N_CONFIG_FILE when set", envValue: "/etc/n8n/credentials.toml", want: "/etc/n8n/credentials.toml"},
+		{name: "error case - returns empty string when not set", envValue: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("N8N_CONFIG_FILE", tt.envValue)
+
+			assert.Equal(t, tt.want, getEnvConfigFile())
+		})
+	}
+}
@@ -6,9 +6,21 @@
 package client
 
 import (
+	"net/http"
+	"net/url"
+
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
 )
 
+// Pagination settings of the list endpoints.
+const (
+	// DEFAULT_PAGE_SIZE is the number of items fetched per page by list calls.
+	DEFAULT_PAGE_SIZE int64 = 100
+
+	// MAX_PAGE_SIZE is the largest page size accepted by the n8n API.
+	MAX_PAGE_SIZE int64 = 250
+)
+
 // N8nClient wraps the generated SDK client with provider-specific configuration.
 // It provides a convenient interface for resources to interact with the n8n API.
 type N8nClient struct {
@@ -20,9 +32,28 @@ type N8nClient struct {
 
 	// APIKey is the API key for authentication
 	APIKey string
+
+	// DefaultProjectID is the project used by project-scoped resources without project_id
+	DefaultProjectID string
+
+	// DefaultTags resolves the tags added to every workflow, nil when unset
+	DefaultTags *DefaultTags
+
+	// CommunityNodeAllowlist holds the node types and packages skipped by the node catalog validation
+	CommunityNodeAllowlist []string
+
+	// Instance holds the version and licensed features detected at configuration, nil disables feature checks
+	Instance *InstanceInfo
+
+	// PageSize is the number of items fetched per page by list calls
+	PageSize int64
+
+	// ListCache coalesces and caches list responses for the run, nil when disabled
+	ListCache *ListCache
 }
 
 // NewN8nClient creates a new N8nClient instance with the given configuration.
+// The client uses DefaultClientOptions for its HTTP transport.
 //
 // Params:
 //   - baseURL: the base URL of the n8n instance (e.g., "https://n8n.example.com")
@@ -31,6 +62,20 @@ type N8nClient struct {
 // Returns:
 //   - *N8nClient: configured client ready for API calls
 func NewN8nClient(baseURL, apiKey string) *N8nClient {
+	// Delegate with default options.
+	return NewN8nClientWithOptions(baseURL, apiKey, DefaultClientOptions())
+}
+
+// NewN8nClientWithOptions creates a new N8nClient instance with a customized HTTP transport.
+//
+// Params:
+//   - baseURL: the base URL of the n8n instance (e.g., "https://n8n.example.com")
+//   - apiKey: the API key for authentication
+//   - opts: options controlling the HTTP transport (retries, backoff, rate limits, TLS, headers, proxy, read-only)
+//
+// Returns:
+//   - *N8nClient: configured client ready for API calls
+func NewN8nClientWithOptions(baseURL, apiKey string, opts ClientOptions) *N8nClient {
 	// Create SDK configuration
 	cfg := n8nsdk.NewConfiguration()
 
@@ -42,9 +87,26 @@ func NewN8nClient(baseURL, apiKey string) *N8nClient {
 		},
 	}
 
+	// Add custom headers first so they can never replace the API key
+	for name, value := range opts.Headers {
+		cfg.AddDefaultHeader(name, value)
+	}
+
 	// Add API key to default headers
 	cfg.AddDefaultHeader("X-N8N-API-KEY", apiKey)
 
+	// Share list responses between concurrent reads of the same run
+	var listCache *ListCache
+	// Check for enabled cache.
+	if opts.ListCacheTTL > 0 {
+		listCache = NewListCache(apiPath(cfg.Servers[0].URL), opts.ListCacheTTL)
+	}
+
+	// Install the transport chain used by every SDK and raw request
+	cfg.HTTPClient = &http.Client{
+		Transport: newTransport(opts, listCache),
+	}
+
 	// Create the API client
 	apiClient := n8nsdk.NewAPIClient(cfg)
 
@@ -53,5 +115,52 @@ func NewN8nClient(baseURL, apiKey string) *N8nClient {
 		APIClient: apiClient,
 		BaseURL:   baseURL,
 		APIKey:    apiKey,
+		PageSize:  DEFAULT_PAGE_SIZE,
+		ListCache: listCache,
+	}
+}
+
+// apiPath returns the URL path of the API root.
+//
+// Params:
+//   - apiURL: URL of the API root (e.g., "https://n8n.example.com/api/v1")
+//
+// Returns:
+//   - string: URL path, empty when apiURL cannot be parsed
+func apiPath(apiURL string) string {
+	parsed, err := url.Parse(apiURL)
+	// Check for error.
+	if err != nil {
+		// Return empty path.
+		return ""
+	}
+	// Return path.
+	return parsed.Path
+}
+
+// newTransport builds the HTTP transport chain for the given options.
+// Retries wrap the rate limiter so every attempt consumes a token and an in-flight slot.
+// The read-only guard is outermost so refused requests are never retried nor throttled.
+// The list cache comes next so cached lists consume no rate limit token.
+// Logging is innermost so every attempt is logged with its own network latency.
+//
+// Params:
+//   - opts: options controlling the transport
+//   - listCache: cache of list responses, nil disables caching
+//
+// Returns:
+//   - http.RoundTripper: transport chain used by the HTTP client
+func newTransport(opts ClientOptions, listCache *ListCache) http.RoundTripper {
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	// Apply custom TLS settings (CA bundle, client certificate, verification)
+	if opts.TLSConfig != nil {
+		base.TLSClientConfig = opts.TLSConfig
 	}
+	// Route requests through an explicit proxy instead of HTTP_PROXY/HTTPS_PROXY
+	if opts.ProxyURL != nil {
+		base.Proxy = http.ProxyURL(opts.ProxyURL)
+	}
+
+	// Return the guarded caching retrying transport wrapping the limited base transport.
+	return newReadOnlyTransport(newListCacheTransport(newRetryTransport(newRateLimitTransport(newLoggingTransport(base, opts), opts), opts), listCache), opts)
 }
@@ -1,8 +1,14 @@
 package client_test
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/stretchr/testify/assert"
@@ -132,6 +138,7 @@ func TestNewN8nClient(t *testing.T) {
 				for _, apiKey := range testKeys {
 					c := client.NewN8nClient("https://n8n.example.com", apiKey)
 					assert.Equal(t, apiKey, c.APIKey)
+					assert.Equal(t, client.DEFAULT_PAGE_SIZE, c.PageSize)
 
 					defaultHeaders := c.APIClient.GetConfig().DefaultHeader
 					assert.Equal(t, apiKey, defaultHeaders["X-N8N-API-KEY"])
@@ -258,3 +265,143 @@ func TestN8nClientEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestNewN8nClientWithOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		opts         client.ClientOptions
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{
+			name:         "retries transient errors with default-like options",
+			opts:         client.ClientOptions{MaxRetries: 2, RetryWaitMin: time.Millisecond, RetryWaitMax: 2 * time.Millisecond},
+			wantAttempts: 2,
+			wantErr:      false,
+		},
+		{
+			name:         "error case - retries disabled surfaces the first failure",
+			opts:         client.ClientOptions{},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if attempts.Add(1) == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":[]}`))
+			}))
+			defer server.Close()
+
+			c := client.NewN8nClientWithOptions(server.URL, "test-key", tt.opts)
+			require.NotNil(t, c.APIClient.GetConfig().HTTPClient, "HTTP client should be installed")
+
+			_, httpResp, err := c.APIClient.TagsAPI.TagsGet(context.Background()).Execute()
+			if httpResp != nil {
+				defer httpResp.Body.Close()
+			}
+
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantAttempts, attempts.Load())
+		})
+	}
+}
+
+func TestNewN8nClientWithOptions_HeadersAndProxy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+	}{
+		{name: "sends custom headers alongside the API key"},
+		{name: "custom headers cannot replace the API key"},
+		{name: "routes requests through the proxy"},
+		{name: "error case - unreachable proxy"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var received http.Header
+			var requestURI string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r.Header.Clone()
+				requestURI = r.RequestURI
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":[]}`))
+			}))
+			defer server.Close()
+
+			switch tt.name {
+			case "sends custom headers alongside the API key":
+				c := client.NewN8nClientWithOptions(server.URL, "test-key", client.ClientOptions{
+					Headers: map[string]string{"CF-Access-Client-Id": "client-id", "Authorization": "Bearer token"},
+				})
+				_, httpResp, err := c.APIClient.TagsAPI.TagsGet(context.Background()).Execute()
+				require.NoError(t, err)
+				defer httpResp.Body.Close()
+
+				assert.Equal(t, "client-id", received.Get("CF-Access-Client-Id"))
+				assert.Equal(t, "Bearer token", received.Get("Authorization"))
+				assert.Equal(t, "test-key", received.Get("X-N8N-API-KEY"))
+
+			case "custom headers cannot replace the API key":
+				c := client.NewN8nClientWithOptions(server.URL, "test-key", client.ClientOptions{
+					Headers: map[string]string{"X-N8N-API-KEY": "other-key"},
+				})
+				_, httpResp, err := c.APIClient.TagsAPI.TagsGet(context.Background()).Execute()
+				require.NoError(t, err)
+				defer httpResp.Body.Close()
+
+				assert.Equal(t, "test-key", received.Get("X-N8N-API-KEY"))
+
+			case "routes requests through the proxy":
+				proxyURL, err := url.Parse(server.URL)
+				require.NoError(t, err)
+
+				c := client.NewN8nClientWithOptions("http://n8n.internal.invalid", "test-key", client.ClientOptions{ProxyURL: proxyURL})
+				_, httpResp, err := c.APIClient.TagsAPI.TagsGet(context.Background()).Execute()
+				require.NoError(t, err)
+				defer httpResp.Body.Close()
+
+				assert.Equal(t, "http://n8n.internal.invalid/api/v1/tags", requestURI, "Proxy should receive the absolute target URL")
+
+			case "error case - unreachable proxy":
+				proxyURL := &url.URL{Scheme: "http", Host: "127.0.0.1:1"}
+
+				c := client.NewN8nClientWithOptions(server.URL, "test-key", client.ClientOptions{ProxyURL: proxyURL})
+				_, httpResp, err := c.APIClient.TagsAPI.TagsGet(context.Background()).Execute()
+				if httpResp != nil {
+					defer httpResp.Body.Close()
+				}
+				assert.Error(t, err)
+				assert.Nil(t, received, "Server should not be reached directly")
+			}
+		})
+	}
+}
+
+func TestDefaultClientOptions(t *testing.T) {
+	t.Parallel()
+
+	opts := client.DefaultClientOptions()
+
+	assert.Equal(t, client.DEFAULT_MAX_RETRIES, opts.MaxRetries)
+	assert.Equal(t, client.DEFAULT_RETRY_WAIT_MIN, opts.RetryWaitMin)
+	assert.Equal(t, client.DEFAULT_RETRY_WAIT_MAX, opts.RetryWaitMax)
+	assert.LessOrEqual(t, opts.RetryWaitMin, opts.RetryWaitMax)
+}
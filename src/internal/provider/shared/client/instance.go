@@ -0,0 +1,316 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+)
+
+// Feature is a licensed n8n capability that some resources depend on.
+type Feature string
+
+// Licensed features detected on the n8n instance.
+const (
+	// FEATURE_PROJECTS is the team projects feature.
+	FEATURE_PROJECTS Feature = "projects"
+
+	// FEATURE_VARIABLES is the environment variables feature.
+	FEATURE_VARIABLES Feature = "variables"
+)
+
+// Editions reported for the n8n instance.
+const (
+	// EDITION_COMMUNITY is reported when no enterprise feature is licensed.
+	EDITION_COMMUNITY string = "community"
+
+	// EDITION_ENTERPRISE is reported when at least one enterprise feature is licensed.
+	EDITION_ENTERPRISE string = "enterprise"
+)
+
+// SETTINGS_PATH is the path of the n8n frontend settings endpoint, relative to the base URL.
+const SETTINGS_PATH string = "/rest/settings"
+
+// ErrInstanceUnreachable is returned when the n8n instance does not answer the detection probe.
+var ErrInstanceUnreachable error = errors.New("n8n instance is unreachable")
+
+// InstanceInfo describes the version and licensed features of an n8n instance.
+type InstanceInfo struct {
+	// Version is the n8n version, empty when unknown
+	Version string
+
+	// Edition is EDITION_COMMUNITY or EDITION_ENTERPRISE, empty when unknown
+	Edition string
+
+	// Features holds the detected features, absent when unknown
+	Features map[Feature]bool
+}
+
+// HasFeature reports whether a feature is licensed on the instance.
+//
+// Params:
+//   - feature: feature to check
+//
+// Returns:
+//   - bool: true when the feature is licensed
+//   - bool: true when the feature could be detected
+func (i *InstanceInfo) HasFeature(feature Feature) (bool, bool) {
+	licensed, known := i.Features[feature]
+	// Return detection result.
+	return licensed, known
+}
+
+// String describes the instance as "<edition> <version>".
+//
+// Returns:
+//   - string: human readable description
+func (i *InstanceInfo) String() string {
+	edition := i.Edition
+	// Describe unknown edition.
+	if edition == "" {
+		edition = "unknown edition"
+	}
+	version := i.Version
+	// Describe unknown version.
+	if version == "" {
+		version = "(unknown version)"
+	}
+	// Return description.
+	return edition + " " + version
+}
+
+// settingsResponse is the subset of the /rest/settings payload used for detection.
+type settingsResponse struct {
+	VersionCli string `json:"versionCli"`
+	Enterprise *struct {
+		Variables *bool `json:"variables"`
+		Projects  *struct {
+			Team *struct {
+				Limit *int `json:"limit"`
+			} `json:"team"`
+		} `json:"projects"`
+	} `json:"enterprise"`
+}
+
+// DetectInstance probes the settings endpoint, then the public API for undetected features.
+// Features whose probe fails are left unknown so that feature checks skip them.
+//
+// Params:
+//   - ctx: context for the probes
+//   - baseURL: base URL of the n8n instance
+//   - api: SDK client carrying the HTTP transport and headers
+//
+// Returns:
+//   - *InstanceInfo: detected information, never nil
+//   - []error: probe failures, wrapping ErrInstanceUnreachable when the instance does not answer
+func DetectInstance(ctx context.Context, baseURL string, api *n8nsdk.APIClient) (*InstanceInfo, []error) {
+	info := &InstanceInfo{Features: make(map[Feature]bool)}
+	var failures []error
+
+	settings, err := fetchSettings(ctx, strings.TrimRight(baseURL, "/"), api)
+	// Check for error.
+	if err != nil {
+		// The public API probes would fail the same way.
+		if errors.Is(err, ErrInstanceUnreachable) {
+			// Return error.
+			return info, []error{err}
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Could not read n8n settings: %s", err.Error()))
+	} else {
+		applySettings(settings, info)
+	}
+
+	// Fall back to the public API for features missing from the settings.
+	if _, known := info.Features[FEATURE_PROJECTS]; !known {
+		err := probeFeature(FEATURE_PROJECTS, info, func() (*http.Response, error) {
+			_, httpResp, err := api.ProjectsAPI.ProjectsGet(ctx).Limit(1).Execute()
+			// Return probe result.
+			return httpResp, err
+		})
+		// Check for error.
+		if err != nil {
+			failures = append(failures, err)
+		}
+	}
+	// Check for unknown value.
+	if _, known := info.Features[FEATURE_VARIABLES]; !known {
+		err := probeFeature(FEATURE_VARIABLES, info, func() (*http.Response, error) {
+			_, httpResp, err := api.VariablesAPI.VariablesGet(ctx).Limit(1).Execute()
+			// Return probe result.
+			return httpResp, err
+		})
+		// Check for error.
+		if err != nil {
+			failures = append(failures, err)
+		}
+	}
+
+	// Derive the edition from the licensed features.
+	if len(info.Features) > 0 {
+		info.Edition = EDITION_COMMUNITY
+		// Any licensed feature means an enterprise license.
+		for _, licensed := range info.Features {
+			// Check licensed feature.
+			if licensed {
+				info.Edition = EDITION_ENTERPRISE
+				break
+			}
+		}
+	}
+
+	// Return detected information.
+	return info, failures
+}
+
+// fetchSettings reads the frontend settings of the instance.
+//
+// Params:
+//   - ctx: context for the request
+//   - baseURL: base URL of the n8n instance, without trailing slash
+//   - api: SDK client carrying the HTTP transport and headers
+//
+// Returns:
+//   - *settingsResponse: decoded settings
+//   - error: error if the request fails or the payload is invalid
+func fetchSettings(ctx context.Context, baseURL string, api *n8nsdk.APIClient) (*settingsResponse, error) {
+	cfg := api.GetConfig()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+SETTINGS_PATH, nil)
+	// Check for error.
+	if err != nil {
+		// Return error.
+		return nil, err
+	}
+	// Forward the API key and every custom provider header.
+	for name, value := range cfg.DefaultHeader {
+		httpReq.Header.Set(name, value)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+
+	httpClient := cfg.HTTPClient
+	// Check for nil value.
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	httpResp, err := httpClient.Do(httpReq)
+	// Check for error.
+	if err != nil {
+		// Return error.
+		return nil, fmt.Errorf("%w: %w", ErrInstanceUnreachable, err)
+	}
+	defer httpResp.Body.Close()
+
+	// Check HTTP status.
+	if httpResp.StatusCode != http.StatusOK {
+		// Return error.
+		return nil, fmt.Errorf("unexpected status %d", httpResp.StatusCode)
+	}
+	body, err := io.ReadAll(httpResp.Body)
+	// Check for error.
+	if err != nil {
+		// Return error.
+		return nil, err
+	}
+
+	// The REST API wraps payloads in a data envelope.
+	var envelope struct {
+		Data *settingsResponse `json:"data"`
+	}
+	// Check for error.
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		// Return error.
+		return nil, err
+	}
+	// Check for missing envelope.
+	if envelope.Data == nil {
+		// Return error.
+		return nil, fmt.Errorf("settings payload has no data")
+	}
+
+	// Return settings.
+	return envelope.Data, nil
+}
+
+// applySettings copies the version and licensed features from the settings.
+//
+// Params:
+//   - settings: decoded settings
+//   - info: information to update
+func applySettings(settings *settingsResponse, info *InstanceInfo) {
+	info.Version = settings.VersionCli
+	// Nothing more without enterprise section.
+	if settings.Enterprise == nil {
+		// Return early.
+		return
+	}
+
+	// Check for non-nil value.
+	if settings.Enterprise.Variables != nil {
+		info.Features[FEATURE_VARIABLES] = *settings.Enterprise.Variables
+	}
+	// Team projects are licensed when the limit is not zero (-1 means unlimited).
+	if projects := settings.Enterprise.Projects; projects != nil && projects.Team != nil && projects.Team.Limit != nil {
+		info.Features[FEATURE_PROJECTS] = *projects.Team.Limit != 0
+	}
+}
+
+// probeFeature detects a feature from the response of a public API call.
+// A success means licensed, a license error means unlicensed, anything else leaves it unknown.
+//
+// Params:
+//   - feature: feature to detect
+//   - info: information to update
+//   - call: API call listing a resource of the feature
+//
+// Returns:
+//   - error: probe failure when the feature stays unknown
+func probeFeature(feature Feature, info *InstanceInfo, call func() (*http.Response, error)) error {
+	httpResp, err := call()
+	// Check for non-nil value.
+	if httpResp != nil && httpResp.Body != nil {
+		defer httpResp.Body.Close()
+	}
+
+	// Check for success.
+	if err == nil {
+		info.Features[feature] = true
+		// Return success.
+		return nil
+	}
+
+	// Only a license error proves the feature is missing.
+	if httpResp != nil && httpResp.StatusCode == http.StatusForbidden && isLicenseError(err) {
+		info.Features[feature] = false
+		// Return success.
+		return nil
+	}
+
+	// Return error.
+	return fmt.Errorf("could not detect the %s feature: %w", feature, err)
+}
+
+// isLicenseError reports whether an API error was caused by a missing license.
+//
+// Params:
+//   - err: error returned by the SDK
+//
+// Returns:
+//   - bool: true when the error body mentions the license
+func isLicenseError(err error) bool {
+	message := err.Error()
+	// Include the response body of SDK errors.
+	if apiErr, ok := err.(*n8nsdk.GenericOpenAPIError); ok {
+		message += " " + string(apiErr.Body())
+	}
+	// Return result.
+	return strings.Contains(strings.ToLower(message), "license")
+}
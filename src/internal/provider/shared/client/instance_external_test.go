@@ -0,0 +1,187 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// instanceTestServer serves the endpoints probed by the instance detector.
+func instanceTestServer(t *testing.T, settings string, projectsStatus, variablesStatus int, calls *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	licenseError := `{"message":"Your license does not allow for feat:variables."}`
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/settings":
+			if settings == "" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(settings))
+		case "/api/v1/projects":
+			w.WriteHeader(projectsStatus)
+			if projectsStatus == http.StatusOK {
+				w.Write([]byte(`{"data":[]}`))
+				return
+			}
+			w.Write([]byte(licenseError))
+		case "/api/v1/variables":
+			w.WriteHeader(variablesStatus)
+			if variablesStatus == http.StatusOK {
+				w.Write([]byte(`{"data":[]}`))
+				return
+			}
+			w.Write([]byte(licenseError))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestDetectInstance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		settings        string
+		projectsStatus  int
+		variablesStatus int
+		wantVersion     string
+		wantEdition     string
+		wantFeatures    map[client.Feature]bool
+		wantString      string
+		wantFailures    int
+	}{
+		{
+			name:        "enterprise instance from settings",
+			settings:    `{"data":{"versionCli":"1.64.0","enterprise":{"variables":true,"projects":{"team":{"limit":-1}}}}}`,
+			wantVersion: "1.64.0",
+			wantEdition: client.EDITION_ENTERPRISE,
+			wantFeatures: map[client.Feature]bool{
+				client.FEATURE_PROJECTS:  true,
+				client.FEATURE_VARIABLES: true,
+			},
+			wantString: "enterprise 1.64.0",
+		},
+		{
+			name:        "community instance from settings",
+			settings:    `{"data":{"versionCli":"1.70.2","enterprise":{"variables":false,"projects":{"team":{"limit":0}}}}}`,
+			wantVersion: "1.70.2",
+			wantEdition: client.EDITION_COMMUNITY,
+			wantFeatures: map[client.Feature]bool{
+				client.FEATURE_PROJECTS:  false,
+				client.FEATURE_VARIABLES: false,
+			},
+			wantString: "community 1.70.2",
+		},
+		{
+			name:            "falls back to the public API without settings",
+			projectsStatus:  http.StatusOK,
+			variablesStatus: http.StatusForbidden,
+			wantEdition:     client.EDITION_ENTERPRISE,
+			wantFeatures: map[client.Feature]bool{
+				client.FEATURE_PROJECTS:  true,
+				client.FEATURE_VARIABLES: false,
+			},
+			wantString: "enterprise (unknown version)",
+		},
+		{
+			name:            "completes partial settings with the public API",
+			settings:        `{"data":{"versionCli":"1.50.0"}}`,
+			projectsStatus:  http.StatusForbidden,
+			variablesStatus: http.StatusForbidden,
+			wantVersion:     "1.50.0",
+			wantEdition:     client.EDITION_COMMUNITY,
+			wantFeatures: map[client.Feature]bool{
+				client.FEATURE_PROJECTS:  false,
+				client.FEATURE_VARIABLES: false,
+			},
+			wantString: "community 1.50.0",
+		},
+		{
+			name:            "error case - nothing detected",
+			projectsStatus:  http.StatusInternalServerError,
+			variablesStatus: http.StatusUnauthorized,
+			wantFeatures:    map[client.Feature]bool{},
+			wantString:      "unknown edition (unknown version)",
+			wantFailures:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := instanceTestServer(t, tt.settings, tt.projectsStatus, tt.variablesStatus, &calls)
+			defer server.Close()
+
+			c := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{})
+
+			info, failures := client.DetectInstance(context.Background(), c.BaseURL, c.APIClient)
+
+			assert.Equal(t, tt.wantVersion, info.Version)
+			assert.Equal(t, tt.wantEdition, info.Edition)
+			assert.Equal(t, tt.wantFeatures, info.Features)
+			assert.Equal(t, tt.wantString, info.String())
+			assert.Len(t, failures, tt.wantFailures)
+		})
+	}
+}
+
+func TestDetectInstance_unreachable(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := instanceTestServer(t, "", http.StatusOK, http.StatusOK, &calls)
+	baseURL := server.URL
+	server.Close()
+
+	c := client.NewN8nClientWithOptions(baseURL, "key", client.ClientOptions{})
+
+	info, failures := client.DetectInstance(context.Background(), c.BaseURL, c.APIClient)
+
+	// Check that the public API is not probed once the instance is unreachable.
+	assert.Empty(t, info.Features)
+	assert.Len(t, failures, 1)
+	assert.ErrorIs(t, failures[0], client.ErrInstanceUnreachable)
+}
+
+func TestInstanceInfo_HasFeature(t *testing.T) {
+	t.Parallel()
+
+	info := &client.InstanceInfo{Features: map[client.Feature]bool{
+		client.FEATURE_PROJECTS:  true,
+		client.FEATURE_VARIABLES: false,
+	}}
+
+	tests := []struct {
+		name         string
+		feature      client.Feature
+		wantLicensed bool
+		wantKnown    bool
+	}{
+		{name: "licensed feature", feature: client.FEATURE_PROJECTS, wantLicensed: true, wantKnown: true},
+		{name: "unlicensed feature", feature: client.FEATURE_VARIABLES, wantLicensed: false, wantKnown: true},
+		{name: "error case - undetected feature", feature: client.Feature("unknown"), wantLicensed: false, wantKnown: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			licensed, known := info.HasFeature(tt.feature)
+
+			assert.Equal(t, tt.wantLicensed, licensed)
+			assert.Equal(t, tt.wantKnown, known)
+		})
+	}
+}
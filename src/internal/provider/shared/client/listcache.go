@@ -0,0 +1,314 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DEFAULT_LIST_CACHE_TTL is the time a list response is reused by concurrent reads of one run.
+const DEFAULT_LIST_CACHE_TTL time.Duration = 30 * time.Second
+
+// ListCache coalesces and caches the responses of list endpoints (e.g., GET /variables),
+// so that refreshing N resources found by listing costs about one list call.
+// Entries are keyed by endpoint and query, expire after a short TTL and are dropped
+// by every mutating request sent through the client. It is safe for concurrent use.
+type ListCache struct {
+	// mu guards entries
+	mu sync.Mutex
+
+	// ttl is the lifetime of a cached response
+	ttl time.Duration
+
+	// apiPath is the URL path of the API root; list endpoints are its direct children
+	apiPath string
+
+	// entries are the cached and in-flight responses indexed by endpoint and query
+	entries map[string]*listCacheEntry
+
+	// now returns the current time, overridable in tests
+	now func() time.Time
+}
+
+// listCacheEntry is a list response, pending until ready is closed.
+type listCacheEntry struct {
+	// ready is closed once the response is available
+	ready chan struct{}
+
+	// ok reports whether the response was fetched successfully
+	ok bool
+
+	// status, statusCode, header and body hold the response
+	status     string
+	statusCode int
+	header     http.Header
+	body       []byte
+
+	// expires is the time after which the entry is refetched
+	expires time.Time
+}
+
+// NewListCache creates a list cache for the API rooted at apiPath.
+//
+// Params:
+//   - apiPath: URL path of the API root (e.g., "/api/v1")
+//   - ttl: lifetime of a cached response
+//
+// Returns:
+//   - *ListCache: empty cache
+func NewListCache(apiPath string, ttl time.Duration) *ListCache {
+	// Return empty cache.
+	return &ListCache{
+		ttl:     ttl,
+		apiPath: strings.TrimSuffix(apiPath, "/"),
+		entries: make(map[string]*listCacheEntry),
+		now:     time.Now,
+	}
+}
+
+// Invalidate drops every cached and in-flight list response.
+// Requests waiting on an in-flight response still receive it.
+func (c *ListCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+}
+
+// isList reports whether a request reads a list endpoint.
+//
+// Params:
+//   - req: request to check
+//
+// Returns:
+//   - bool: true for GET requests on a direct child of the API root
+func (c *ListCache) isList(req *http.Request) bool {
+	// Only plain reads are cached.
+	if req.Method != http.MethodGet && req.Method != "" {
+		// Return not cacheable.
+		return false
+	}
+	endpoint, found := strings.CutPrefix(req.URL.Path, c.apiPath+"/")
+	// Return whether the path names a collection.
+	return found && endpoint != "" && !strings.Contains(endpoint, "/")
+}
+
+// listCacheKey returns the cache key of a request, independent of the query parameter order.
+//
+// Params:
+//   - req: list request
+//
+// Returns:
+//   - string: endpoint and normalized query
+func listCacheKey(req *http.Request) string {
+	// Return key.
+	return req.URL.Path + "?" + req.URL.Query().Encode()
+}
+
+// fetch returns the cached response of a list request, fetching it once for concurrent callers.
+//
+// Params:
+//   - req: list request
+//   - next: transport performing the request on a cache miss
+//
+// Returns:
+//   - *http.Response: cached, shared or fresh response
+//   - error: error of next
+func (c *ListCache) fetch(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+	key := listCacheKey(req)
+
+	c.mu.Lock()
+	entry, found := c.entries[key]
+	// Drop expired responses.
+	if found && isClosed(entry.ready) && c.now().After(entry.expires) {
+		delete(c.entries, key)
+		found = false
+	}
+	// Lead the fetch on a miss.
+	if !found {
+		entry = &listCacheEntry{ready: make(chan struct{})}
+		c.entries[key] = entry
+		c.mu.Unlock()
+		// Return fresh response.
+		return c.lead(req, next, key, entry)
+	}
+	c.mu.Unlock()
+
+	// Wait for the leading request.
+	select {
+	case <-entry.ready:
+	case <-req.Context().Done():
+		// Return cancellation.
+		return nil, req.Context().Err()
+	}
+
+	// Fetch alone when the leading request failed, so its error is not shared.
+	if !entry.ok {
+		// Return response of next.
+		return next.RoundTrip(req)
+	}
+
+	// Return shared response.
+	return entry.response(req), nil
+}
+
+// lead performs a list request on behalf of every concurrent caller and caches a successful response.
+//
+// Params:
+//   - req: list request
+//   - next: transport performing the request
+//   - key: cache key of the request
+//   - entry: pending entry registered for the request
+//
+// Returns:
+//   - *http.Response: fresh response
+//   - error: error of next
+func (c *ListCache) lead(req *http.Request, next http.RoundTripper, key string, entry *listCacheEntry) (*http.Response, error) {
+	defer close(entry.ready)
+
+	resp, err := next.RoundTrip(req)
+	// Do not cache failed requests.
+	if err != nil {
+		c.forget(key, entry)
+		// Return error.
+		return nil, err
+	}
+
+	body, readErr := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	// Only cache complete successful responses.
+	if readErr != nil || resp.StatusCode != http.StatusOK {
+		c.forget(key, entry)
+		// Return response.
+		return resp, readErr
+	}
+
+	entry.ok = true
+	entry.status = resp.Status
+	entry.statusCode = resp.StatusCode
+	entry.header = resp.Header.Clone()
+	entry.body = body
+	entry.expires = c.now().Add(c.ttl)
+
+	// Return response.
+	return resp, nil
+}
+
+// forget removes an entry unless it was already replaced.
+//
+// Params:
+//   - key: cache key of the entry
+//   - entry: entry to remove
+func (c *ListCache) forget(key string, entry *listCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Keep a newer entry.
+	if c.entries[key] == entry {
+		delete(c.entries, key)
+	}
+}
+
+// response builds a response replaying the cached entry.
+//
+// Params:
+//   - req: request answered by the entry
+//
+// Returns:
+//   - *http.Response: response with its own body reader
+func (e *listCacheEntry) response(req *http.Request) *http.Response {
+	// Return replayed response.
+	return &http.Response{
+		Status:        e.status,
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// isClosed reports whether a channel is closed.
+//
+// Params:
+//   - ch: channel to check
+//
+// Returns:
+//   - bool: true when ch is closed
+func isClosed(ch chan struct{}) bool {
+	// Check without blocking.
+	select {
+	case <-ch:
+		// Return closed.
+		return true
+	default:
+		// Return open.
+		return false
+	}
+}
+
+// listCacheTransport is an http.RoundTripper serving list requests from a ListCache.
+type listCacheTransport struct {
+	// next is the transport performing the requests
+	next http.RoundTripper
+
+	// cache holds the list responses
+	cache *ListCache
+}
+
+// newListCacheTransport wraps a transport with a list cache.
+// Returns next unchanged when cache is nil.
+//
+// Params:
+//   - next: transport performing the requests
+//   - cache: list cache, nil disables caching
+//
+// Returns:
+//   - http.RoundTripper: caching transport
+func newListCacheTransport(next http.RoundTripper, cache *ListCache) http.RoundTripper {
+	// Nothing to cache without cache.
+	if cache == nil {
+		// Return transport unchanged.
+		return next
+	}
+
+	// Return caching transport.
+	return &listCacheTransport{next: next, cache: cache}
+}
+
+// RoundTrip serves list requests from the cache and invalidates it around every mutating request.
+// The cache is dropped before and after the mutation, so a list fetched while it runs is not reused.
+//
+// Params:
+//   - req: request to send
+//
+// Returns:
+//   - *http.Response: cached or fresh response
+//   - error: error of next
+func (t *listCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Mutations invalidate every list.
+	if !isSafeMethod(req.Method) {
+		t.cache.Invalidate()
+		defer t.cache.Invalidate()
+		// Return response of the next transport.
+		return t.next.RoundTrip(req)
+	}
+
+	// Forward requests that do not read a list.
+	if !t.cache.isList(req) {
+		// Return response of the next transport.
+		return t.next.RoundTrip(req)
+	}
+
+	// Return cached response.
+	return t.cache.fetch(req, t.next)
+}
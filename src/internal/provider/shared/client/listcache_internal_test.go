@@ -0,0 +1,218 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sendThrough sends a request through a transport and returns the status and body.
+func sendThrough(t *testing.T, transport http.RoundTripper, method, url string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, nil)
+	require.NoError(t, err)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return resp.StatusCode, string(body)
+}
+
+func Test_listCacheTransport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	const base = "https://n8n.example.com/api/v1"
+
+	tests := []struct {
+		name      string
+		requests  [][2]string
+		status    int
+		wantCalls int32
+	}{
+		{
+			name:      "serves repeated list reads from the cache",
+			requests:  [][2]string{{http.MethodGet, "/variables"}, {http.MethodGet, "/variables"}, {http.MethodGet, "/variables"}},
+			wantCalls: 1,
+		},
+		{
+			name:      "ignores the query parameter order",
+			requests:  [][2]string{{http.MethodGet, "/users?limit=100&projectId=p1"}, {http.MethodGet, "/users?projectId=p1&limit=100"}},
+			wantCalls: 1,
+		},
+		{
+			name:      "keys entries by query",
+			requests:  [][2]string{{http.MethodGet, "/variables?cursor=a"}, {http.MethodGet, "/variables?cursor=b"}},
+			wantCalls: 2,
+		},
+		{
+			name:      "does not cache single resources",
+			requests:  [][2]string{{http.MethodGet, "/workflows/wf-1"}, {http.MethodGet, "/workflows/wf-1"}},
+			wantCalls: 2,
+		},
+		{
+			name:      "invalidates on mutation",
+			requests:  [][2]string{{http.MethodGet, "/variables"}, {http.MethodPut, "/variables/var-1"}, {http.MethodGet, "/variables"}},
+			wantCalls: 3,
+		},
+		{
+			name:      "invalidates every endpoint on mutation",
+			requests:  [][2]string{{http.MethodGet, "/projects"}, {http.MethodPost, "/variables"}, {http.MethodGet, "/projects"}},
+			wantCalls: 3,
+		},
+		{
+			name:      "error case - does not cache failed lists",
+			requests:  [][2]string{{http.MethodGet, "/variables"}, {http.MethodGet, "/variables"}},
+			status:    http.StatusInternalServerError,
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				calls.Add(1)
+				status := http.StatusOK
+				// Use the configured status.
+				if tt.status != 0 {
+					status = tt.status
+				}
+				return &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{"data":[]}`))}, nil
+			})
+			transport := newListCacheTransport(next, NewListCache("/api/v1", time.Minute))
+
+			for _, request := range tt.requests {
+				_, body := sendThrough(t, transport, request[0], base+request[1])
+				assert.JSONEq(t, `{"data":[]}`, body, "every caller should read the full body")
+			}
+
+			assert.Equal(t, tt.wantCalls, calls.Load())
+		})
+	}
+}
+
+func Test_listCacheTransport_Disabled(t *testing.T) {
+	t.Parallel()
+
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) { return nil, nil })
+
+	assert.IsType(t, roundTripFunc(nil), newListCacheTransport(next, nil), "No cache should leave the transport unchanged")
+}
+
+func Test_ListCache_Expiry(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	})
+	cache := NewListCache("/api/v1", time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	transport := newListCacheTransport(next, cache)
+
+	sendThrough(t, transport, http.MethodGet, "https://n8n.example.com/api/v1/tags")
+	now = now.Add(30 * time.Second)
+	sendThrough(t, transport, http.MethodGet, "https://n8n.example.com/api/v1/tags")
+	assert.Equal(t, int32(1), calls.Load(), "a fresh entry should be reused")
+
+	now = now.Add(time.Minute)
+	sendThrough(t, transport, http.MethodGet, "https://n8n.example.com/api/v1/tags")
+	assert.Equal(t, int32(2), calls.Load(), "an expired entry should be refetched")
+}
+
+func Test_ListCache_Coalescing(t *testing.T) {
+	t.Parallel()
+
+	const readers = 20
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"var-1","key":"KEY","value":"v"}]}`))
+	}))
+	defer server.Close()
+
+	n8nClient := NewN8nClientWithOptions(server.URL, "key", ClientOptions{ListCacheTTL: time.Minute})
+	require.NotNil(t, n8nClient.ListCache)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, readers)
+	for range readers {
+		wg.Go(func() {
+			list, httpResp, err := n8nClient.APIClient.VariablesAPI.VariablesGet(context.Background()).Execute()
+			// Report failures to the test goroutine.
+			if err != nil || len(list.Data) != 1 || httpResp.StatusCode != http.StatusOK {
+				errs <- err
+			}
+		})
+	}
+	// Let every reader queue behind the leading request.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	assert.Empty(t, errs, "every reader should get the list")
+	assert.Equal(t, int32(1), calls.Load(), "concurrent reads should share one list call")
+}
+
+func Test_ListCache_CancelledWaiter(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		close(started)
+		<-release
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	})
+	transport := newListCacheTransport(next, NewListCache("/api/v1", time.Minute))
+
+	// Start the leading request.
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "https://n8n.example.com/api/v1/users", nil)
+		resp, err := transport.RoundTrip(req)
+		// Release the body.
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://n8n.example.com/api/v1/users", nil)
+	require.NoError(t, err)
+
+	_, err = transport.RoundTrip(req)
+
+	assert.ErrorIs(t, err, context.Canceled, "error case - a cancelled waiter should not block")
+}
+
+func Test_apiPath(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "/api/v1", apiPath("https://n8n.example.com/api/v1"))
+	assert.Equal(t, "/n8n/api/v1", apiPath("https://example.com/n8n/api/v1"))
+	assert.Equal(t, "", apiPath("://invalid"), "error case - invalid URL")
+}
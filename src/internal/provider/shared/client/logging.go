@@ -0,0 +1,277 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// HTTP logging settings.
+const (
+	// HTTP_LOG_SUBSYSTEM is the tflog subsystem receiving the HTTP exchanges.
+	HTTP_LOG_SUBSYSTEM string = "n8n_http"
+
+	// HTTP_LOG_ENV is the environment variable enabling and leveling the HTTP logs.
+	HTTP_LOG_ENV string = "TF_LOG_PROVIDER_N8N_HTTP"
+
+	// MAX_LOGGED_BODY_BYTES is the number of body bytes kept in a log entry.
+	MAX_LOGGED_BODY_BYTES int = 4096
+
+	// REDACTED replaces sensitive values in log entries.
+	REDACTED string = "***REDACTED***"
+
+	// CREDENTIALS_PATH_SEGMENT identifies the endpoints carrying credential secrets.
+	CREDENTIALS_PATH_SEGMENT string = "/credentials"
+)
+
+// sensitiveHeaders are always redacted from the logs, in canonical form.
+var sensitiveHeaders map[string]struct{} = map[string]struct{}{
+	"X-N8n-Api-Key":       {},
+	"Authorization":       {},
+	"Proxy-Authorization": {},
+	"Cookie":              {},
+	"Set-Cookie":          {},
+}
+
+// loggingTransport is an http.RoundTripper logging every exchange to the n8n_http subsystem.
+type loggingTransport struct {
+	// next is the transport performing the request
+	next http.RoundTripper
+
+	// redactedHeaders are the custom provider headers, redacted because they are sensitive
+	redactedHeaders map[string]struct{}
+}
+
+// newLoggingTransport wraps a transport with request/response logging.
+// Returns next unchanged when TF_LOG_PROVIDER_N8N_HTTP is not set.
+//
+// Params:
+//   - next: transport performing the requests
+//   - opts: client options holding the custom headers to redact
+//
+// Returns:
+//   - http.RoundTripper: logging transport
+func newLoggingTransport(next http.RoundTripper, opts ClientOptions) http.RoundTripper {
+	// Logging is opt-in.
+	if os.Getenv(HTTP_LOG_ENV) == "" {
+		// Return transport unchanged.
+		return next
+	}
+
+	redacted := make(map[string]struct{}, len(opts.Headers))
+	// Provider headers are sensitive.
+	for name := range opts.Headers {
+		redacted[http.CanonicalHeaderKey(name)] = struct{}{}
+	}
+
+	// Return logging transport.
+	return &loggingTransport{next: next, redactedHeaders: redacted}
+}
+
+// RoundTrip sends the request and logs method, path, status, latency, headers and truncated bodies.
+//
+// Params:
+//   - req: request to send
+//
+// Returns:
+//   - *http.Response: response of the next transport
+//   - error: error of the next transport
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), HTTP_LOG_SUBSYSTEM, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_N8N", "HTTP"))
+	// Mask sensitive header values wherever they appear, e.g. echoed in a body.
+	if secrets := t.secretValues(req.Header); len(secrets) > 0 {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, HTTP_LOG_SUBSYSTEM, secrets...)
+	}
+
+	fields := map[string]any{
+		"method":          req.Method,
+		"path":            req.URL.Path,
+		"query":           req.URL.RawQuery,
+		"request_headers": t.redactHeaders(req.Header),
+	}
+	// Capture the request body without consuming it.
+	if req.GetBody != nil {
+		// Read a copy of the body.
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			fields["request_body"] = formatBody(req.URL.Path, data, false)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+
+	// Check for error.
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, HTTP_LOG_SUBSYSTEM, "n8n API request failed", fields)
+		// Return error.
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	fields["response_headers"] = t.redactHeaders(resp.Header)
+	// Capture the beginning of the response body and hand the full body back to the caller.
+	if resp.Body != nil {
+		prefix, _ := io.ReadAll(io.LimitReader(resp.Body, int64(MAX_LOGGED_BODY_BYTES)+1))
+		fields["response_body"] = formatBody(req.URL.Path, prefix, len(prefix) > MAX_LOGGED_BODY_BYTES)
+		resp.Body = &prefixedBody{Reader: io.MultiReader(bytes.NewReader(prefix), resp.Body), Closer: resp.Body}
+	}
+
+	tflog.SubsystemDebug(ctx, HTTP_LOG_SUBSYSTEM, "n8n API request", fields)
+	// Return response.
+	return resp, nil
+}
+
+// redactHeaders flattens headers for logging, redacting sensitive values.
+//
+// Params:
+//   - header: headers to log
+//
+// Returns:
+//   - map[string]string: header values indexed by name
+func (t *loggingTransport) redactHeaders(header http.Header) map[string]string {
+	logged := make(map[string]string, len(header))
+	// Copy each header.
+	for name, values := range header {
+		logged[name] = strings.Join(values, ", ")
+		// Hide sensitive values.
+		if t.isSensitive(name) {
+			logged[name] = REDACTED
+		}
+	}
+	// Return headers.
+	return logged
+}
+
+// secretValues returns the values of the sensitive request headers.
+//
+// Params:
+//   - header: request headers
+//
+// Returns:
+//   - []string: non-empty sensitive values
+func (t *loggingTransport) secretValues(header http.Header) []string {
+	var secrets []string
+	// Collect sensitive values.
+	for name, values := range header {
+		// Skip regular headers.
+		if !t.isSensitive(name) {
+			continue
+		}
+		// Keep non-empty values.
+		for _, value := range values {
+			// Check for empty value.
+			if value != "" {
+				secrets = append(secrets, value)
+			}
+		}
+	}
+	// Return secrets.
+	return secrets
+}
+
+// isSensitive reports whether a header must be redacted.
+//
+// Params:
+//   - name: header name
+//
+// Returns:
+//   - bool: true for authentication headers and custom provider headers
+func (t *loggingTransport) isSensitive(name string) bool {
+	canonical := http.CanonicalHeaderKey(name)
+	_, builtin := sensitiveHeaders[canonical]
+	_, custom := t.redactedHeaders[canonical]
+	// Return result.
+	return builtin || custom
+}
+
+// formatBody prepares a body for logging: credential data is redacted and long bodies are truncated.
+//
+// Params:
+//   - path: request path, used to detect credential endpoints
+//   - data: body bytes, possibly already cut at MAX_LOGGED_BODY_BYTES+1
+//   - cut: true when data is only the beginning of the body
+//
+// Returns:
+//   - string: loggable body
+func formatBody(path string, data []byte, cut bool) string {
+	// Redact credential secrets before truncation.
+	if strings.Contains(path, CREDENTIALS_PATH_SEGMENT) && len(data) > 0 {
+		var payload any
+		// Never log a credential payload that cannot be redacted.
+		if cut || json.Unmarshal(data, &payload) != nil {
+			// Return placeholder.
+			return REDACTED
+		}
+		redacted, err := json.Marshal(redactCredentialData(payload))
+		// Check for error.
+		if err != nil {
+			// Return placeholder.
+			return REDACTED
+		}
+		data = redacted
+	}
+
+	// Truncate long bodies.
+	if len(data) > MAX_LOGGED_BODY_BYTES {
+		// Return truncated body.
+		return string(data[:MAX_LOGGED_BODY_BYTES]) + "...(truncated)"
+	}
+	// Return body.
+	return string(data)
+}
+
+// redactCredentialData replaces every credential "data" object in a decoded JSON value.
+// List envelopes also use a "data" key, holding an array, which is walked instead.
+//
+// Params:
+//   - value: decoded JSON value
+//
+// Returns:
+//   - any: value with credential data redacted
+func redactCredentialData(value any) any {
+	// Walk the JSON tree.
+	switch typed := value.(type) {
+	case map[string]any:
+		// Redact or walk each field.
+		for key, field := range typed {
+			// Credential secrets are the object held by "data".
+			if _, isObject := field.(map[string]any); isObject && key == "data" {
+				typed[key] = REDACTED
+				continue
+			}
+			typed[key] = redactCredentialData(field)
+		}
+		// Return object.
+		return typed
+	case []any:
+		// Walk each item.
+		for i, item := range typed {
+			typed[i] = redactCredentialData(item)
+		}
+		// Return array.
+		return typed
+	default:
+		// Return scalar.
+		return value
+	}
+}
+
+// prefixedBody replays the logged prefix before the rest of the original body.
+type prefixedBody struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,194 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newLoggingTransport(t *testing.T) {
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+
+	t.Setenv(HTTP_LOG_ENV, "")
+	assert.IsType(t, roundTripFunc(nil), newLoggingTransport(next, ClientOptions{}), "Logging should be disabled without TF_LOG_PROVIDER_N8N_HTTP")
+
+	t.Setenv(HTTP_LOG_ENV, "DEBUG")
+	transport := newLoggingTransport(next, ClientOptions{Headers: map[string]string{"cf-access-client-secret": "secret"}})
+	require.IsType(t, &loggingTransport{}, transport)
+	assert.True(t, transport.(*loggingTransport).isSensitive("CF-Access-Client-Secret"))
+}
+
+func Test_loggingTransport_RoundTrip(t *testing.T) {
+	largeBody := strings.Repeat("a", MAX_LOGGED_BODY_BYTES+100)
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		requestBody  string
+		responseBody string
+		transportErr error
+		wantFields   map[string]any
+		wantAbsent   []string
+	}{
+		{
+			name:         "logs exchange and redacts the API key",
+			method:       http.MethodGet,
+			path:         "/api/v1/workflows",
+			responseBody: `{"data":[],"echo":"api-key-secret"}`,
+			wantFields: map[string]any{
+				"method":        "GET",
+				"path":          "/api/v1/workflows",
+				"status":        float64(200),
+				"response_body": `{"data":[],"echo":"***"}`,
+			},
+			wantAbsent: []string{"api-key-secret"},
+		},
+		{
+			name:         "redacts credential data in request and response bodies",
+			method:       http.MethodPost,
+			path:         "/api/v1/credentials",
+			requestBody:  `{"name":"Slack","type":"slackApi","data":{"accessToken":"xoxb-secret"}}`,
+			responseBody: `{"id":"cred-1","name":"Slack","data":{"accessToken":"xoxb-secret"}}`,
+			wantFields: map[string]any{
+				"request_body":  `{"data":"***REDACTED***","name":"Slack","type":"slackApi"}`,
+				"response_body": `{"data":"***REDACTED***","id":"cred-1","name":"Slack"}`,
+			},
+			wantAbsent: []string{"xoxb-secret"},
+		},
+		{
+			name:         "truncates long bodies",
+			method:       http.MethodGet,
+			path:         "/api/v1/executions",
+			responseBody: largeBody,
+			wantFields: map[string]any{
+				"response_body": largeBody[:MAX_LOGGED_BODY_BYTES] + "...(truncated)",
+			},
+		},
+		{
+			name:         "hides credential payloads that cannot be parsed",
+			method:       http.MethodGet,
+			path:         "/api/v1/credentials/cred-1",
+			responseBody: `{"data":{"password":"` + largeBody + `"}}`,
+			wantFields: map[string]any{
+				"response_body": REDACTED,
+			},
+		},
+		{
+			name:         "error case - logs transport errors",
+			method:       http.MethodGet,
+			path:         "/api/v1/workflows",
+			transportErr: errors.New("connection refused"),
+			wantFields: map[string]any{
+				"error": "connection refused",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(HTTP_LOG_ENV, "DEBUG")
+
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+
+			next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				// Simulate a network failure.
+				if tt.transportErr != nil {
+					return nil, tt.transportErr
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": {"application/json"}},
+					Body:       io.NopCloser(strings.NewReader(tt.responseBody)),
+				}, nil
+			})
+			transport := newLoggingTransport(next, ClientOptions{})
+
+			var body io.Reader
+			// Send a body when configured.
+			if tt.requestBody != "" {
+				body = strings.NewReader(tt.requestBody)
+			}
+			req, err := http.NewRequestWithContext(ctx, tt.method, "https://n8n.example.com"+tt.path, body)
+			require.NoError(t, err)
+			req.Header.Set("X-N8N-API-KEY", "api-key-secret")
+
+			resp, err := transport.RoundTrip(req)
+
+			// Check that the caller still reads the full body.
+			if tt.transportErr != nil {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				data, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				require.NoError(t, resp.Body.Close())
+				assert.Equal(t, tt.responseBody, string(data))
+			}
+
+			entries, err := tflogtest.MultilineJSONDecode(&output)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			entry := entries[0]
+			assert.Equal(t, "provider."+HTTP_LOG_SUBSYSTEM, entry["@module"])
+			assert.Contains(t, entry, "latency_ms")
+			assert.Equal(t, REDACTED, entry["request_headers"].(map[string]any)["X-N8n-Api-Key"])
+			for key, want := range tt.wantFields {
+				assert.Equal(t, want, entry[key], key)
+			}
+			for _, secret := range tt.wantAbsent {
+				assert.NotContains(t, output.String(), secret)
+			}
+		})
+	}
+}
+
+func Test_redactCredentialData(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		value any
+		want  any
+	}{
+		{
+			name:  "redacts credential object",
+			value: map[string]any{"name": "cred", "data": map[string]any{"token": "secret"}},
+			want:  map[string]any{"name": "cred", "data": REDACTED},
+		},
+		{
+			name:  "walks list envelopes",
+			value: map[string]any{"data": []any{map[string]any{"id": "1", "data": map[string]any{"token": "secret"}}}},
+			want:  map[string]any{"data": []any{map[string]any{"id": "1", "data": REDACTED}}},
+		},
+		{
+			name:  "keeps scalar data fields",
+			value: map[string]any{"data": "visible"},
+			want:  map[string]any{"data": "visible"},
+		},
+		{
+			name:  "error case - scalar value",
+			value: "plain",
+			want:  "plain",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, redactCredentialData(tt.value))
+		})
+	}
+}
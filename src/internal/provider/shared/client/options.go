@@ -0,0 +1,71 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package client
+
+import (
+	"crypto/tls"
+	"net/url"
+	"time"
+)
+
+// Default values applied when the provider configuration leaves an option unset.
+const (
+	// DEFAULT_MAX_RETRIES is the number of retries attempted for a failed request.
+	DEFAULT_MAX_RETRIES int = 3
+
+	// DEFAULT_RETRY_WAIT_MIN is the minimum wait time between two attempts.
+	DEFAULT_RETRY_WAIT_MIN time.Duration = 1 * time.Second
+
+	// DEFAULT_RETRY_WAIT_MAX is the maximum wait time between two attempts.
+	DEFAULT_RETRY_WAIT_MAX time.Duration = 30 * time.Second
+)
+
+// ClientOptions holds the optional settings used to build the HTTP transport of N8nClient.
+// The zero value disables retries and limits; use DefaultClientOptions for the provider defaults.
+type ClientOptions struct {
+	// MaxRetries is the maximum number of retries for a retryable request
+	MaxRetries int
+
+	// RetryWaitMin is the minimum backoff duration between two attempts
+	RetryWaitMin time.Duration
+
+	// RetryWaitMax is the maximum backoff duration between two attempts
+	RetryWaitMax time.Duration
+
+	// RequestsPerSecond limits the request rate, 0 means unlimited
+	RequestsPerSecond float64
+
+	// MaxConcurrentRequests caps the number of in-flight requests, 0 means unlimited
+	MaxConcurrentRequests int
+
+	// TLSConfig overrides the TLS settings of the transport, nil keeps the defaults
+	TLSConfig *tls.Config
+
+	// Headers are extra headers sent with every request alongside the API key
+	Headers map[string]string
+
+	// ProxyURL routes every request through the given proxy, nil uses the proxy environment variables
+	ProxyURL *url.URL
+
+	// ReadOnly refuses every request that may modify the instance (POST, PUT, PATCH, DELETE)
+	ReadOnly bool
+
+	// ListCacheTTL is the time list responses are reused, 0 disables the list cache
+	ListCacheTTL time.Duration
+}
+
+// DefaultClientOptions returns the options used when the provider does not override them.
+//
+// Returns:
+//   - ClientOptions: options populated with the default values
+func DefaultClientOptions() ClientOptions {
+	// Return default options.
+	return ClientOptions{
+		MaxRetries:   DEFAULT_MAX_RETRIES,
+		RetryWaitMin: DEFAULT_RETRY_WAIT_MIN,
+		RetryWaitMax: DEFAULT_RETRY_WAIT_MAX,
+		ListCacheTTL: DEFAULT_LIST_CACHE_TTL,
+	}
+}
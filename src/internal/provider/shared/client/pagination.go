@@ -0,0 +1,160 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// ErrRepeatedCursor reports a nextCursor returned twice, which would paginate forever.
+var ErrRepeatedCursor error = errors.New("n8n API returned an already visited page cursor")
+
+// PageList is a page returned by a list endpoint of the n8n API (e.g., *n8nsdk.WorkflowList).
+type PageList[T any] interface {
+	// GetData returns the items of the page.
+	GetData() []T
+	// GetNextCursor returns the cursor of the next page, empty on the last page.
+	GetNextCursor() string
+}
+
+// ListRequest is a list request built by the SDK (e.g., n8nsdk.WorkflowAPIWorkflowsGetRequest).
+// Filters are set on the request before it is paginated.
+type ListRequest[R any, L PageList[T], T any] interface {
+	// Limit sets the page size.
+	Limit(limit float32) R
+	// Cursor selects the page to fetch.
+	Cursor(cursor string) R
+	// Execute fetches the page.
+	Execute() (L, *http.Response, error)
+}
+
+// PageError reports the failure of a page fetched by Paginate.
+type PageError struct {
+	// HTTPResponse is the response of the failed page, nil on transport errors
+	HTTPResponse *http.Response
+
+	// Err is the error returned by the SDK
+	Err error
+}
+
+// Error returns the SDK error message.
+//
+// Returns:
+//   - string: error message
+func (e *PageError) Error() string {
+	// Return SDK message.
+	return e.Err.Error()
+}
+
+// Unwrap returns the SDK error.
+//
+// Returns:
+//   - error: SDK error
+func (e *PageError) Unwrap() error {
+	// Return SDK error.
+	return e.Err
+}
+
+// Paginate iterates over every item of a list request, following the nextCursor of each page.
+// Pages are fetched lazily, so breaking out of the loop stops the pagination.
+// A failed page yields a *PageError and a repeated cursor yields ErrRepeatedCursor; both end the iteration.
+// It lives in the client package rather than shared because the client resolves default tags
+// with it, and shared already imports client.
+//
+// Params:
+//   - req: list request, with its filters set
+//   - pageSize: number of items per page, DEFAULT_PAGE_SIZE when not positive
+//
+// Returns:
+//   - iter.Seq2[T, error]: items of every page
+func Paginate[T any, L PageList[T], R ListRequest[R, L, T]](req R, pageSize int64) iter.Seq2[T, error] {
+	// Fall back to the default page size.
+	if pageSize <= 0 {
+		pageSize = DEFAULT_PAGE_SIZE
+	}
+
+	// Return iterator.
+	return func(yield func(T, error) bool) {
+		cursor := ""
+		visited := make(map[string]struct{})
+		// Fetch pages until the cursor is exhausted.
+		for {
+			page := req.Limit(float32(pageSize))
+			// Continue from the previous page.
+			if cursor != "" {
+				page = page.Cursor(cursor)
+			}
+			list, httpResp, err := page.Execute()
+			// Check for non-nil value.
+			if httpResp != nil && httpResp.Body != nil {
+				httpResp.Body.Close()
+			}
+			// Check for error.
+			if err != nil {
+				var zero T
+				yield(zero, &PageError{HTTPResponse: httpResp, Err: err})
+				// Stop on error.
+				return
+			}
+
+			// Yield items of the page.
+			for _, item := range list.GetData() {
+				// Stop when the caller breaks.
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			cursor = list.GetNextCursor()
+			// Stop on the last page.
+			if cursor == "" {
+				return
+			}
+			// Stop when the instance points back to a visited page.
+			if _, ok := visited[cursor]; ok {
+				var zero T
+				yield(zero, fmt.Errorf("%w: %q", ErrRepeatedCursor, cursor))
+				// Stop on repeated cursor.
+				return
+			}
+			visited[cursor] = struct{}{}
+		}
+	}
+}
+
+// ListAll fetches every item of a list request, following pagination.
+//
+// Params:
+//   - req: list request, with its filters set
+//   - pageSize: number of items per page, DEFAULT_PAGE_SIZE when not positive
+//
+// Returns:
+//   - []T: items of every page
+//   - *http.Response: response of the failed page, nil on success
+//   - error: error if a page cannot be fetched
+func ListAll[T any, L PageList[T], R ListRequest[R, L, T]](req R, pageSize int64) ([]T, *http.Response, error) {
+	items := []T{}
+	// Collect every item.
+	for item, err := range Paginate(req, pageSize) {
+		// Check for error.
+		if err != nil {
+			var pageErr *PageError
+			// Report the response of the failed page.
+			if errors.As(err, &pageErr) {
+				// Return error with response.
+				return nil, pageErr.HTTPResponse, pageErr.Err
+			}
+			// Return error.
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+
+	// Return items.
+	return items, nil, nil
+}
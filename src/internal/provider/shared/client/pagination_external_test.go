@@ -0,0 +1,158 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPagedVariablesServer serves total variables in pages of the requested limit.
+// The cursor is the offset of the next page.
+func newPagedVariablesServer(t *testing.T, total int, failAt string, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		cursor := r.URL.Query().Get("cursor")
+		// Fail the selected page.
+		if failAt != "" && cursor == failAt {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		offset, _ := strconv.Atoi(cursor)
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		body := `{"data":[`
+		end := min(offset+limit, total)
+		for i := offset; i < end; i++ {
+			if i > offset {
+				body += ","
+			}
+			body += fmt.Sprintf(`{"id":"var-%d","key":"KEY_%d","value":"v"}`, i, i)
+		}
+		body += `]`
+		// Point to the next page.
+		if end < total {
+			body += fmt.Sprintf(`,"nextCursor":"%d"`, end)
+		}
+		body += `}`
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// TestListAll tests the ListAll function.
+func TestListAll(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		total        int
+		pageSize     int64
+		failAt       string
+		wantItems    int
+		wantRequests int32
+		wantErr      bool
+	}{
+		{name: "single page", total: 3, pageSize: 10, wantItems: 3, wantRequests: 1},
+		{name: "follows nextCursor", total: 7, pageSize: 3, wantItems: 7, wantRequests: 3},
+		{name: "empty list", total: 0, pageSize: 3, wantItems: 0, wantRequests: 1},
+		{name: "uses the default page size", total: 150, pageSize: 0, wantItems: 150, wantRequests: 2},
+		{name: "error case - failed page", total: 7, pageSize: 3, failAt: "3", wantErr: true, wantRequests: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			server := newPagedVariablesServer(t, tt.total, tt.failAt, &requests)
+			n8nClient := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{})
+
+			variables, httpResp, err := client.ListAll(n8nClient.APIClient.VariablesAPI.VariablesGet(context.Background()), tt.pageSize)
+
+			assert.Equal(t, tt.wantRequests, requests.Load())
+			// Check error.
+			if tt.wantErr {
+				require.Error(t, err)
+				require.NotNil(t, httpResp)
+				assert.Equal(t, http.StatusInternalServerError, httpResp.StatusCode)
+				assert.Nil(t, variables)
+				return
+			}
+			require.NoError(t, err)
+			assert.Nil(t, httpResp)
+			require.Len(t, variables, tt.wantItems)
+			// Check order across pages.
+			if tt.wantItems > 0 {
+				assert.Equal(t, fmt.Sprintf("KEY_%d", tt.wantItems-1), variables[tt.wantItems-1].Key)
+			}
+		})
+	}
+}
+
+// TestPaginate tests that the Paginate iterator fetches pages lazily.
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := newPagedVariablesServer(t, 10, "", &requests)
+	n8nClient := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{})
+
+	var keys []string
+	// Stop in the middle of the second page.
+	for variable, err := range client.Paginate(n8nClient.APIClient.VariablesAPI.VariablesGet(context.Background()), 3) {
+		require.NoError(t, err)
+		keys = append(keys, variable.Key)
+		if variable.Key == "KEY_4" {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"KEY_0", "KEY_1", "KEY_2", "KEY_3", "KEY_4"}, keys)
+	assert.Equal(t, int32(2), requests.Load(), "the third page should not be fetched")
+}
+
+// TestPaginate_RepeatedCursor tests that Paginate stops when a page cursor repeats.
+func TestPaginate_RepeatedCursor(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"var-0","key":"KEY_0","value":"v"}],"nextCursor":"same"}`))
+	}))
+	t.Cleanup(server.Close)
+	n8nClient := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{})
+
+	variables, httpResp, err := client.ListAll(n8nClient.APIClient.VariablesAPI.VariablesGet(context.Background()), 3)
+
+	require.ErrorIs(t, err, client.ErrRepeatedCursor)
+	assert.Nil(t, httpResp)
+	assert.Nil(t, variables)
+	assert.Equal(t, int32(2), requests.Load(), "the repeated page should not be fetched again")
+}
+
+// TestPageError tests the PageError type.
+func TestPageError(t *testing.T) {
+	t.Parallel()
+
+	cause := fmt.Errorf("500 Internal Server Error")
+	err := &client.PageError{Err: cause}
+
+	assert.Equal(t, "500 Internal Server Error", err.Error())
+	assert.ErrorIs(t, err, cause)
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package client
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// tokenBucket is a minimal token-bucket rate limiter safe for concurrent use.
+// The bucket refills at rate tokens per second and holds at most burst tokens.
+type tokenBucket struct {
+	// mu guards tokens and last
+	mu sync.Mutex
+
+	// rate is the number of tokens added per second
+	rate float64
+
+	// burst is the bucket capacity
+	burst float64
+
+	// tokens is the number of tokens currently available
+	tokens float64
+
+	// last is the time of the last refill
+	last time.Time
+
+	// now returns the current time, overridable in tests
+	now func() time.Time
+}
+
+// newTokenBucket creates a full token bucket.
+// The burst allows one second worth of requests, with a minimum of one.
+//
+// Params:
+//   - rate: tokens added per second, must be positive
+//
+// Returns:
+//   - *tokenBucket: limiter ready for use
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(1, math.Floor(rate))
+
+	// Return full bucket.
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+		now:    time.Now,
+	}
+}
+
+// Wait blocks until a token is available or the context is done.
+//
+// Params:
+//   - ctx: context bounding the wait
+//
+// Returns:
+//   - error: context error if the wait was interrupted
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	// Loop until a token can be taken.
+	for {
+		wait := b.reserve()
+		// Token taken without waiting.
+		if wait <= 0 {
+			// Return success.
+			return nil
+		}
+
+		// Sleep until the next token should be available.
+		if err := sleepWithContext(ctx, wait); err != nil {
+			// Return context error.
+			return err
+		}
+	}
+}
+
+// reserve takes a token if one is available.
+//
+// Returns:
+//   - time.Duration: zero if a token was taken, otherwise the time until the next token
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	// Take a token when available.
+	if b.tokens >= 1 {
+		b.tokens--
+		// Return no wait.
+		return 0
+	}
+
+	// Return time until one full token is available.
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// rateLimitTransport is an http.RoundTripper enforcing a request rate and a cap on
+// in-flight requests. A request stays in flight until its response body is closed.
+type rateLimitTransport struct {
+	// next is the transport performing the actual request
+	next http.RoundTripper
+
+	// limiter throttles the request rate, nil when unlimited
+	limiter *tokenBucket
+
+	// slots caps in-flight requests, nil when unlimited
+	slots chan struct{}
+}
+
+// newRateLimitTransport wraps a transport with rate limiting and a concurrency cap.
+// Returns next unchanged when both limits are disabled.
+//
+// Params:
+//   - next: transport performing the actual requests
+//   - opts: client options holding the limits
+//
+// Returns:
+//   - http.RoundTripper: limited transport
+func newRateLimitTransport(next http.RoundTripper, opts ClientOptions) http.RoundTripper {
+	// Nothing to enforce without limits.
+	if opts.RequestsPerSecond <= 0 && opts.MaxConcurrentRequests <= 0 {
+		// Return transport unchanged.
+		return next
+	}
+
+	t := &rateLimitTransport{next: next}
+	// Enable the rate limiter when configured.
+	if opts.RequestsPerSecond > 0 {
+		t.limiter = newTokenBucket(opts.RequestsPerSecond)
+	}
+	// Enable the concurrency cap when configured.
+	if opts.MaxConcurrentRequests > 0 {
+		t.slots = make(chan struct{}, opts.MaxConcurrentRequests)
+	}
+
+	// Return limited transport.
+	return t
+}
+
+// RoundTrip waits for an in-flight slot and a rate token, then executes the request.
+//
+// Params:
+//   - req: HTTP request to execute
+//
+// Returns:
+//   - *http.Response: response whose body releases the in-flight slot on close
+//   - error: transport error or context error if the wait was interrupted
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	release, err := t.acquireSlot(ctx)
+	// Check for error.
+	if err != nil {
+		// Return context error.
+		return nil, err
+	}
+
+	// Wait for the rate limiter when enabled.
+	if t.limiter != nil {
+		// Check for error.
+		if err := t.limiter.Wait(ctx); err != nil {
+			release()
+			// Return context error.
+			return nil, err
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	// Release immediately when there is no body to track.
+	if err != nil || resp == nil || resp.Body == nil {
+		release()
+		// Return the outcome.
+		return resp, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	// Return response tracking the slot.
+	return resp, nil
+}
+
+// acquireSlot reserves an in-flight slot.
+//
+// Params:
+//   - ctx: context bounding the wait
+//
+// Returns:
+//   - func(): function releasing the slot, safe to call more than once
+//   - error: context error if the wait was interrupted
+func (t *rateLimitTransport) acquireSlot(ctx context.Context) (func(), error) {
+	// No concurrency cap configured.
+	if t.slots == nil {
+		// Return no-op release.
+		return func() {}, nil
+	}
+
+	// Wait for a free slot.
+	select {
+	// Slot acquired.
+	case t.slots <- struct{}{}:
+		var once sync.Once
+		// Return release function.
+		return func() { once.Do(func() { <-t.slots }) }, nil
+	// Context canceled or deadline exceeded.
+	case <-ctx.Done():
+		// Return context error.
+		return nil, ctx.Err()
+	}
+}
+
+// releasingBody releases an in-flight slot when the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+
+	// release frees the in-flight slot
+	release func()
+}
+
+// Close closes the body and releases the in-flight slot.
+//
+// Returns:
+//   - error: error from closing the underlying body
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+
+	// Return close error.
+	return err
+}
@@ -0,0 +1,236 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_tokenBucket_reserve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+	}{
+		{name: "starts full with burst of one second"},
+		{name: "refills over time"},
+		{name: "does not exceed burst after idle period"},
+		{name: "error case - fractional rate keeps a burst of one"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			clock := func() time.Time { return now }
+
+			switch tt.name {
+			case "starts full with burst of one second":
+				b := newTokenBucket(5)
+				b.now, b.last = clock, now
+				for i := 0; i < 5; i++ {
+					assert.Zero(t, b.reserve(), "Token %d should be available", i)
+				}
+				assert.Equal(t, 200*time.Millisecond, b.reserve(), "Sixth token should wait one interval")
+
+			case "refills over time":
+				b := newTokenBucket(2)
+				b.now, b.last = clock, now
+				assert.Zero(t, b.reserve())
+				assert.Zero(t, b.reserve())
+				assert.Positive(t, b.reserve())
+				now = now.Add(500 * time.Millisecond)
+				assert.Zero(t, b.reserve(), "Token should be available after refill")
+
+			case "does not exceed burst after idle period":
+				b := newTokenBucket(3)
+				b.now, b.last = clock, now
+				now = now.Add(time.Hour)
+				for i := 0; i < 3; i++ {
+					assert.Zero(t, b.reserve())
+				}
+				assert.Positive(t, b.reserve(), "Burst should be capped")
+
+			case "error case - fractional rate keeps a burst of one":
+				b := newTokenBucket(0.5)
+				b.now, b.last = clock, now
+				assert.Zero(t, b.reserve())
+				assert.Equal(t, 2*time.Second, b.reserve())
+			}
+		})
+	}
+}
+
+func Test_tokenBucket_Wait(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rate    float64
+		drain   int
+		cancel  bool
+		wantErr bool
+	}{
+		{name: "returns immediately with tokens", rate: 10, drain: 0, wantErr: false},
+		{name: "waits for next token", rate: 100, drain: 100, wantErr: false},
+		{name: "error case - canceled context", rate: 0.001, drain: 1, cancel: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := newTokenBucket(tt.rate)
+			for i := 0; i < tt.drain; i++ {
+				b.reserve()
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				cancel()
+			}
+
+			err := b.Wait(ctx)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_newRateLimitTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		opts        ClientOptions
+		wantWrapped bool
+	}{
+		{name: "returns next when unlimited", opts: ClientOptions{}, wantWrapped: false},
+		{name: "wraps with rate limit", opts: ClientOptions{RequestsPerSecond: 5}, wantWrapped: true},
+		{name: "wraps with concurrency cap", opts: ClientOptions{MaxConcurrentRequests: 2}, wantWrapped: true},
+		{name: "error case - negative values are unlimited", opts: ClientOptions{RequestsPerSecond: -1, MaxConcurrentRequests: -1}, wantWrapped: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rt := newRateLimitTransport(http.DefaultTransport, tt.opts)
+			_, wrapped := rt.(*rateLimitTransport)
+			assert.Equal(t, tt.wantWrapped, wrapped)
+		})
+	}
+}
+
+func Test_rateLimitTransport_ConcurrencyCap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		maxConcurrent int
+		requests      int
+	}{
+		{name: "caps in-flight requests at one", maxConcurrent: 1, requests: 5},
+		{name: "caps in-flight requests at three", maxConcurrent: 3, requests: 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var inFlight, peak atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				current := inFlight.Add(1)
+				for {
+					previous := peak.Load()
+					if current <= previous || peak.CompareAndSwap(previous, current) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				inFlight.Add(-1)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			httpClient := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, ClientOptions{MaxConcurrentRequests: tt.maxConcurrent})}
+
+			var wg sync.WaitGroup
+			for i := 0; i < tt.requests; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					resp, err := httpClient.Get(server.URL)
+					if assert.NoError(t, err) {
+						resp.Body.Close()
+					}
+				}()
+			}
+			wg.Wait()
+
+			assert.LessOrEqual(t, peak.Load(), int32(tt.maxConcurrent))
+		})
+	}
+}
+
+func Test_rateLimitTransport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+	}{
+		{name: "releases slot when body is closed"},
+		{name: "releases slot on transport error"},
+		{name: "error case - canceled context while waiting for slot"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			switch tt.name {
+			case "releases slot when body is closed":
+				next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+				})
+				rt := newRateLimitTransport(next, ClientOptions{MaxConcurrentRequests: 1}).(*rateLimitTransport)
+
+				resp, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://n8n.local", nil))
+				require.NoError(t, err)
+				assert.Len(t, rt.slots, 1, "Slot should be held until the body is closed")
+				require.NoError(t, resp.Body.Close())
+				require.NoError(t, resp.Body.Close(), "Closing twice should be safe")
+				assert.Len(t, rt.slots, 0, "Slot should be released")
+
+			case "releases slot on transport error":
+				next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					return nil, assert.AnError
+				})
+				rt := newRateLimitTransport(next, ClientOptions{MaxConcurrentRequests: 1}).(*rateLimitTransport)
+
+				_, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, "http://n8n.local", nil))
+				assert.ErrorIs(t, err, assert.AnError)
+				assert.Len(t, rt.slots, 0)
+
+			case "error case - canceled context while waiting for slot":
+				rt := newRateLimitTransport(http.DefaultTransport, ClientOptions{MaxConcurrentRequests: 1}).(*rateLimitTransport)
+				rt.slots <- struct{}{}
+
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				req := httptest.NewRequest(http.MethodGet, "http://n8n.local", nil).WithContext(ctx)
+
+				_, err := rt.RoundTrip(req)
+				assert.ErrorIs(t, err, context.Canceled)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrReadOnly is matched by every request refused because the client is read-only.
+var ErrReadOnly error = errors.New("n8n provider is in read-only mode")
+
+// ReadOnlyError reports a mutating request refused by the read-only transport.
+type ReadOnlyError struct {
+	// Method is the HTTP method of the refused request
+	Method string
+
+	// Path is the URL path of the refused request
+	Path string
+}
+
+// Error describes the refused operation.
+//
+// Returns:
+//   - string: error message naming the method and path
+func (e *ReadOnlyError) Error() string {
+	// Return message.
+	return fmt.Sprintf("%s: refusing %s %s", ErrReadOnly.Error(), e.Method, e.Path)
+}
+
+// Is makes errors.Is match ErrReadOnly.
+//
+// Params:
+//   - target: error to compare with
+//
+// Returns:
+//   - bool: true when target is ErrReadOnly
+func (e *ReadOnlyError) Is(target error) bool {
+	// Return result.
+	return target == ErrReadOnly
+}
+
+// readOnlyTransport is an http.RoundTripper refusing every request that may modify the instance.
+type readOnlyTransport struct {
+	// next is the transport performing the safe requests
+	next http.RoundTripper
+}
+
+// newReadOnlyTransport wraps a transport so that only safe methods reach the instance.
+// Returns next unchanged when read-only mode is disabled.
+//
+// Params:
+//   - next: transport performing the allowed requests
+//   - opts: client options holding the read-only flag
+//
+// Returns:
+//   - http.RoundTripper: guarded transport
+func newReadOnlyTransport(next http.RoundTripper, opts ClientOptions) http.RoundTripper {
+	// Nothing to guard when writes are allowed.
+	if !opts.ReadOnly {
+		// Return transport unchanged.
+		return next
+	}
+
+	// Return guarded transport.
+	return &readOnlyTransport{next: next}
+}
+
+// RoundTrip forwards GET, HEAD and OPTIONS requests and refuses every other method.
+//
+// Params:
+//   - req: request to send
+//
+// Returns:
+//   - *http.Response: response of an allowed request
+//   - error: ReadOnlyError for a refused request, or the error of next
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Forward safe methods.
+	if isSafeMethod(req.Method) {
+		// Return response of the next transport.
+		return t.next.RoundTrip(req)
+	}
+
+	// RoundTrip must close the body even when the request is not sent.
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	// Return refusal.
+	return nil, &ReadOnlyError{Method: req.Method, Path: req.URL.Path}
+}
+
+// isSafeMethod reports whether an HTTP method is read-only.
+//
+// Params:
+//   - method: HTTP method
+//
+// Returns:
+//   - bool: true for GET, HEAD and OPTIONS
+func isSafeMethod(method string) bool {
+	// Check method.
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, "":
+		// Return safe.
+		return true
+	default:
+		// Return unsafe.
+		return false
+	}
+}
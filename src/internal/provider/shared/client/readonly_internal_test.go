@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// closeTracker records whether a request body was closed.
+type closeTracker struct {
+	io.Reader
+	closed atomic.Bool
+}
+
+// Close marks the body as closed.
+func (c *closeTracker) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+func Test_readOnlyTransport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		method      string
+		wantRefused bool
+	}{
+		{name: "forwards GET", method: http.MethodGet},
+		{name: "forwards HEAD", method: http.MethodHead},
+		{name: "forwards OPTIONS", method: http.MethodOptions},
+		{name: "error case - refuses POST", method: http.MethodPost, wantRefused: true},
+		{name: "error case - refuses PUT", method: http.MethodPut, wantRefused: true},
+		{name: "error case - refuses PATCH", method: http.MethodPatch, wantRefused: true},
+		{name: "error case - refuses DELETE", method: http.MethodDelete, wantRefused: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				calls.Add(1)
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+			})
+			transport := newReadOnlyTransport(next, ClientOptions{ReadOnly: true})
+
+			body := &closeTracker{Reader: strings.NewReader("{}")}
+			req, err := http.NewRequest(tt.method, "https://n8n.example.com/api/v1/workflows/wf-1", body)
+			require.NoError(t, err)
+
+			resp, err := transport.RoundTrip(req)
+
+			// Check refused requests.
+			if tt.wantRefused {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				assert.True(t, errors.Is(err, ErrReadOnly))
+				assert.Contains(t, err.Error(), "refusing "+tt.method+" /api/v1/workflows/wf-1")
+				assert.True(t, body.closed.Load(), "Refused request body should be closed")
+				assert.Equal(t, int32(0), calls.Load())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, int32(1), calls.Load())
+		})
+	}
+}
+
+func Test_newReadOnlyTransport(t *testing.T) {
+	t.Parallel()
+
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+
+	assert.IsType(t, roundTripFunc(nil), newReadOnlyTransport(next, ClientOptions{}), "Transport should be unchanged when writes are allowed")
+	assert.IsType(t, &readOnlyTransport{}, newReadOnlyTransport(next, ClientOptions{ReadOnly: true}))
+}
+
+func TestNewN8nClientWithOptions_ReadOnly(t *testing.T) {
+	t.Parallel()
+
+	var writes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Count requests that reached the server with a mutating method.
+		if r.Method != http.MethodGet {
+			writes.Add(1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	c := NewN8nClientWithOptions(server.URL, "key", ClientOptions{ReadOnly: true, MaxRetries: 3})
+
+	_, _, err := c.APIClient.VariablesAPI.VariablesGet(context.Background()).Execute()
+	require.NoError(t, err, "Reads should keep working")
+
+	_, err = c.APIClient.VariablesAPI.VariablesPost(context.Background()).VariableCreate(n8nsdk.VariableCreate{Key: "KEY", Value: "value"}).Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "refusing POST /api/v1/variables")
+	assert.Equal(t, int32(0), writes.Load(), "Mutating requests should never reach the server")
+}
@@ -0,0 +1,332 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RETRY_DRAIN_LIMIT is the maximum number of bytes read from a discarded response body
+// so the underlying connection can be reused.
+const RETRY_DRAIN_LIMIT int64 = 4096
+
+// retryTransport is an http.RoundTripper retrying transient failures.
+// Idempotent requests are retried on connection errors and on 429, 502, 503 and 504
+// responses; other requests are only retried on 429 since the server did not process them.
+// Waits use exponential backoff with jitter and honor the Retry-After response header.
+type retryTransport struct {
+	// next is the transport performing the actual request
+	next http.RoundTripper
+
+	// maxRetries is the maximum number of retries after the first attempt
+	maxRetries int
+
+	// waitMin is the base backoff duration
+	waitMin time.Duration
+
+	// waitMax caps the backoff duration and any Retry-After value
+	waitMax time.Duration
+}
+
+// newRetryTransport wraps a transport with retry handling.
+//
+// Params:
+//   - next: transport performing the actual requests
+//   - opts: client options holding the retry settings
+//
+// Returns:
+//   - *retryTransport: transport retrying transient failures
+func newRetryTransport(next http.RoundTripper, opts ClientOptions) *retryTransport {
+	waitMax := opts.RetryWaitMax
+	// Never allow the maximum wait to be lower than the minimum.
+	if waitMax < opts.RetryWaitMin {
+		waitMax = opts.RetryWaitMin
+	}
+
+	// Return configured transport.
+	return &retryTransport{
+		next:       next,
+		maxRetries: max(opts.MaxRetries, 0),
+		waitMin:    opts.RetryWaitMin,
+		waitMax:    waitMax,
+	}
+}
+
+// RoundTrip executes the request, retrying it while the failure is transient.
+//
+// Params:
+//   - req: HTTP request to execute
+//
+// Returns:
+//   - *http.Response: the last response received
+//   - error: the last transport error, or the context error if a wait was interrupted
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	attemptReq := req
+
+	// Loop until the request succeeds, fails permanently or retries are exhausted.
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(attemptReq)
+
+		// Stop when no retry is allowed for this outcome.
+		if attempt >= t.maxRetries || !shouldRetry(ctx, req.Method, resp, err) {
+			// Return the last outcome.
+			return resp, err
+		}
+
+		nextReq, rewindErr := rewindRequest(req)
+		// A request whose body cannot be replayed must not be retried.
+		if rewindErr != nil {
+			// Return the last outcome.
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		tflog.Warn(ctx, fmt.Sprintf(
+			"Retrying %s %s in %s (retry %d/%d): %s",
+			req.Method, req.URL.Path, wait, attempt+1, t.maxRetries, retryReason(resp, err),
+		))
+		drainResponse(resp)
+
+		// Abort when the context is canceled while waiting.
+		if sleepErr := sleepWithContext(ctx, wait); sleepErr != nil {
+			// Return the context error.
+			return nil, sleepErr
+		}
+
+		attemptReq = nextReq
+	}
+}
+
+// backoff computes the wait before the next attempt.
+//
+// Params:
+//   - attempt: zero-based index of the attempt that just failed
+//   - resp: response of the failed attempt, may be nil
+//
+// Returns:
+//   - time.Duration: wait duration before the next attempt
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	// Honor Retry-After when the server provides it.
+	if resp != nil {
+		// Use the server hint capped at the maximum wait.
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			// Return capped Retry-After.
+			return min(retryAfter, t.waitMax)
+		}
+	}
+
+	wait := t.waitMin
+	// Double the wait for each previous attempt without exceeding the maximum.
+	for i := 0; i < attempt && wait < t.waitMax; i++ {
+		wait *= 2
+	}
+	wait = min(wait, t.waitMax)
+
+	// Keep half of the wait fixed and randomize the other half.
+	half := wait / 2
+	// Skip jitter when the wait is too small to split.
+	if half <= 0 {
+		// Return unjittered wait.
+		return wait
+	}
+
+	// Return jittered wait.
+	return half + time.Duration(rand.Int64N(int64(half)+1))
+}
+
+// shouldRetry reports whether a request outcome is transient and worth retrying.
+//
+// Params:
+//   - ctx: request context
+//   - method: HTTP method of the request
+//   - resp: response received, may be nil
+//   - err: transport error, may be nil
+//
+// Returns:
+//   - bool: true if the request should be retried
+func shouldRetry(ctx context.Context, method string, resp *http.Response, err error) bool {
+	// Never retry once the caller gave up.
+	if ctx.Err() != nil {
+		// Return no retry.
+		return false
+	}
+
+	// Connection errors are only safe to retry for idempotent methods.
+	if err != nil {
+		// Return idempotency of the method.
+		return isIdempotentMethod(method)
+	}
+
+	// Classify the response status.
+	switch resp.StatusCode {
+	// Rate limited requests were not processed by the server.
+	case http.StatusTooManyRequests:
+		// Return retry.
+		return true
+	// Gateway and availability errors may have been partially processed.
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		// Return idempotency of the method.
+		return isIdempotentMethod(method)
+	// Any other status is final.
+	default:
+		// Return no retry.
+		return false
+	}
+}
+
+// isIdempotentMethod reports whether repeating a request with this method is safe.
+//
+// Params:
+//   - method: HTTP method
+//
+// Returns:
+//   - bool: true for idempotent methods
+func isIdempotentMethod(method string) bool {
+	// Classify the method.
+	switch method {
+	// Methods defined as idempotent by RFC 9110.
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		// Return idempotent.
+		return true
+	// Any other method may have side effects when repeated.
+	default:
+		// Return not idempotent.
+		return false
+	}
+}
+
+// rewindRequest clones a request with a fresh body for a new attempt.
+//
+// Params:
+//   - req: original request
+//
+// Returns:
+//   - *http.Request: request ready to be sent again
+//   - error: error if the body cannot be replayed
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	// Requests without body can be sent again as-is.
+	if req.Body == nil || req.Body == http.NoBody {
+		// Return clone.
+		return clone, nil
+	}
+
+	// A body without GetBody cannot be replayed.
+	if req.GetBody == nil {
+		// Return error.
+		return nil, fmt.Errorf("request body for %s %s cannot be replayed", req.Method, req.URL.Path)
+	}
+
+	body, err := req.GetBody()
+	// Check for error.
+	if err != nil {
+		// Return error.
+		return nil, fmt.Errorf("rewinding request body: %w", err)
+	}
+	clone.Body = body
+
+	// Return clone with fresh body.
+	return clone, nil
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date.
+//
+// Params:
+//   - value: raw header value
+//   - now: reference time for HTTP dates
+//
+// Returns:
+//   - time.Duration: wait requested by the server
+//   - bool: true if the header held a valid value
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	// Empty header means no hint.
+	if value == "" {
+		// Return no hint.
+		return 0, false
+	}
+
+	// Try the delay-seconds form first.
+	if seconds, err := strconv.Atoi(value); err == nil {
+		// Return the delay, ignoring negative values.
+		return time.Duration(max(seconds, 0)) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	// Check for error.
+	if err != nil {
+		// Return no hint.
+		return 0, false
+	}
+
+	// Return the remaining time until the date.
+	return max(date.Sub(now), 0), true
+}
+
+// retryReason describes a failed attempt for logging.
+//
+// Params:
+//   - resp: response received, may be nil
+//   - err: transport error, may be nil
+//
+// Returns:
+//   - string: human readable reason
+func retryReason(resp *http.Response, err error) string {
+	// Prefer the transport error when present.
+	if err != nil {
+		// Return error message.
+		return err.Error()
+	}
+
+	// Return response status.
+	return fmt.Sprintf("HTTP %d", resp.StatusCode)
+}
+
+// drainResponse discards and closes a response body so the connection can be reused.
+//
+// Params:
+//   - resp: response to discard, may be nil
+func drainResponse(resp *http.Response) {
+	// Nothing to drain without a body.
+	if resp == nil || resp.Body == nil {
+		// Return early.
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, RETRY_DRAIN_LIMIT))
+	_ = resp.Body.Close()
+}
+
+// sleepWithContext waits for the given duration or until the context is done.
+//
+// Params:
+//   - ctx: context bounding the wait
+//   - wait: duration to wait
+//
+// Returns:
+//   - error: context error if the wait was interrupted
+func sleepWithContext(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	// Wait for whichever happens first.
+	select {
+	// Context canceled or deadline exceeded.
+	case <-ctx.Done():
+		// Return context error.
+		return ctx.Err()
+	// Wait elapsed.
+	case <-timer.C:
+		// Return success.
+		return nil
+	}
+}
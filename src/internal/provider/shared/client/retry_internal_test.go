@@ -0,0 +1,252 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls the wrapped function.
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// fastRetryOptions returns options with waits short enough for tests.
+func fastRetryOptions(maxRetries int) ClientOptions {
+	return ClientOptions{
+		MaxRetries:   maxRetries,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 5 * time.Millisecond,
+	}
+}
+
+func Test_retryTransport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		maxRetries   int
+		wantStatus   int
+		wantAttempts int32
+	}{
+		{name: "success on first attempt", method: http.MethodGet, statuses: []int{200}, maxRetries: 3, wantStatus: 200, wantAttempts: 1},
+		{name: "retries GET on 503", method: http.MethodGet, statuses: []int{503, 502, 200}, maxRetries: 3, wantStatus: 200, wantAttempts: 3},
+		{name: "retries DELETE on 504", method: http.MethodDelete, statuses: []int{504, 204}, maxRetries: 3, wantStatus: 204, wantAttempts: 2},
+		{name: "retries POST on 429", method: http.MethodPost, statuses: []int{429, 201}, maxRetries: 3, wantStatus: 201, wantAttempts: 2},
+		{name: "does not retry POST on 503", method: http.MethodPost, statuses: []int{503, 201}, maxRetries: 3, wantStatus: 503, wantAttempts: 1},
+		{name: "does not retry 400", method: http.MethodGet, statuses: []int{400, 200}, maxRetries: 3, wantStatus: 400, wantAttempts: 1},
+		{name: "stops after max retries", method: http.MethodGet, statuses: []int{503, 503, 503, 503}, maxRetries: 2, wantStatus: 503, wantAttempts: 3},
+		{name: "error case - retries disabled", method: http.MethodGet, statuses: []int{503, 200}, maxRetries: 0, wantStatus: 503, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				idx := int(attempts.Add(1)) - 1
+				body, _ := io.ReadAll(r.Body)
+				if r.Method == http.MethodPost {
+					assert.Equal(t, `{"name":"test"}`, string(body), "Body should be replayed on every attempt")
+				}
+				w.WriteHeader(tt.statuses[min(idx, len(tt.statuses)-1)])
+			}))
+			defer server.Close()
+
+			httpClient := &http.Client{Transport: newRetryTransport(http.DefaultTransport, fastRetryOptions(tt.maxRetries))}
+			req, err := http.NewRequestWithContext(context.Background(), tt.method, server.URL, strings.NewReader(`{"name":"test"}`))
+			require.NoError(t, err)
+
+			resp, err := httpClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantAttempts, attempts.Load())
+		})
+	}
+}
+
+func Test_retryTransport_RoundTripErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+	}{
+		{name: "retries connection errors for idempotent methods"},
+		{name: "does not retry connection errors for POST"},
+		{name: "does not retry a body that cannot be replayed"},
+		{name: "error case - canceled context stops waiting"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			switch tt.name {
+			case "retries connection errors for idempotent methods":
+				var attempts atomic.Int32
+				next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					if attempts.Add(1) < 3 {
+						return nil, errors.New("connection reset by peer")
+					}
+					return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+				})
+				rt := newRetryTransport(next, fastRetryOptions(3))
+				req := httptest.NewRequest(http.MethodGet, "http://n8n.local/api/v1/workflows", nil)
+
+				resp, err := rt.RoundTrip(req)
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusOK, resp.StatusCode)
+				assert.Equal(t, int32(3), attempts.Load())
+
+			case "does not retry connection errors for POST":
+				var attempts atomic.Int32
+				next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					attempts.Add(1)
+					return nil, errors.New("connection reset by peer")
+				})
+				rt := newRetryTransport(next, fastRetryOptions(3))
+				req := httptest.NewRequest(http.MethodPost, "http://n8n.local/api/v1/workflows", nil)
+
+				_, err := rt.RoundTrip(req)
+				assert.Error(t, err)
+				assert.Equal(t, int32(1), attempts.Load())
+
+			case "does not retry a body that cannot be replayed":
+				var attempts atomic.Int32
+				next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					attempts.Add(1)
+					return &http.Response{StatusCode: http.StatusTooManyRequests, Body: http.NoBody, Request: req}, nil
+				})
+				rt := newRetryTransport(next, fastRetryOptions(3))
+				req := httptest.NewRequest(http.MethodPost, "http://n8n.local/api/v1/workflows", io.NopCloser(strings.NewReader("{}")))
+				req.GetBody = nil
+
+				resp, err := rt.RoundTrip(req)
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+				assert.Equal(t, int32(1), attempts.Load())
+
+			case "error case - canceled context stops waiting":
+				ctx, cancel := context.WithCancel(context.Background())
+				next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					cancel()
+					return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: http.NoBody, Request: req}, nil
+				})
+				rt := newRetryTransport(next, ClientOptions{MaxRetries: 3, RetryWaitMin: time.Hour, RetryWaitMax: time.Hour})
+				req := httptest.NewRequest(http.MethodGet, "http://n8n.local/api/v1/workflows", nil).WithContext(ctx)
+
+				resp, err := rt.RoundTrip(req)
+				assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "Canceled context returns the last response")
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_retryTransport_backoff(t *testing.T) {
+	t.Parallel()
+
+	rt := newRetryTransport(http.DefaultTransport, ClientOptions{MaxRetries: 5, RetryWaitMin: time.Second, RetryWaitMax: 10 * time.Second})
+
+	tests := []struct {
+		name    string
+		attempt int
+		header  string
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{name: "first retry uses minimum wait", attempt: 0, wantMin: 500 * time.Millisecond, wantMax: time.Second},
+		{name: "wait grows exponentially", attempt: 2, wantMin: 2 * time.Second, wantMax: 4 * time.Second},
+		{name: "wait is capped at maximum", attempt: 10, wantMin: 5 * time.Second, wantMax: 10 * time.Second},
+		{name: "Retry-After seconds are honored", attempt: 0, header: "3", wantMin: 3 * time.Second, wantMax: 3 * time.Second},
+		{name: "Retry-After is capped at maximum", attempt: 0, header: "120", wantMin: 10 * time.Second, wantMax: 10 * time.Second},
+		{name: "error case - invalid Retry-After falls back to backoff", attempt: 0, header: "soon", wantMin: 500 * time.Millisecond, wantMax: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+
+			wait := rt.backoff(tt.attempt, resp)
+			assert.GreaterOrEqual(t, wait, tt.wantMin)
+			assert.LessOrEqual(t, wait, tt.wantMax)
+		})
+	}
+}
+
+func Test_parseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "delay in seconds", value: "5", want: 5 * time.Second, wantOK: true},
+		{name: "HTTP date in the future", value: now.Add(30 * time.Second).Format(http.TimeFormat), want: 30 * time.Second, wantOK: true},
+		{name: "HTTP date in the past", value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0, wantOK: true},
+		{name: "negative seconds", value: "-3", want: 0, wantOK: true},
+		{name: "empty header", value: "", want: 0, wantOK: false},
+		{name: "error case - garbage value", value: "later", want: 0, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := parseRetryAfter(tt.value, now)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_isIdempotentMethod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		method string
+		want   bool
+	}{
+		{name: "GET", method: http.MethodGet, want: true},
+		{name: "PUT", method: http.MethodPut, want: true},
+		{name: "DELETE", method: http.MethodDelete, want: true},
+		{name: "HEAD", method: http.MethodHead, want: true},
+		{name: "POST", method: http.MethodPost, want: false},
+		{name: "error case - PATCH", method: http.MethodPatch, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, isIdempotentMethod(tt.method))
+		})
+	}
+}
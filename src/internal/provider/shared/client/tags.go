@@ -0,0 +1,217 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+)
+
+// TAG_PAGE_SIZE is the number of tags fetched per page when resolving default tags.
+const TAG_PAGE_SIZE int64 = MAX_PAGE_SIZE
+
+// DefaultTags resolves the provider default_tags names to n8n tag IDs.
+// Resolved IDs are cached for the lifetime of the provider so each name is
+// looked up at most once per run.
+type DefaultTags struct {
+	// names are the configured default tag names, sorted and deduplicated
+	names []string
+
+	// mu protects ids
+	mu sync.Mutex
+
+	// ids are the resolved tag IDs, nil until every name is resolved
+	ids []string
+}
+
+// NewDefaultTags creates a resolver for the given tag names.
+//
+// Params:
+//   - names: default tag names
+//
+// Returns:
+//   - *DefaultTags: resolver, nil when names is empty
+func NewDefaultTags(names []string) *DefaultTags {
+	unique := make(map[string]struct{}, len(names))
+	sorted := make([]string, 0, len(names))
+	// Deduplicate names.
+	for _, name := range names {
+		// Skip duplicates and empty names.
+		if _, seen := unique[name]; seen || name == "" {
+			continue
+		}
+		unique[name] = struct{}{}
+		sorted = append(sorted, name)
+	}
+
+	// No resolver without names.
+	if len(sorted) == 0 {
+		// Return nil.
+		return nil
+	}
+
+	sort.Strings(sorted)
+	// Return resolver.
+	return &DefaultTags{names: sorted}
+}
+
+// Names returns the configured default tag names.
+//
+// Returns:
+//   - []string: sorted tag names
+func (d *DefaultTags) Names() []string {
+	// Return a copy of the names.
+	return append([]string(nil), d.names...)
+}
+
+// Lookup returns the IDs of the default tags without creating missing tags.
+// It is safe to call at plan time.
+//
+// Params:
+//   - ctx: context for the API calls
+//   - api: n8n API client
+//
+// Returns:
+//   - []string: IDs of the existing default tags
+//   - bool: true when every default tag exists
+//   - error: error if the tags cannot be listed
+func (d *DefaultTags) Lookup(ctx context.Context, api *n8nsdk.APIClient) ([]string, bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// Use cached IDs when available.
+	if d.ids != nil {
+		// Return cached IDs.
+		return append([]string(nil), d.ids...), true, nil
+	}
+
+	existing, err := listTagIDsByName(ctx, api)
+	// Check for error.
+	if err != nil {
+		// Return error.
+		return nil, false, err
+	}
+
+	ids, missing := d.match(existing)
+	// Cache complete resolutions.
+	if len(missing) == 0 {
+		d.ids = ids
+	}
+
+	// Return existing IDs.
+	return append([]string(nil), ids...), len(missing) == 0, nil
+}
+
+// Resolve returns the IDs of the default tags, creating missing tags.
+//
+// Params:
+//   - ctx: context for the API calls
+//   - api: n8n API client
+//
+// Returns:
+//   - []string: IDs of every default tag
+//   - error: error if tags cannot be listed or created
+func (d *DefaultTags) Resolve(ctx context.Context, api *n8nsdk.APIClient) ([]string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// Use cached IDs when available.
+	if d.ids != nil {
+		// Return cached IDs.
+		return append([]string(nil), d.ids...), nil
+	}
+
+	existing, err := listTagIDsByName(ctx, api)
+	// Check for error.
+	if err != nil {
+		// Return error.
+		return nil, err
+	}
+
+	ids, missing := d.match(existing)
+	// Create each missing tag.
+	for _, name := range missing {
+		tag, httpResp, err := api.TagsAPI.TagsPost(ctx).Tag(n8nsdk.Tag{Name: name}).Execute()
+		// Check for non-nil value.
+		if httpResp != nil && httpResp.Body != nil {
+			httpResp.Body.Close()
+		}
+		// Check for error.
+		if err != nil {
+			// Return error.
+			return nil, fmt.Errorf("creating default tag %q: %w", name, err)
+		}
+		// Check for missing ID.
+		if tag == nil || tag.Id == nil {
+			// Return error.
+			return nil, fmt.Errorf("creating default tag %q: response has no ID", name)
+		}
+		ids = append(ids, *tag.Id)
+	}
+
+	sort.Strings(ids)
+	d.ids = ids
+
+	// Return resolved IDs.
+	return append([]string(nil), ids...), nil
+}
+
+// match splits the default names into resolved IDs and missing names.
+//
+// Params:
+//   - existing: tag IDs indexed by name
+//
+// Returns:
+//   - []string: IDs of the existing default tags
+//   - []string: names without an existing tag
+func (d *DefaultTags) match(existing map[string]string) ([]string, []string) {
+	ids := make([]string, 0, len(d.names))
+	var missing []string
+	// Look up each name.
+	for _, name := range d.names {
+		// Collect existing IDs.
+		if id, ok := existing[name]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		missing = append(missing, name)
+	}
+
+	sort.Strings(ids)
+	// Return IDs and missing names.
+	return ids, missing
+}
+
+// listTagIDsByName lists every tag of the instance, following pagination.
+//
+// Params:
+//   - ctx: context for the API calls
+//   - api: n8n API client
+//
+// Returns:
+//   - map[string]string: tag IDs indexed by name
+//   - error: error if a page cannot be fetched
+func listTagIDsByName(ctx context.Context, api *n8nsdk.APIClient) (map[string]string, error) {
+	tags := make(map[string]string)
+	// Index tags of every page.
+	for tag, err := range Paginate(api.TagsAPI.TagsGet(ctx), TAG_PAGE_SIZE) {
+		// Check for error.
+		if err != nil {
+			// Return error.
+			return nil, fmt.Errorf("listing tags: %w", err)
+		}
+		// Skip tags without ID.
+		if tag.Id != nil {
+			tags[tag.Name] = *tag.Id
+		}
+	}
+
+	// Return tags.
+	return tags, nil
+}
@@ -0,0 +1,215 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTagServer serves a paginated tag list and records created tags.
+type fakeTagServer struct {
+	mu       sync.Mutex
+	tags     map[string]string
+	pageSize int
+	lists    int
+	created  []string
+	failList bool
+}
+
+// ServeHTTP implements http.Handler for the tags endpoints.
+func (f *fakeTagServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch r.Method {
+	case http.MethodGet:
+		f.lists++
+		if f.failList {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		names := make([]string, 0, len(f.tags))
+		for name := range f.tags {
+			names = append(names, name)
+		}
+		// Serve a stable order split in pages.
+		sort.Strings(names)
+		start := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			for i, name := range names {
+				if name == cursor {
+					start = i
+				}
+			}
+		}
+		end := min(start+f.pageSize, len(names))
+		data := make([]map[string]string, 0, end-start)
+		for _, name := range names[start:end] {
+			data = append(data, map[string]string{"id": f.tags[name], "name": name})
+		}
+		body := map[string]any{"data": data}
+		if end < len(names) {
+			body["nextCursor"] = names[end]
+		}
+		_ = json.NewEncoder(w).Encode(body)
+
+	case http.MethodPost:
+		var tag map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&tag)
+		id := "id-" + tag["name"]
+		f.tags[tag["name"]] = id
+		f.created = append(f.created, tag["name"])
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": id, "name": tag["name"]})
+	}
+}
+
+func TestNewDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		names   []string
+		want    []string
+		wantNil bool
+	}{
+		{name: "sorts names", names: []string{"env:prod", "managed-by-terraform"}, want: []string{"env:prod", "managed-by-terraform"}},
+		{name: "removes duplicates and empty names", names: []string{"b", "a", "b", ""}, want: []string{"a", "b"}},
+		{name: "error case - nil without names", names: nil, wantNil: true},
+		{name: "error case - nil with only empty names", names: []string{""}, wantNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := client.NewDefaultTags(tt.names)
+			if tt.wantNil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want, got.Names())
+		})
+	}
+}
+
+func TestDefaultTags_Lookup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		existing     map[string]string
+		failList     bool
+		wantIDs      []string
+		wantComplete bool
+		wantErr      bool
+	}{
+		{
+			name:         "resolves existing tags across pages",
+			existing:     map[string]string{"a": "1", "managed": "2", "other": "3", "prod": "4"},
+			wantIDs:      []string{"2", "4"},
+			wantComplete: true,
+		},
+		{
+			name:         "reports missing tags without creating them",
+			existing:     map[string]string{"managed": "2"},
+			wantIDs:      []string{"2"},
+			wantComplete: false,
+		},
+		{
+			name:     "error case - list failure",
+			existing: map[string]string{},
+			failList: true,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &fakeTagServer{tags: tt.existing, pageSize: 2, failList: tt.failList}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			c := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{})
+			defaults := client.NewDefaultTags([]string{"managed", "prod"})
+
+			ids, complete, err := defaults.Lookup(context.Background(), c.APIClient)
+
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantComplete, complete)
+			assert.Empty(t, fake.created, "Lookup should never create tags")
+		})
+	}
+}
+
+func TestDefaultTags_Resolve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		existing    map[string]string
+		failList    bool
+		wantIDs     []string
+		wantCreated []string
+		wantErr     bool
+	}{
+		{
+			name:        "creates missing tags",
+			existing:    map[string]string{"managed": "2"},
+			wantIDs:     []string{"2", "id-prod"},
+			wantCreated: []string{"prod"},
+		},
+		{
+			name:     "reuses existing tags",
+			existing: map[string]string{"managed": "2", "prod": "4"},
+			wantIDs:  []string{"2", "4"},
+		},
+		{
+			name:     "error case - list failure",
+			existing: map[string]string{},
+			failList: true,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &fakeTagServer{tags: tt.existing, pageSize: 10, failList: tt.failList}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			c := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{})
+			defaults := client.NewDefaultTags([]string{"managed", "prod"})
+
+			ids, err := defaults.Resolve(context.Background(), c.APIClient)
+
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantCreated, fake.created)
+
+			// Check that the resolution is cached.
+			if !tt.wantErr {
+				lists := fake.lists
+				cached, complete, err := defaults.Lookup(context.Background(), c.APIClient)
+				require.NoError(t, err)
+				assert.True(t, complete)
+				assert.Equal(t, tt.wantIDs, cached)
+				assert.Equal(t, lists, fake.lists, "Cached IDs should not list tags again")
+			}
+		})
+	}
+}
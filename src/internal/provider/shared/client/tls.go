@@ -0,0 +1,173 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// TLSOptions holds the PEM material used to build the TLS configuration of the client.
+type TLSOptions struct {
+	// CACertPEM holds one or more PEM certificates trusted in addition to the system pool
+	CACertPEM []byte
+
+	// ClientCertPEM holds the PEM client certificate chain for mutual TLS
+	ClientCertPEM []byte
+
+	// ClientKeyPEM holds the PEM private key matching ClientCertPEM
+	ClientKeyPEM []byte
+
+	// InsecureSkipVerify disables server certificate verification
+	InsecureSkipVerify bool
+}
+
+// IsEmpty reports whether no TLS customization is requested.
+//
+// Returns:
+//   - bool: true when the default TLS settings apply
+func (o TLSOptions) IsEmpty() bool {
+	// Return true when nothing is set.
+	return len(o.CACertPEM) == 0 && len(o.ClientCertPEM) == 0 && len(o.ClientKeyPEM) == 0 && !o.InsecureSkipVerify
+}
+
+// NewTLSConfig builds a TLS configuration from PEM material.
+// Custom CA certificates are appended to the system certificate pool.
+//
+// Params:
+//   - opts: PEM material and verification settings
+//
+// Returns:
+//   - *tls.Config: TLS configuration for the HTTP transport
+//   - error: error if a PEM block does not parse or the key pair is incomplete
+func NewTLSConfig(opts TLSOptions) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		//nolint:gosec // Explicitly requested through insecure_skip_verify.
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+
+	// Trust the custom CA bundle when provided.
+	if len(opts.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		// Fall back to an empty pool when the system pool is unavailable.
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		certs, err := ParseCertificatesPEM(opts.CACertPEM)
+		// Check for error.
+		if err != nil {
+			// Return error.
+			return nil, fmt.Errorf("invalid CA certificate: %w", err)
+		}
+		// Add every certificate of the bundle.
+		for _, cert := range certs {
+			pool.AddCert(cert)
+		}
+		cfg.RootCAs = pool
+	}
+
+	// Require both halves of the client key pair.
+	if (len(opts.ClientCertPEM) > 0) != (len(opts.ClientKeyPEM) > 0) {
+		// Return error.
+		return nil, errors.New("client certificate and client key must be set together")
+	}
+
+	// Load the client key pair for mutual TLS.
+	if len(opts.ClientCertPEM) > 0 {
+		pair, err := tls.X509KeyPair(opts.ClientCertPEM, opts.ClientKeyPEM)
+		// Check for error.
+		if err != nil {
+			// Return error.
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{pair}
+	}
+
+	// Return TLS configuration.
+	return cfg, nil
+}
+
+// ParseCertificatesPEM parses every CERTIFICATE block of a PEM bundle.
+//
+// Params:
+//   - data: PEM encoded certificates
+//
+// Returns:
+//   - []*x509.Certificate: parsed certificates
+//   - error: error if no certificate is found or a block does not parse
+func ParseCertificatesPEM(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := data
+
+	// Decode PEM blocks until the input is exhausted.
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		// Stop when no more block is found.
+		if block == nil {
+			break
+		}
+		// Ignore blocks that are not certificates.
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		// Check for error.
+		if err != nil {
+			// Return error.
+			return nil, fmt.Errorf("parsing certificate %d: %w", len(certs)+1, err)
+		}
+		certs = append(certs, cert)
+	}
+
+	// A bundle without any certificate is invalid.
+	if len(certs) == 0 {
+		// Return error.
+		return nil, errors.New("no PEM encoded CERTIFICATE block found")
+	}
+
+	// Return certificates.
+	return certs, nil
+}
+
+// ValidatePrivateKeyPEM checks that the data holds a parseable PEM private key.
+// PKCS#1, PKCS#8 and SEC 1 (EC) encodings are accepted.
+//
+// Params:
+//   - data: PEM encoded private key
+//
+// Returns:
+//   - error: error if no private key block parses
+func ValidatePrivateKeyPEM(data []byte) error {
+	block, _ := pem.Decode(data)
+	// Check that a PEM block is present.
+	if block == nil {
+		// Return error.
+		return errors.New("no PEM encoded private key block found")
+	}
+
+	// Try every supported encoding.
+	if _, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		// Return success.
+		return nil
+	}
+	// Try PKCS#1 RSA keys.
+	if _, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		// Return success.
+		return nil
+	}
+	// Try SEC 1 EC keys.
+	if _, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		// Return success.
+		return nil
+	}
+
+	// Return error.
+	return fmt.Errorf("PEM block of type %q is not a PKCS#1, PKCS#8 or EC private key", block.Type)
+}
@@ -0,0 +1,249 @@
+package client_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// generateTestCertificate creates a self-signed certificate and returns its PEM encoded cert and key.
+func generateTestCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "n8n-test-client"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM
+}
+
+// serverCertificatePEM returns the PEM encoded certificate of a TLS test server.
+func serverCertificatePEM(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := generateTestCertificate(t)
+	_, otherKeyPEM := generateTestCertificate(t)
+
+	tests := []struct {
+		name            string
+		opts            client.TLSOptions
+		wantErr         bool
+		wantRootCAs     bool
+		wantClientCerts int
+	}{
+		{name: "empty options", opts: client.TLSOptions{}},
+		{name: "custom CA bundle", opts: client.TLSOptions{CACertPEM: certPEM}, wantRootCAs: true},
+		{name: "client key pair", opts: client.TLSOptions{ClientCertPEM: certPEM, ClientKeyPEM: keyPEM}, wantClientCerts: 1},
+		{name: "insecure skip verify", opts: client.TLSOptions{InsecureSkipVerify: true}},
+		{name: "error case - invalid CA bundle", opts: client.TLSOptions{CACertPEM: []byte("not a pem")}, wantErr: true},
+		{name: "error case - certificate without key", opts: client.TLSOptions{ClientCertPEM: certPEM}, wantErr: true},
+		{name: "error case - mismatched key pair", opts: client.TLSOptions{ClientCertPEM: certPEM, ClientKeyPEM: otherKeyPEM}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, err := client.NewTLSConfig(tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, cfg)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.opts.InsecureSkipVerify, cfg.InsecureSkipVerify)
+			assert.Equal(t, tt.wantRootCAs, cfg.RootCAs != nil)
+			assert.Len(t, cfg.Certificates, tt.wantClientCerts)
+			assert.Equal(t, uint16(tls.VersionTLS12), cfg.MinVersion)
+		})
+	}
+}
+
+func TestNewTLSConfig_Connectivity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+	}{
+		{name: "trusts server through custom CA"},
+		{name: "presents client certificate for mutual TLS"},
+		{name: "error case - untrusted server certificate"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":[]}`))
+			})
+
+			switch tt.name {
+			case "trusts server through custom CA":
+				server := httptest.NewTLSServer(handler)
+				defer server.Close()
+
+				tlsConfig, err := client.NewTLSConfig(client.TLSOptions{CACertPEM: serverCertificatePEM(server)})
+				require.NoError(t, err)
+
+				c := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{TLSConfig: tlsConfig})
+				_, httpResp, err := c.APIClient.TagsAPI.TagsGet(context.Background()).Execute()
+				require.NoError(t, err)
+				defer httpResp.Body.Close()
+
+			case "presents client certificate for mutual TLS":
+				certPEM, keyPEM := generateTestCertificate(t)
+				clientCAs := x509.NewCertPool()
+				require.True(t, clientCAs.AppendCertsFromPEM(certPEM))
+
+				server := httptest.NewUnstartedServer(handler)
+				server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+				server.StartTLS()
+				defer server.Close()
+
+				tlsConfig, err := client.NewTLSConfig(client.TLSOptions{
+					CACertPEM:     serverCertificatePEM(server),
+					ClientCertPEM: certPEM,
+					ClientKeyPEM:  keyPEM,
+				})
+				require.NoError(t, err)
+
+				c := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{TLSConfig: tlsConfig})
+				_, httpResp, err := c.APIClient.TagsAPI.TagsGet(context.Background()).Execute()
+				require.NoError(t, err)
+				defer httpResp.Body.Close()
+
+			case "error case - untrusted server certificate":
+				server := httptest.NewTLSServer(handler)
+				defer server.Close()
+
+				c := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{})
+				_, httpResp, err := c.APIClient.TagsAPI.TagsGet(context.Background()).Execute()
+				if httpResp != nil {
+					defer httpResp.Body.Close()
+				}
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParseCertificatesPEM(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := generateTestCertificate(t)
+	otherPEM, _ := generateTestCertificate(t)
+
+	tests := []struct {
+		name      string
+		data      []byte
+		wantCount int
+		wantErr   bool
+	}{
+		{name: "single certificate", data: certPEM, wantCount: 1},
+		{name: "bundle of certificates", data: append(append([]byte{}, certPEM...), otherPEM...), wantCount: 2},
+		{name: "ignores non certificate blocks", data: append(append([]byte{}, keyPEM...), certPEM...), wantCount: 1},
+		{name: "error case - empty input", data: nil, wantErr: true},
+		{name: "error case - only a key", data: keyPEM, wantErr: true},
+		{name: "error case - corrupted certificate", data: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")}), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			certs, err := client.ParseCertificatesPEM(tt.data)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Len(t, certs, tt.wantCount)
+		})
+	}
+}
+
+func TestValidatePrivateKeyPEM(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := generateTestCertificate(t)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{name: "PKCS#8 key", data: keyPEM},
+		{name: "error case - certificate instead of key", data: certPEM, wantErr: true},
+		{name: "error case - not PEM", data: []byte("secret"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := client.ValidatePrivateKeyPEM(tt.data)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestTLSOptions_IsEmpty(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts client.TLSOptions
+		want bool
+	}{
+		{name: "zero value", opts: client.TLSOptions{}, want: true},
+		{name: "insecure only", opts: client.TLSOptions{InsecureSkipVerify: true}, want: false},
+		{name: "error case - CA set", opts: client.TLSOptions{CACertPEM: []byte("x")}, want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.opts.IsEmpty())
+		})
+	}
+}
@@ -0,0 +1,295 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+)
+
+// apiErrorSummaries are the short reasons appended to the summary of API error diagnostics.
+var apiErrorSummaries map[APIErrorClass]string = map[APIErrorClass]string{
+	API_ERROR_BAD_REQUEST:  "invalid request",
+	API_ERROR_UNAUTHORIZED: "invalid API key",
+	API_ERROR_FORBIDDEN:    "forbidden by license or API key scope",
+	API_ERROR_NOT_FOUND:    "not found",
+	API_ERROR_CONFLICT:     "conflict",
+	API_ERROR_RATE_LIMITED: "rate limited",
+	API_ERROR_SERVER:       "n8n server error",
+}
+
+// apiErrorAdvice explains each class of API error and how to resolve it.
+var apiErrorAdvice map[APIErrorClass]string = map[APIErrorClass]string{
+	API_ERROR_BAD_REQUEST: "The n8n API rejected the request as invalid. Check the configured values.",
+	API_ERROR_UNAUTHORIZED: "The n8n instance rejected the API key. Check the api_key provider setting " +
+		"(or N8N_API_KEY) and that the key has not been revoked or expired.",
+	API_ERROR_FORBIDDEN: "The n8n instance denied the request. The feature may require a license the instance " +
+		"does not have (e.g., projects or variables), or the API key may lack the required scope.",
+	API_ERROR_NOT_FOUND: "The object does not exist on the n8n instance.",
+	API_ERROR_CONFLICT: "The request conflicts with the current state of the n8n instance, " +
+		"e.g., an object with the same name or key already exists.",
+	API_ERROR_RATE_LIMITED: "The n8n instance throttled the request. Lower requests_per_second or raise max_retries.",
+	API_ERROR_SERVER:       "The n8n instance failed to process the request. Check the n8n logs.",
+}
+
+// APIError is the decoded failure of an n8n API call.
+type APIError struct {
+	// Status is the HTTP error status, 0 when the failure has no status
+	Status int
+
+	// Message is the message of the n8n error body, or the SDK error message
+	Message string
+
+	// Code is the n8n error code, empty when absent
+	Code string
+
+	// Hint is the n8n suggestion to fix the request, empty when absent
+	Hint string
+}
+
+// apiErrorBody is the JSON error body returned by the n8n API.
+type apiErrorBody struct {
+	// Message describes the error
+	Message string `json:"message"`
+
+	// Code is a string or a number depending on the n8n version
+	Code any `json:"code"`
+
+	// Hint suggests how to fix the request
+	Hint string `json:"hint"`
+
+	// Description is the hint of older n8n versions
+	Description string `json:"description"`
+}
+
+// DecodeAPIError decodes the failure of an SDK call, including the n8n error body.
+//
+// Params:
+//   - err: error returned by the SDK
+//   - httpResp: HTTP response returned by the SDK, may be nil
+//
+// Returns:
+//   - APIError: decoded failure
+func DecodeAPIError(err error, httpResp *http.Response) APIError {
+	apiErr := APIError{Status: APIErrorStatus(err, httpResp)}
+	// Fall back to the SDK message.
+	if err != nil {
+		apiErr.Message = err.Error()
+	}
+
+	var sdkErr *n8nsdk.GenericOpenAPIError
+	// Only SDK errors carry the error body.
+	if errors.As(err, &sdkErr) {
+		apiErr.decodeBody(sdkErr.Body())
+	}
+
+	// Return decoded failure.
+	return apiErr
+}
+
+// ParseAPIError decodes the error body of a request sent without the SDK.
+//
+// Params:
+//   - status: HTTP status of the response
+//   - body: body of the response
+//
+// Returns:
+//   - APIError: decoded failure
+func ParseAPIError(status int, body []byte) APIError {
+	apiErr := APIError{Message: fmt.Sprintf("%d %s", status, http.StatusText(status))}
+	// Only error statuses are classified.
+	if status >= MIN_ERROR_STATUS {
+		apiErr.Status = status
+	}
+	apiErr.decodeBody(body)
+
+	// Return decoded failure.
+	return apiErr
+}
+
+// decodeBody fills the failure from an n8n error body.
+// Bodies that are not JSON objects (e.g., proxy error pages) are ignored.
+//
+// Params:
+//   - body: error body
+func (e *APIError) decodeBody(body []byte) {
+	var decoded apiErrorBody
+	// Ignore bodies that are not n8n errors.
+	if len(body) == 0 || json.Unmarshal(body, &decoded) != nil {
+		return
+	}
+
+	// Prefer the n8n message.
+	if decoded.Message != "" {
+		e.Message = decoded.Message
+	}
+	// Normalize the code.
+	switch code := decoded.Code.(type) {
+	case string:
+		e.Code = code
+	case float64:
+		e.Code = strconv.FormatFloat(code, 'f', -1, 64)
+	}
+	e.Hint = decoded.Hint
+	// Fall back to the description.
+	if e.Hint == "" {
+		e.Hint = decoded.Description
+	}
+}
+
+// Class classifies the failure by its HTTP status.
+//
+// Returns:
+//   - APIErrorClass: class of the failure
+func (e APIError) Class() APIErrorClass {
+	// Return class of the status.
+	return classifyStatus(e.Status)
+}
+
+// Summary appends the reason of the failure to a diagnostic summary.
+//
+// Params:
+//   - summary: summary of the failed operation (e.g., "Error creating tag")
+//
+// Returns:
+//   - string: summary with the reason, e.g. "Error creating tag: conflict"
+func (e APIError) Summary(summary string) string {
+	reason, found := apiErrorSummaries[e.Class()]
+	// Keep the summary of unclassified failures.
+	if !found {
+		// Return summary unchanged.
+		return summary
+	}
+
+	// Return summary with reason.
+	return fmt.Sprintf("%s: %s", summary, reason)
+}
+
+// Detail builds the diagnostic detail from the decoded failure.
+//
+// Params:
+//   - detail: description of the failed operation (e.g., "Could not create tag")
+//
+// Returns:
+//   - string: detail with the status, the n8n message, code and hint, and advice
+func (e APIError) Detail(detail string) string {
+	var b strings.Builder
+	b.WriteString(detail)
+	// Report the status.
+	if e.Status != 0 {
+		fmt.Fprintf(&b, " (HTTP %d)", e.Status)
+	}
+	fmt.Fprintf(&b, ": %s", e.Message)
+	// Report codes that add to the status.
+	if e.Code != "" && e.Code != strconv.Itoa(e.Status) {
+		fmt.Fprintf(&b, "\nCode: %s", e.Code)
+	}
+	// Report the n8n hint.
+	if e.Hint != "" {
+		fmt.Fprintf(&b, "\nHint: %s", e.Hint)
+	}
+	// Explain the class.
+	if advice, found := apiErrorAdvice[e.Class()]; found {
+		fmt.Fprintf(&b, "\n\n%s", advice)
+	}
+
+	// Return detail.
+	return b.String()
+}
+
+// AddTo adds the failure to diagnostics.
+// The attribute path is attached only to failures caused by the request content
+// (HTTP 400, 409 and 422); an invalid key or a server error is not about the attribute.
+//
+// Params:
+//   - diags: diagnostics to append to
+//   - attributePath: attribute the request was built from, path.Empty() for none
+//   - summary: summary of the failed operation (e.g., "Error creating tag")
+//   - detail: description of the failed operation (e.g., "Could not create tag")
+func (e APIError) AddTo(diags *diag.Diagnostics, attributePath path.Path, summary, detail string) {
+	class := e.Class()
+	// Point at the attribute when its value caused the failure.
+	if !attributePath.Equal(path.Empty()) && (class == API_ERROR_BAD_REQUEST || class == API_ERROR_CONFLICT) {
+		diags.AddAttributeError(attributePath, e.Summary(summary), e.Detail(detail))
+		// Return after attribute error.
+		return
+	}
+
+	diags.AddError(e.Summary(summary), e.Detail(detail))
+}
+
+// AddAPIError adds the failure of an SDK call to diagnostics.
+//
+// Params:
+//   - diags: diagnostics to append to
+//   - summary: summary of the failed operation (e.g., "Error reading workflow")
+//   - detail: description of the failed operation (e.g., "Could not read workflow ID 1")
+//   - err: error returned by the SDK
+//   - httpResp: HTTP response returned by the SDK, may be nil
+func AddAPIError(diags *diag.Diagnostics, summary, detail string, err error, httpResp *http.Response) {
+	// Report requests refused by read-only mode on their own.
+	if addReadOnlyError(diags, detail, err) {
+		// Return after read-only error.
+		return
+	}
+
+	DecodeAPIError(err, httpResp).AddTo(diags, path.Empty(), summary, detail)
+}
+
+// AddAPIAttributeError adds the failure of an SDK call to diagnostics,
+// pointing at the attribute when the request content caused the failure.
+//
+// Params:
+//   - diags: diagnostics to append to
+//   - attributePath: attribute the request was built from
+//   - summary: summary of the failed operation (e.g., "Error creating tag")
+//   - detail: description of the failed operation (e.g., "Could not create tag")
+//   - err: error returned by the SDK
+//   - httpResp: HTTP response returned by the SDK, may be nil
+func AddAPIAttributeError(diags *diag.Diagnostics, attributePath path.Path, summary, detail string, err error, httpResp *http.Response) {
+	// Report requests refused by read-only mode on their own.
+	if addReadOnlyError(diags, detail, err) {
+		// Return after read-only error.
+		return
+	}
+
+	DecodeAPIError(err, httpResp).AddTo(diags, attributePath, summary, detail)
+}
+
+// addReadOnlyError adds a dedicated diagnostic when the request was refused by read-only mode,
+// which is a provider setting rather than a failure of the instance.
+//
+// Params:
+//   - diags: diagnostics to append to
+//   - detail: description of the failed operation (e.g., "Could not create tag")
+//   - err: error returned by the SDK
+//
+// Returns:
+//   - bool: true when the error was a read-only refusal
+func addReadOnlyError(diags *diag.Diagnostics, detail string, err error) bool {
+	var readOnlyErr *client.ReadOnlyError
+	// Only read-only refusals are handled here.
+	if !errors.As(err, &readOnlyErr) {
+		// Return not handled.
+		return false
+	}
+
+	diags.AddError(
+		"Provider Is Read-Only",
+		fmt.Sprintf("%s: the provider refused %s %s because read-only mode is enabled (read_only or N8N_READ_ONLY). "+
+			"Disable read-only mode to apply changes to the n8n instance.", detail, readOnlyErr.Method, readOnlyErr.Path),
+	)
+	// Return handled.
+	return true
+}
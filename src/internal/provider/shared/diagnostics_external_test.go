@@ -0,0 +1,243 @@
+package shared_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDecodeAPIError tests the DecodeAPIError function.
+func TestDecodeAPIError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   shared.APIError
+	}{
+		{
+			name:   "message and numeric code",
+			status: http.StatusConflict,
+			body:   `{"code":409,"message":"Tag \"prod\" already exists"}`,
+			want:   shared.APIError{Status: 409, Message: `Tag "prod" already exists`, Code: "409"},
+		},
+		{
+			name:   "string code and hint",
+			status: http.StatusForbidden,
+			body:   `{"code":"FEATURE_NOT_LICENSED","message":"Plan lacks license for this feature","hint":"Upgrade to Enterprise"}`,
+			want: shared.APIError{
+				Status:  403,
+				Message: "Plan lacks license for this feature",
+				Code:    "FEATURE_NOT_LICENSED",
+				Hint:    "Upgrade to Enterprise",
+			},
+		},
+		{
+			name:   "description as hint",
+			status: http.StatusBadRequest,
+			body:   `{"message":"request/body must have required property 'name'","description":"Set a name"}`,
+			want:   shared.APIError{Status: 400, Message: "request/body must have required property 'name'", Hint: "Set a name"},
+		},
+		{
+			name:   "error case - body is not an n8n error",
+			status: http.StatusBadGateway,
+			body:   `<html>Bad Gateway</html>`,
+			want:   shared.APIError{Status: 502, Message: "502 Bad Gateway"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			httpResp, err := getTagWithError(t, tt.status, tt.body)
+
+			assert.Equal(t, tt.want, shared.DecodeAPIError(err, httpResp))
+		})
+	}
+
+	t.Run("error case - transport error", func(t *testing.T) {
+		t.Parallel()
+
+		apiErr := shared.DecodeAPIError(errors.New("connection refused"), nil)
+
+		assert.Equal(t, shared.APIError{Message: "connection refused"}, apiErr)
+		assert.Equal(t, shared.API_ERROR_OTHER, apiErr.Class())
+	})
+}
+
+// TestParseAPIError tests the ParseAPIError function.
+func TestParseAPIError(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		shared.APIError{Status: 409, Message: "User already exists", Code: "409"},
+		shared.ParseAPIError(http.StatusConflict, []byte(`{"code":409,"message":"User already exists"}`)),
+	)
+	assert.Equal(t,
+		shared.APIError{Message: "200 OK"},
+		shared.ParseAPIError(http.StatusOK, nil),
+		"error case - successful status",
+	)
+}
+
+// TestAPIError_Summary tests the Summary method.
+func TestAPIError_Summary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		want   string
+	}{
+		{name: "bad request", status: http.StatusBadRequest, want: "Error creating tag: invalid request"},
+		{name: "unauthorized", status: http.StatusUnauthorized, want: "Error creating tag: invalid API key"},
+		{name: "forbidden", status: http.StatusForbidden, want: "Error creating tag: forbidden by license or API key scope"},
+		{name: "not found", status: http.StatusNotFound, want: "Error creating tag: not found"},
+		{name: "conflict", status: http.StatusConflict, want: "Error creating tag: conflict"},
+		{name: "rate limited", status: http.StatusTooManyRequests, want: "Error creating tag: rate limited"},
+		{name: "server error", status: http.StatusServiceUnavailable, want: "Error creating tag: n8n server error"},
+		{name: "unexpected status", status: http.StatusTeapot, want: "Error creating tag"},
+		{name: "error case - no status", status: 0, want: "Error creating tag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, shared.APIError{Status: tt.status}.Summary("Error creating tag"))
+		})
+	}
+}
+
+// TestAPIError_Detail tests the Detail method.
+func TestAPIError_Detail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		apiErr shared.APIError
+		want   string
+	}{
+		{
+			name:   "status code and hint",
+			apiErr: shared.APIError{Status: 403, Message: "Plan lacks license", Code: "FEATURE_NOT_LICENSED", Hint: "Upgrade"},
+			want: "Could not create tag (HTTP 403): Plan lacks license\nCode: FEATURE_NOT_LICENSED\nHint: Upgrade\n\n" +
+				"The n8n instance denied the request. The feature may require a license the instance " +
+				"does not have (e.g., projects or variables), or the API key may lack the required scope.",
+		},
+		{
+			name:   "code equal to the status is omitted",
+			apiErr: shared.APIError{Status: 404, Message: "Not Found", Code: "404"},
+			want:   "Could not create tag (HTTP 404): Not Found\n\nThe object does not exist on the n8n instance.",
+		},
+		{
+			name:   "error case - no status",
+			apiErr: shared.APIError{Message: "connection refused"},
+			want:   "Could not create tag: connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.apiErr.Detail("Could not create tag"))
+		})
+	}
+}
+
+// TestAddAPIAttributeError tests the AddAPIAttributeError function.
+func TestAddAPIAttributeError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		status   int
+		wantPath bool
+	}{
+		{name: "conflict points at the attribute", status: http.StatusConflict, wantPath: true},
+		{name: "bad request points at the attribute", status: http.StatusBadRequest, wantPath: true},
+		{name: "invalid key is not about the attribute", status: http.StatusUnauthorized, wantPath: false},
+		{name: "error case - server error is not about the attribute", status: http.StatusInternalServerError, wantPath: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			httpResp, err := getTagWithStatus(t, tt.status)
+			var diags diag.Diagnostics
+
+			shared.AddAPIAttributeError(&diags, path.Root("name"), "Error creating tag", "Could not create tag", err, httpResp)
+
+			require.Len(t, diags.Errors(), 1)
+			withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+			assert.Equal(t, tt.wantPath, ok)
+			// Check the attribute.
+			if tt.wantPath {
+				assert.Equal(t, path.Root("name"), withPath.Path())
+			}
+		})
+	}
+}
+
+// TestAddAPIError tests the AddAPIError function.
+func TestAddAPIError(t *testing.T) {
+	t.Parallel()
+
+	httpResp, err := getTagWithError(t, http.StatusUnauthorized, `{"message":"unauthorized"}`)
+	var diags diag.Diagnostics
+
+	shared.AddAPIError(&diags, "Error reading tag", "Could not read tag ID tag-1", err, httpResp)
+
+	require.Len(t, diags.Errors(), 1)
+	assert.Equal(t, "Error reading tag: invalid API key", diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), "Could not read tag ID tag-1 (HTTP 401): unauthorized")
+	assert.Contains(t, diags.Errors()[0].Detail(), "api_key")
+}
+
+// TestAddAPIError_readOnly tests that requests refused by read-only mode get a dedicated diagnostic.
+func TestAddAPIError_readOnly(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		attributePath path.Path
+	}{
+		{name: "without attribute", attributePath: path.Empty()},
+		{name: "with attribute", attributePath: path.Root("name")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			n8nClient := client.NewN8nClientWithOptions("http://127.0.0.1:0", "key", client.ClientOptions{ReadOnly: true})
+			_, httpResp, err := n8nClient.APIClient.TagsAPI.TagsPost(context.Background()).Tag(*n8nsdk.NewTag("name")).Execute()
+			require.Error(t, err)
+			var diags diag.Diagnostics
+
+			// Check both entry points.
+			if tt.attributePath.Equal(path.Empty()) {
+				shared.AddAPIError(&diags, "Error creating tag", "Could not create tag", err, httpResp)
+			} else {
+				shared.AddAPIAttributeError(&diags, tt.attributePath, "Error creating tag", "Could not create tag", err, httpResp)
+			}
+
+			require.Len(t, diags.Errors(), 1)
+			assert.Equal(t, "Provider Is Read-Only", diags.Errors()[0].Summary())
+			assert.Contains(t, diags.Errors()[0].Detail(), "Could not create tag: the provider refused POST /api/v1/tags")
+		})
+	}
+}
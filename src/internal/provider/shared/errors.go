@@ -0,0 +1,215 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+)
+
+// APIErrorClass classifies the failure of an n8n API call.
+type APIErrorClass int
+
+// API error classes.
+const (
+	// API_ERROR_OTHER is a failure without HTTP status, e.g. a transport or decoding error.
+	API_ERROR_OTHER APIErrorClass = iota
+
+	// API_ERROR_BAD_REQUEST is a request rejected as invalid (HTTP 400 or 422).
+	API_ERROR_BAD_REQUEST
+
+	// API_ERROR_UNAUTHORIZED is a request with a missing or invalid API key (HTTP 401).
+	API_ERROR_UNAUTHORIZED
+
+	// API_ERROR_FORBIDDEN is a request denied by the license or the key scopes (HTTP 403).
+	API_ERROR_FORBIDDEN
+
+	// API_ERROR_NOT_FOUND is a request on an object that does not exist (HTTP 404).
+	API_ERROR_NOT_FOUND
+
+	// API_ERROR_CONFLICT is a request conflicting with the current state of an object (HTTP 409).
+	API_ERROR_CONFLICT
+
+	// API_ERROR_RATE_LIMITED is a request throttled by the instance (HTTP 429).
+	API_ERROR_RATE_LIMITED
+
+	// API_ERROR_SERVER is a failure of the instance (HTTP 5xx).
+	API_ERROR_SERVER
+
+	// API_ERROR_UNEXPECTED is any other HTTP error status.
+	API_ERROR_UNEXPECTED
+)
+
+// MIN_ERROR_STATUS is the lowest HTTP status reported as an API error.
+const MIN_ERROR_STATUS int = 400
+
+// APIErrorStatus returns the HTTP status of a failed API call.
+// The response status is used when available; otherwise the status is read
+// from the message of the SDK error (e.g., "404 Not Found").
+//
+// Params:
+//   - err: error returned by the SDK
+//   - httpResp: HTTP response returned by the SDK, may be nil
+//
+// Returns:
+//   - int: HTTP error status, 0 when the failure has no error status
+func APIErrorStatus(err error, httpResp *http.Response) int {
+	// No status without error.
+	if err == nil {
+		// Return no status.
+		return 0
+	}
+	// Prefer the response status.
+	if httpResp != nil {
+		// Decoding errors come with a successful response.
+		if httpResp.StatusCode < MIN_ERROR_STATUS {
+			// Return no status.
+			return 0
+		}
+		// Return response status.
+		return httpResp.StatusCode
+	}
+
+	var apiErr *n8nsdk.GenericOpenAPIError
+	// Only SDK errors carry a status message.
+	if !errors.As(err, &apiErr) {
+		// Return no status.
+		return 0
+	}
+	code, _, _ := strings.Cut(apiErr.Error(), " ")
+	status, parseErr := strconv.Atoi(code)
+	// Check for a status message.
+	if parseErr != nil || status < MIN_ERROR_STATUS {
+		// Return no status.
+		return 0
+	}
+	// Return parsed status.
+	return status
+}
+
+// ClassifyAPIError classifies the failure of an API call by its HTTP status.
+//
+// Params:
+//   - err: error returned by the SDK
+//   - httpResp: HTTP response returned by the SDK, may be nil
+//
+// Returns:
+//   - APIErrorClass: class of the failure
+func ClassifyAPIError(err error, httpResp *http.Response) APIErrorClass {
+	// Return class of the status.
+	return classifyStatus(APIErrorStatus(err, httpResp))
+}
+
+// classifyStatus maps an HTTP error status to its class.
+//
+// Params:
+//   - status: HTTP error status, 0 when the failure has no status
+//
+// Returns:
+//   - APIErrorClass: class of the status
+func classifyStatus(status int) APIErrorClass {
+	// Map the status to its class.
+	switch {
+	case status == 0:
+		// Return class.
+		return API_ERROR_OTHER
+	case status == http.StatusBadRequest, status == http.StatusUnprocessableEntity:
+		// Return class.
+		return API_ERROR_BAD_REQUEST
+	case status == http.StatusUnauthorized:
+		// Return class.
+		return API_ERROR_UNAUTHORIZED
+	case status == http.StatusForbidden:
+		// Return class.
+		return API_ERROR_FORBIDDEN
+	case status == http.StatusNotFound:
+		// Return class.
+		return API_ERROR_NOT_FOUND
+	case status == http.StatusConflict:
+		// Return class.
+		return API_ERROR_CONFLICT
+	case status == http.StatusTooManyRequests:
+		// Return class.
+		return API_ERROR_RATE_LIMITED
+	case status >= http.StatusInternalServerError:
+		// Return class.
+		return API_ERROR_SERVER
+	default:
+		// Return class.
+		return API_ERROR_UNEXPECTED
+	}
+}
+
+// IsNotFound reports whether an API call failed because the object does not exist.
+// Read uses it to remove objects deleted outside Terraform from the state,
+// and Delete to treat them as already deleted.
+//
+// Params:
+//   - err: error returned by the SDK
+//   - httpResp: HTTP response returned by the SDK, may be nil
+//
+// Returns:
+//   - bool: true on HTTP 404
+func IsNotFound(err error, httpResp *http.Response) bool {
+	// Return result.
+	return ClassifyAPIError(err, httpResp) == API_ERROR_NOT_FOUND
+}
+
+// RemoveIfNotFound removes an object deleted outside Terraform from the state,
+// so that the next plan recreates it instead of failing the refresh.
+//
+// Params:
+//   - ctx: context for logging
+//   - err: error returned by the SDK
+//   - httpResp: HTTP response returned by the SDK, may be nil
+//   - state: resource state to clear
+//   - objectType: object type used in the log (e.g., "workflow")
+//   - id: identifier of the object
+//
+// Returns:
+//   - bool: true when the object was not found and removed from the state
+func RemoveIfNotFound(ctx context.Context, err error, httpResp *http.Response, state *tfsdk.State, objectType, id string) bool {
+	// Only missing objects are removed.
+	if !IsNotFound(err, httpResp) {
+		// Return not handled.
+		return false
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("%s %s no longer exists, removing it from the state", objectType, id))
+	state.RemoveResource(ctx)
+	// Return handled.
+	return true
+}
+
+// IgnoreNotFound reports whether a failed Delete targeted an object that is already deleted.
+//
+// Params:
+//   - ctx: context for logging
+//   - err: error returned by the SDK
+//   - httpResp: HTTP response returned by the SDK, may be nil
+//   - objectType: object type used in the log (e.g., "workflow")
+//   - id: identifier of the object
+//
+// Returns:
+//   - bool: true when the object was not found and the deletion is complete
+func IgnoreNotFound(ctx context.Context, err error, httpResp *http.Response, objectType, id string) bool {
+	// Only missing objects are ignored.
+	if !IsNotFound(err, httpResp) {
+		// Return not handled.
+		return false
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("%s %s was already deleted", objectType, id))
+	// Return handled.
+	return true
+}
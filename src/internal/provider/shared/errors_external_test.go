@@ -0,0 +1,149 @@
+package shared_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getTagWithStatus reads a tag from a server answering with the given status.
+func getTagWithStatus(t *testing.T, status int) (*http.Response, error) {
+	t.Helper()
+
+	return getTagWithError(t, status, `{"message":"error"}`)
+}
+
+// getTagWithError reads a tag from a server answering with the given status and error body.
+func getTagWithError(t *testing.T, status int, body string) (*http.Response, error) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	n8nClient := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{})
+
+	_, httpResp, err := n8nClient.APIClient.TagsAPI.TagsIdGet(context.Background(), "tag-1").Execute()
+	// Check for non-nil value.
+	if httpResp != nil {
+		httpResp.Body.Close()
+	}
+	require.Error(t, err)
+
+	return httpResp, err
+}
+
+// TestClassifyAPIError tests the ClassifyAPIError function.
+func TestClassifyAPIError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		status     int
+		want       shared.APIErrorClass
+		wantStatus int
+	}{
+		{name: "bad request", status: http.StatusBadRequest, want: shared.API_ERROR_BAD_REQUEST, wantStatus: 400},
+		{name: "unprocessable entity", status: http.StatusUnprocessableEntity, want: shared.API_ERROR_BAD_REQUEST, wantStatus: 422},
+		{name: "unauthorized", status: http.StatusUnauthorized, want: shared.API_ERROR_UNAUTHORIZED, wantStatus: 401},
+		{name: "forbidden", status: http.StatusForbidden, want: shared.API_ERROR_FORBIDDEN, wantStatus: 403},
+		{name: "not found", status: http.StatusNotFound, want: shared.API_ERROR_NOT_FOUND, wantStatus: 404},
+		{name: "conflict", status: http.StatusConflict, want: shared.API_ERROR_CONFLICT, wantStatus: 409},
+		{name: "rate limited", status: http.StatusTooManyRequests, want: shared.API_ERROR_RATE_LIMITED, wantStatus: 429},
+		{name: "server error", status: http.StatusBadGateway, want: shared.API_ERROR_SERVER, wantStatus: 502},
+		{name: "unexpected status", status: http.StatusTeapot, want: shared.API_ERROR_UNEXPECTED, wantStatus: 418},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			httpResp, err := getTagWithStatus(t, tt.status)
+
+			assert.Equal(t, tt.want, shared.ClassifyAPIError(err, httpResp))
+			assert.Equal(t, tt.wantStatus, shared.APIErrorStatus(err, httpResp))
+			assert.Equal(t, tt.want, shared.ClassifyAPIError(err, nil), "the status should be read from the SDK error")
+		})
+	}
+
+	t.Run("error case - no error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 0, shared.APIErrorStatus(nil, &http.Response{StatusCode: http.StatusNotFound}))
+	})
+
+	t.Run("error case - transport error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, shared.API_ERROR_OTHER, shared.ClassifyAPIError(errors.New("connection refused"), nil))
+	})
+
+	t.Run("error case - decoding error with successful response", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.New("undefined response type")
+		assert.Equal(t, shared.API_ERROR_OTHER, shared.ClassifyAPIError(err, &http.Response{StatusCode: http.StatusOK}))
+	})
+}
+
+// TestIsNotFound tests the IsNotFound function.
+func TestIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	notFoundResp, notFoundErr := getTagWithStatus(t, http.StatusNotFound)
+	serverResp, serverErr := getTagWithStatus(t, http.StatusInternalServerError)
+
+	assert.True(t, shared.IsNotFound(notFoundErr, notFoundResp))
+	assert.False(t, shared.IsNotFound(serverErr, serverResp), "error case - server error")
+	assert.False(t, shared.IsNotFound(nil, nil), "error case - no error")
+}
+
+// TestRemoveIfNotFound tests the RemoveIfNotFound function.
+func TestRemoveIfNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		status      int
+		wantRemoved bool
+	}{
+		{name: "removes a missing object", status: http.StatusNotFound, wantRemoved: true},
+		{name: "error case - keeps the object on other errors", status: http.StatusInternalServerError, wantRemoved: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			httpResp, err := getTagWithStatus(t, tt.status)
+			state := tfsdk.State{Schema: projectTestSchema, Raw: projectTestValue(tftypes.NewValue(tftypes.String, "project-1"))}
+
+			removed := shared.RemoveIfNotFound(context.Background(), err, httpResp, &state, "tag", "tag-1")
+
+			assert.Equal(t, tt.wantRemoved, removed)
+			assert.Equal(t, tt.wantRemoved, state.Raw.IsNull())
+		})
+	}
+}
+
+// TestIgnoreNotFound tests the IgnoreNotFound function.
+func TestIgnoreNotFound(t *testing.T) {
+	t.Parallel()
+
+	notFoundResp, notFoundErr := getTagWithStatus(t, http.StatusNotFound)
+	forbiddenResp, forbiddenErr := getTagWithStatus(t, http.StatusForbidden)
+
+	assert.True(t, shared.IgnoreNotFound(context.Background(), notFoundErr, notFoundResp, "tag", "tag-1"))
+	assert.False(t, shared.IgnoreNotFound(context.Background(), forbiddenErr, forbiddenResp, "tag", "tag-1"), "error case - forbidden")
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package shared
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+)
+
+// RequireFeature fails the plan when the n8n instance does not license a feature the resource needs.
+// The check is skipped on destroy, when the provider is not configured and when the feature could
+// not be detected, so that the API stays the source of truth.
+//
+// Params:
+//   - n8nClient: configured client, nil before the provider is configured
+//   - feature: licensed feature required by the resource
+//   - resourceType: resource type name used in the error (e.g., "n8n_variable")
+//   - resp: plan modification response receiving the error
+func RequireFeature(n8nClient *client.N8nClient, feature client.Feature, resourceType string, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or without detection.
+	if resp.Plan.Raw.IsNull() || n8nClient == nil || n8nClient.Instance == nil {
+		// Return early.
+		return
+	}
+
+	info := n8nClient.Instance
+	licensed, known := info.HasFeature(feature)
+	// Only fail when the feature is known to be missing.
+	if !known || licensed {
+		// Return early.
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Unsupported n8n Feature",
+		fmt.Sprintf("%s requires the Enterprise %s feature; instance is %s. "+
+			"Enable the feature in the n8n license or remove the resource from the configuration.", resourceType, feature, info),
+	)
+}
@@ -0,0 +1,85 @@
+package shared_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRequireFeature tests the RequireFeature function.
+func TestRequireFeature(t *testing.T) {
+	t.Parallel()
+
+	community := `{"data":{"versionCli":"1.64.0","enterprise":{"variables":false,"projects":{"team":{"limit":0}}}}}`
+	enterprise := `{"data":{"versionCli":"1.64.0","enterprise":{"variables":true,"projects":{"team":{"limit":-1}}}}}`
+	objectType := projectTestValue(tftypes.NewValue(tftypes.String, nil)).Type()
+
+	tests := []struct {
+		name        string
+		settings    string
+		noDetection bool
+		destroy     bool
+		wantErr     string
+	}{
+		{name: "licensed feature passes", settings: enterprise},
+		{name: "undetected feature passes", settings: `{"data":{"versionCli":"1.64.0"}}`},
+		{name: "skipped without detection", settings: community, noDetection: true},
+		{name: "skipped on destroy", settings: community, destroy: true},
+		{
+			name:     "error case - unlicensed feature",
+			settings: community,
+			wantErr:  "n8n_variable requires the Enterprise variables feature; instance is community 1.64.0.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Only the settings endpoint is available.
+				if r.URL.Path != "/rest/settings" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Write([]byte(tt.settings))
+			}))
+			defer server.Close()
+
+			n8nClient := client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{})
+			// Detect the instance features.
+			if !tt.noDetection {
+				n8nClient.Instance, _ = client.DetectInstance(context.Background(), n8nClient.BaseURL, n8nClient.APIClient)
+			}
+			plan := projectTestValue(tftypes.NewValue(tftypes.String, nil))
+			// Destroy plans are null.
+			if tt.destroy {
+				plan = tftypes.NewValue(objectType, nil)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: projectTestSchema, Raw: plan}}
+
+			shared.RequireFeature(n8nClient, client.FEATURE_VARIABLES, "n8n_variable", resp)
+
+			// Check diagnostics.
+			if tt.wantErr == "" {
+				assert.False(t, resp.Diagnostics.HasError())
+				return
+			}
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+
+	// A nil client is accepted before the provider is configured.
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: projectTestSchema, Raw: projectTestValue(tftypes.NewValue(tftypes.String, nil))}}
+	shared.RequireFeature(nil, client.FEATURE_VARIABLES, "n8n_variable", resp)
+	assert.False(t, resp.Diagnostics.HasError())
+}
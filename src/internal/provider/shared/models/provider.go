@@ -13,6 +13,81 @@ type N8nProviderModel struct {
 	// APIKey is the n8n API key used for authentication
 	APIKey types.String `tfsdk:"api_key"`
 
+	// APIKeyFile is the path of a file holding the API key
+	APIKeyFile types.String `tfsdk:"api_key_file"`
+
+	// APIKeyCommand is the helper command printing the API key
+	APIKeyCommand types.List `tfsdk:"api_key_command"`
+
 	// BaseURL is the base URL for the n8n instance (e.g., "https://n8n.example.com")
 	BaseURL types.String `tfsdk:"base_url"`
+
+	// MaxRetries is the maximum number of retries for transient API failures
+	MaxRetries types.Int64 `tfsdk:"max_retries"`
+
+	// RetryWaitMin is the minimum backoff duration between retries (e.g., "1s")
+	RetryWaitMin types.String `tfsdk:"retry_wait_min"`
+
+	// RetryWaitMax is the maximum backoff duration between retries (e.g., "30s")
+	RetryWaitMax types.String `tfsdk:"retry_wait_max"`
+
+	// RequestsPerSecond limits the rate of API requests issued by the provider
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+
+	// MaxConcurrentRequests caps the number of API requests in flight at once
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+
+	// PageSize is the number of items fetched per page by list calls
+	PageSize types.Int64 `tfsdk:"page_size"`
+
+	// CACertPEM holds PEM encoded CA certificates trusted for the n8n instance
+	CACertPEM types.String `tfsdk:"ca_cert_pem"`
+
+	// CACertFile is the path of a file holding PEM encoded CA certificates
+	CACertFile types.String `tfsdk:"ca_cert_file"`
+
+	// ClientCertPEM holds the PEM encoded client certificate for mutual TLS
+	ClientCertPEM types.String `tfsdk:"client_cert_pem"`
+
+	// ClientKeyPEM holds the PEM encoded private key of the client certificate
+	ClientKeyPEM types.String `tfsdk:"client_key_pem"`
+
+	// ClientCertFile is the path of a file holding the PEM encoded client certificate
+	ClientCertFile types.String `tfsdk:"client_cert_file"`
+
+	// ClientKeyFile is the path of a file holding the PEM encoded client private key
+	ClientKeyFile types.String `tfsdk:"client_key_file"`
+
+	// InsecureSkipVerify disables verification of the server certificate
+	InsecureSkipVerify types.Bool `tfsdk:"insecure_skip_verify"`
+
+	// Headers are extra HTTP headers sent with every API request
+	Headers types.Map `tfsdk:"headers"`
+
+	// ProxyURL is the URL of the HTTP proxy used to reach the n8n instance
+	ProxyURL types.String `tfsdk:"proxy_url"`
+
+	// Profile is the name of the profile loaded from the profiles file
+	Profile types.String `tfsdk:"profile"`
+
+	// ConfigFile is the path of the TOML profiles file
+	ConfigFile types.String `tfsdk:"config_file"`
+
+	// ReadOnly refuses every mutating API request
+	ReadOnly types.Bool `tfsdk:"read_only"`
+
+	// DefaultProjectID is the project used by workflows, credentials and variables without project_id
+	DefaultProjectID types.String `tfsdk:"default_project_id"`
+
+	// DefaultTags holds the tags added to every managed workflow
+	DefaultTags *DefaultTagsModel `tfsdk:"default_tags"`
+
+	// CommunityNodeAllowlist holds the node types and packages skipped by the node catalog validation
+	CommunityNodeAllowlist types.Set `tfsdk:"community_node_allowlist"`
+}
+
+// DefaultTagsModel represents the default_tags block of the provider configuration.
+type DefaultTagsModel struct {
+	// Names are the tag names merged into the tags of every workflow
+	Names types.Set `tfsdk:"names"`
 }
@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package shared
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+)
+
+// PROJECT_ID_ATTRIBUTE is the name of the project attribute shared by project-scoped resources.
+const PROJECT_ID_ATTRIBUTE string = "project_id"
+
+// ModifyPlanProjectID plans the project_id attribute of a project-scoped resource.
+// When the configuration leaves project_id null, the provider default_project_id is planned;
+// without a default, the prior state value is kept so the computed attribute does not show
+// as unknown on every update. Project-scoped resources call it from their ModifyPlan.
+//
+// Params:
+//   - ctx: context for the operation
+//   - n8nClient: provider client, nil while the provider is not configured yet during validation
+//   - req: plan modification request
+//   - resp: plan modification response to update
+func ModifyPlanProjectID(ctx context.Context, n8nClient *client.N8nClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		// Return early.
+		return
+	}
+
+	defaultProjectID := ""
+	// Read the provider default once the provider is configured.
+	if n8nClient != nil {
+		defaultProjectID = n8nClient.DefaultProjectID
+	}
+
+	var configured types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(PROJECT_ID_ATTRIBUTE), &configured)...)
+	// The resource configuration wins over the provider default.
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		// Return early.
+		return
+	}
+
+	// Plan the provider default.
+	if defaultProjectID != "" {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(PROJECT_ID_ATTRIBUTE), types.StringValue(defaultProjectID))...)
+		// Return after applying the default.
+		return
+	}
+
+	// Nothing to keep on create.
+	if req.State.Raw.IsNull() {
+		// Return early.
+		return
+	}
+
+	var prior types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(PROJECT_ID_ATTRIBUTE), &prior)...)
+	// Keep the known prior value.
+	if !resp.Diagnostics.HasError() && !prior.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(PROJECT_ID_ATTRIBUTE), prior)...)
+	}
+}
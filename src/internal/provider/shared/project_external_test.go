@@ -0,0 +1,144 @@
+package shared_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// projectTestSchema is a minimal project-scoped resource schema.
+var projectTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name":       schema.StringAttribute{Required: true},
+		"project_id": schema.StringAttribute{Optional: true, Computed: true},
+	},
+}
+
+// projectTestValue builds a raw object for projectTestSchema.
+func projectTestValue(projectID tftypes.Value) tftypes.Value {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String, "project_id": tftypes.String}}
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"name":       tftypes.NewValue(tftypes.String, "resource"),
+		"project_id": projectID,
+	})
+}
+
+// TestModifyPlanProjectID tests the ModifyPlanProjectID function.
+func TestModifyPlanProjectID(t *testing.T) {
+	t.Parallel()
+
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	null := tftypes.NewValue(tftypes.String, nil)
+	objectType := projectTestValue(null).Type()
+	withDefault := &client.N8nClient{DefaultProjectID: "project-default"}
+
+	tests := []struct {
+		name    string
+		client  *client.N8nClient
+		config  tftypes.Value
+		state   tftypes.Value
+		plan    tftypes.Value
+		destroy bool
+		want    types.String
+	}{
+		{
+			name:   "configured project wins over default",
+			client: withDefault,
+			config: projectTestValue(tftypes.NewValue(tftypes.String, "project-config")),
+			state:  tftypes.NewValue(objectType, nil),
+			plan:   projectTestValue(tftypes.NewValue(tftypes.String, "project-config")),
+			want:   types.StringValue("project-config"),
+		},
+		{
+			name:   "unknown configured project stays unknown",
+			client: withDefault,
+			config: projectTestValue(unknown),
+			state:  tftypes.NewValue(objectType, nil),
+			plan:   projectTestValue(unknown),
+			want:   types.StringUnknown(),
+		},
+		{
+			name:   "default applies on create",
+			client: withDefault,
+			config: projectTestValue(null),
+			state:  tftypes.NewValue(objectType, nil),
+			plan:   projectTestValue(unknown),
+			want:   types.StringValue("project-default"),
+		},
+		{
+			name:   "default applies on update",
+			client: withDefault,
+			config: projectTestValue(null),
+			state:  projectTestValue(tftypes.NewValue(tftypes.String, "project-old")),
+			plan:   projectTestValue(unknown),
+			want:   types.StringValue("project-default"),
+		},
+		{
+			name:   "keeps prior state without default",
+			client: &client.N8nClient{},
+			config: projectTestValue(null),
+			state:  projectTestValue(tftypes.NewValue(tftypes.String, "project-old")),
+			plan:   projectTestValue(unknown),
+			want:   types.StringValue("project-old"),
+		},
+		{
+			name:   "keeps prior state while the provider is not configured",
+			config: projectTestValue(null),
+			state:  projectTestValue(tftypes.NewValue(tftypes.String, "project-old")),
+			plan:   projectTestValue(unknown),
+			want:   types.StringValue("project-old"),
+		},
+		{
+			name:    "destroy plan stays null",
+			client:  withDefault,
+			config:  tftypes.NewValue(objectType, nil),
+			state:   projectTestValue(tftypes.NewValue(tftypes.String, "project-old")),
+			plan:    tftypes.NewValue(objectType, nil),
+			destroy: true,
+		},
+		{
+			name:   "error case - stays unknown on create without default",
+			client: &client.N8nClient{},
+			config: projectTestValue(null),
+			state:  tftypes.NewValue(objectType, nil),
+			plan:   projectTestValue(unknown),
+			want:   types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: projectTestSchema, Raw: tt.config},
+				State:  tfsdk.State{Schema: projectTestSchema, Raw: tt.state},
+				Plan:   tfsdk.Plan{Schema: projectTestSchema, Raw: tt.plan},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			shared.ModifyPlanProjectID(context.Background(), tt.client, req, resp)
+			require.False(t, resp.Diagnostics.HasError())
+			// Check the destroy plan.
+			if tt.destroy {
+				assert.True(t, resp.Plan.Raw.IsNull())
+				return
+			}
+
+			var got types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("project_id"), &got)...)
+			require.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
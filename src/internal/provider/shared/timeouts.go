@@ -0,0 +1,125 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// DEFAULT_OPERATION_TIMEOUT is the deadline of a resource operation when its timeout is not configured.
+const DEFAULT_OPERATION_TIMEOUT time.Duration = 20 * time.Minute
+
+// Resource operations bounded by a timeout.
+const (
+	// OPERATION_CREATE is the create operation.
+	OPERATION_CREATE string = "create"
+
+	// OPERATION_READ is the read operation.
+	OPERATION_READ string = "read"
+
+	// OPERATION_UPDATE is the update operation.
+	OPERATION_UPDATE string = "update"
+
+	// OPERATION_DELETE is the delete operation.
+	OPERATION_DELETE string = "delete"
+)
+
+// timeoutStepKey is the context key of the step running under WithTimeout.
+type timeoutStepKey struct{}
+
+// timeoutStep holds the step running under WithTimeout.
+type timeoutStep struct {
+	// mu guards name
+	mu sync.Mutex
+
+	// name describes the running step (e.g., "creating credential")
+	name string
+}
+
+// TimeoutFunc reads the configured timeout of an operation, such as timeouts.Value.Create.
+type TimeoutFunc func(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics)
+
+// TimeoutsBlock returns the timeouts block with create, read, update and delete attributes.
+//
+// Params:
+//   - ctx: context for the schema
+//
+// Returns:
+//   - schema.Block: timeouts block accepting Go durations (e.g., "30s", "5m")
+func TimeoutsBlock(ctx context.Context) schema.Block {
+	// Return block.
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// WithTimeout derives a context bounded by the configured timeout of an operation.
+// The returned function must be deferred: it releases the context and, when the deadline
+// was exceeded, adds a diagnostic naming the step that was running.
+//
+// Params:
+//   - ctx: parent context
+//   - operation: operation name (e.g., OPERATION_CREATE)
+//   - step: step running first, updated with SetTimeoutStep (e.g., "creating credential")
+//   - timeout: configured timeout accessor
+//   - diags: diagnostics of the operation
+//
+// Returns:
+//   - context.Context: context carrying the deadline
+//   - func(): function reporting the timeout and releasing the context
+func WithTimeout(ctx context.Context, operation, step string, timeout TimeoutFunc, diags *diag.Diagnostics) (context.Context, func()) {
+	duration, timeoutDiags := timeout(ctx, DEFAULT_OPERATION_TIMEOUT)
+	diags.Append(timeoutDiags...)
+
+	running := &timeoutStep{name: step}
+	ctx, cancel := context.WithTimeout(context.WithValue(ctx, timeoutStepKey{}, running), duration)
+	// Return context and completion function.
+	return ctx, func() {
+		defer cancel()
+		// Only report steps that failed because of the deadline.
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) || !diags.HasError() {
+			// Return early.
+			return
+		}
+
+		running.mu.Lock()
+		defer running.mu.Unlock()
+		diags.AddError(
+			"Operation Timed Out",
+			fmt.Sprintf("The %s operation exceeded its %s timeout during step %q. "+
+				"Increase timeouts.%s to allow more time.", operation, duration, running.name, operation),
+		)
+	}
+}
+
+// SetTimeoutStep names the step now running under the timeout of ctx,
+// for operations made of several steps. It does nothing outside WithTimeout.
+//
+// Params:
+//   - ctx: context returned by WithTimeout
+//   - step: step description (e.g., "updating workflows")
+func SetTimeoutStep(ctx context.Context, step string) {
+	running, ok := ctx.Value(timeoutStepKey{}).(*timeoutStep)
+	// Nothing to record outside WithTimeout.
+	if !ok {
+		// Return early.
+		return
+	}
+
+	running.mu.Lock()
+	defer running.mu.Unlock()
+	running.name = step
+}
@@ -0,0 +1,147 @@
+package shared_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedTimeout returns a timeout accessor yielding a configured duration.
+func fixedTimeout(duration time.Duration, diags diag.Diagnostics) shared.TimeoutFunc {
+	return func(_ context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+		// Fall back to the default like timeouts.Value does.
+		if duration == 0 {
+			return defaultTimeout, diags
+		}
+		return duration, diags
+	}
+}
+
+// TestTimeoutsBlock tests the TimeoutsBlock function.
+func TestTimeoutsBlock(t *testing.T) {
+	t.Parallel()
+
+	block, ok := shared.TimeoutsBlock(context.Background()).(schema.SingleNestedBlock)
+	require.True(t, ok, "TimeoutsBlock should return a single nested block")
+	for _, operation := range []string{shared.OPERATION_CREATE, shared.OPERATION_READ, shared.OPERATION_UPDATE, shared.OPERATION_DELETE} {
+		assert.Contains(t, block.Attributes, operation)
+	}
+}
+
+// TestWithTimeout tests the WithTimeout function.
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		timeout      time.Duration
+		timeoutDiags diag.Diagnostics
+		expire       bool
+		stepErr      string
+		nextStep     string
+		wantDeadline time.Duration
+		wantErrs     int
+		wantErr      string
+	}{
+		{
+			name:         "uses the configured timeout",
+			timeout:      5 * time.Minute,
+			wantDeadline: 5 * time.Minute,
+		},
+		{
+			name:         "uses the default timeout",
+			wantDeadline: shared.DEFAULT_OPERATION_TIMEOUT,
+		},
+		{
+			name:         "ignores a deadline that did not fail a step",
+			timeout:      time.Millisecond,
+			expire:       true,
+			wantDeadline: time.Millisecond,
+		},
+		{
+			name:         "keeps errors unrelated to the deadline",
+			timeout:      5 * time.Minute,
+			stepErr:      "Error creating credential",
+			wantDeadline: 5 * time.Minute,
+			wantErrs:     1,
+		},
+		{
+			name:         "error case - names the step that timed out",
+			timeout:      time.Millisecond,
+			expire:       true,
+			stepErr:      "Error creating credential: conflict",
+			wantDeadline: time.Millisecond,
+			wantErr:      `The create operation exceeded its 1ms timeout during step "creating credential". Increase timeouts.create to allow more time.`,
+		},
+		{
+			name:         "error case - names the step set during the operation",
+			timeout:      time.Millisecond,
+			expire:       true,
+			stepErr:      "Error updating workflow during rotation: conflict",
+			nextStep:     "updating workflows",
+			wantDeadline: time.Millisecond,
+			wantErr:      `The create operation exceeded its 1ms timeout during step "updating workflows". Increase timeouts.create to allow more time.`,
+		},
+		{
+			name:         "error case - invalid timeout",
+			timeoutDiags: diag.Diagnostics{diag.NewErrorDiagnostic("Timeout Cannot Be Parsed", "invalid")},
+			wantDeadline: shared.DEFAULT_OPERATION_TIMEOUT,
+			wantErrs:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			start := time.Now()
+			ctx, done := shared.WithTimeout(context.Background(), shared.OPERATION_CREATE, "creating credential", fixedTimeout(tt.timeout, tt.timeoutDiags), &diags)
+
+			deadline, ok := ctx.Deadline()
+			require.True(t, ok, "context should carry a deadline")
+			assert.WithinDuration(t, start.Add(tt.wantDeadline), deadline, time.Second)
+
+			// Move to the next step.
+			if tt.nextStep != "" {
+				shared.SetTimeoutStep(ctx, tt.nextStep)
+			}
+			// Let the deadline expire.
+			if tt.expire {
+				<-ctx.Done()
+			}
+			// Simulate a failed step.
+			if tt.stepErr != "" {
+				diags.AddError(tt.stepErr, fmt.Sprintf("Could not create credential: %s", ctx.Err()))
+			}
+			done()
+
+			assert.Error(t, ctx.Err(), "done should release the context")
+			// Check diagnostics.
+			if tt.wantErr == "" {
+				assert.Len(t, diags.Errors(), tt.wantErrs)
+				return
+			}
+			errs := diags.Errors()
+			require.NotEmpty(t, errs)
+			assert.Equal(t, "Operation Timed Out", errs[len(errs)-1].Summary())
+			assert.Equal(t, tt.wantErr, errs[len(errs)-1].Detail())
+		})
+	}
+}
+
+// TestSetTimeoutStep tests that SetTimeoutStep ignores contexts without timeout.
+func TestSetTimeoutStep(t *testing.T) {
+	t.Parallel()
+
+	assert.NotPanics(t, func() {
+		shared.SetTimeoutStep(context.Background(), "updating workflows")
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/tag/models"
 )
@@ -221,9 +222,11 @@ func (d *TagDataSource) fetchTagByID(ctx context.Context, data *models.DataSourc
 	}
 	// Check if API call returned an error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error retrieving tag",
-			fmt.Sprintf("Could not retrieve tag with ID %s: %s\nHTTP Response: %v", data.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not retrieve tag with ID %s", data.ID.ValueString()),
+			err, httpResp,
 		)
 		// Return with error.
 		return nil
@@ -242,28 +245,16 @@ func (d *TagDataSource) fetchTagByID(ctx context.Context, data *models.DataSourc
 // Returns:
 //   - *n8nsdk.Tag: The found tag or nil if error occurred
 func (d *TagDataSource) fetchTagByName(ctx context.Context, data *models.DataSource, resp *datasource.ReadResponse) *n8nsdk.Tag {
-	tagList, httpResp, err := d.client.APIClient.TagsAPI.TagsGet(ctx).Execute()
-	// Close HTTP response body if present.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	tags, httpResp, err := client.ListAll(d.client.APIClient.TagsAPI.TagsGet(ctx), d.client.PageSize)
 	// Check if API call returned an error.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing tags",
-			fmt.Sprintf("Could not list tags: %s\nHTTP Response: %v", err.Error(), httpResp),
-		)
+		shared.AddAPIError(&resp.Diagnostics, "Error listing tags", "Could not list tags", err, httpResp)
 		// Return with error.
 		return nil
 	}
 
 	// Find tag by name in the response data.
-	var tag *n8nsdk.Tag
-	var found bool
-	// Check if tag list data is not empty.
-	if tagList.Data != nil {
-		tag, found = findTagByName(tagList.Data, data.Name.ValueString())
-	}
+	tag, found := findTagByName(tags, data.Name.ValueString())
 
 	// Return error if tag was not found.
 	if !found {
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/constants"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -145,43 +146,33 @@ func (d *TagsDataSource) Configure(_ctx context.Context, req datasource.Configur
 func (d *TagsDataSource) Read(ctx context.Context, _req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data models.DataSources
 
-	tagList, httpResp, err := d.client.APIClient.TagsAPI.TagsGet(ctx).Execute()
-	// Check for non-nil value.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	tags, httpResp, err := client.ListAll(d.client.APIClient.TagsAPI.TagsGet(ctx), d.client.PageSize)
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing tags",
-			fmt.Sprintf("Could not list tags: %s\nHTTP Response: %v", err.Error(), httpResp),
-		)
+		shared.AddAPIError(&resp.Diagnostics, "Error listing tags", "Could not list tags", err, httpResp)
 		// Return result.
 		return
 	}
 
 	data.Tags = make([]models.Item, 0, constants.DEFAULT_LIST_CAPACITY)
-	// Check for non-nil value.
-	if tagList.Data != nil {
-		// Iterate over items.
-		for _, tag := range tagList.Data {
-			item := models.Item{
-				Name: types.StringValue(tag.Name),
-			}
-			// Check for non-nil value.
-			if tag.Id != nil {
-				item.ID = types.StringValue(*tag.Id)
-			}
-			// Check for non-nil value.
-			if tag.CreatedAt != nil {
-				item.CreatedAt = types.StringValue(tag.CreatedAt.String())
-			}
-			// Check for non-nil value.
-			if tag.UpdatedAt != nil {
-				item.UpdatedAt = types.StringValue(tag.UpdatedAt.String())
-			}
-			data.Tags = append(data.Tags, item)
+	// Iterate over items.
+	for _, tag := range tags {
+		item := models.Item{
+			Name: types.StringValue(tag.Name),
+		}
+		// Check for non-nil value.
+		if tag.Id != nil {
+			item.ID = types.StringValue(*tag.Id)
+		}
+		// Check for non-nil value.
+		if tag.CreatedAt != nil {
+			item.CreatedAt = types.StringValue(tag.CreatedAt.String())
+		}
+		// Check for non-nil value.
+		if tag.UpdatedAt != nil {
+			item.UpdatedAt = types.StringValue(tag.UpdatedAt.String())
 		}
+		data.Tags = append(data.Tags, item)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/tag/models"
 )
@@ -195,9 +196,12 @@ func (r *TagResource) executeCreateLogic(ctx context.Context, plan *models.Resou
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIAttributeError(
+			&resp.Diagnostics,
+			path.Root("name"),
 			"Error creating tag",
-			fmt.Sprintf("Could not create tag: %s\nHTTP Response: %v", err.Error(), httpResp),
+			"Could not create tag",
+			err, httpResp,
 		)
 		// Return failure.
 		return false
@@ -265,9 +269,16 @@ func (r *TagResource) executeReadLogic(ctx context.Context, state *models.Resour
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// The tag was deleted outside Terraform.
+		if shared.RemoveIfNotFound(ctx, err, httpResp, &resp.State, "tag", state.ID.ValueString()) {
+			// Return failure.
+			return false
+		}
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error reading tag",
-			fmt.Sprintf("Could not read tag ID %s: %s\nHTTP Response: %v", state.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not read tag ID %s", state.ID.ValueString()),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
@@ -349,9 +360,12 @@ func (r *TagResource) executeUpdateLogic(ctx context.Context, plan, state *model
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIAttributeError(
+			&resp.Diagnostics,
+			path.Root("name"),
 			"Error updating tag",
-			fmt.Sprintf("Could not update tag ID %s: %s\nHTTP Response: %v", tagID, err.Error(), httpResp),
+			fmt.Sprintf("Could not update tag ID %s", tagID),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
@@ -420,9 +434,16 @@ func (r *TagResource) executeDeleteLogic(ctx context.Context, state *models.Reso
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// The tag was already deleted outside Terraform.
+		if shared.IgnoreNotFound(ctx, err, httpResp, "tag", state.ID.ValueString()) {
+			// Return success.
+			return true
+		}
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error deleting tag",
-			fmt.Sprintf("Could not delete tag ID %s: %s\nHTTP Response: %v", state.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not delete tag ID %s", state.ID.ValueString()),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
@@ -475,7 +475,7 @@ func TestTagResource_Read(t *testing.T) {
 			},
 		},
 		{
-			name: "error - read with API error",
+			name: "read removes tag deleted outside Terraform",
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -516,6 +516,53 @@ func TestTagResource_Read(t *testing.T) {
 
 				r.Read(ctx, req, resp)
 
+				// Verify removal
+				assert.False(t, resp.Diagnostics.HasError())
+				assert.True(t, resp.State.Raw.IsNull())
+			},
+		},
+		{
+			name: "error - read with API error",
+			testFunc: func(t *testing.T) {
+				t.Helper()
+				handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+					w.Write([]byte(`{"message": "Internal server error"}`))
+				})
+
+				n8nClient, server := setupTestClient(t, handler)
+				defer server.Close()
+
+				r := tag.NewTagResource()
+				r.Configure(context.Background(), resource.ConfigureRequest{
+					ProviderData: n8nClient,
+				}, &resource.ConfigureResponse{})
+
+				ctx := context.Background()
+				schemaResp := resource.SchemaResponse{}
+				r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"id":         tftypes.NewValue(tftypes.String, "tag-123"),
+					"name":       tftypes.NewValue(tftypes.String, "test-tag"),
+					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+				})
+
+				state := tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    stateRaw,
+				}
+
+				req := resource.ReadRequest{
+					State: state,
+				}
+				resp := &resource.ReadResponse{
+					State: state,
+				}
+
+				r.Read(ctx, req, resp)
+
 				// Verify error
 				assert.True(t, resp.Diagnostics.HasError())
 			},
@@ -7,12 +7,15 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/tag/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // setupTestClient creates a test N8nClient with httptest server.
@@ -121,6 +124,33 @@ func TestTagResource_executeCreateLogic(t *testing.T) {
 	}
 }
 
+// TestTagResource_executeCreateLogic_Conflict tests that a duplicate tag name points at the name attribute.
+func TestTagResource_executeCreateLogic_Conflict(t *testing.T) {
+	t.Parallel()
+
+	n8nClient, server := setupTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"code": 409, "message": "Tag \"prod\" already exists"}`))
+	}))
+	defer server.Close()
+
+	r := &TagResource{client: n8nClient}
+	plan := &models.Resource{Name: types.StringValue("prod")}
+	resp := &resource.CreateResponse{}
+
+	result := r.executeCreateLogic(context.Background(), plan, resp)
+
+	assert.False(t, result)
+	require.Len(t, resp.Diagnostics.Errors(), 1)
+	errDiag := resp.Diagnostics.Errors()[0]
+	assert.Equal(t, "Error creating tag: conflict", errDiag.Summary())
+	assert.Contains(t, errDiag.Detail(), `Tag "prod" already exists`)
+	withPath, ok := errDiag.(diag.DiagnosticWithPath)
+	require.True(t, ok, "the conflict should point at an attribute")
+	assert.Equal(t, path.Root("name"), withPath.Path())
+}
+
 // TestTagResource_executeReadLogic tests the executeReadLogic method with error cases.
 func TestTagResource_executeReadLogic(t *testing.T) {
 	t.Parallel()
@@ -152,15 +182,8 @@ func TestTagResource_executeReadLogic(t *testing.T) {
 			expectError: false,
 			expectName:  "Retrieved Tag",
 		},
-		{
-			name:  "tag not found",
-			tagID: "tag-404",
-			setupHandler: func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusNotFound)
-				w.Write([]byte(`{"message": "Tag not found"}`))
-			},
-			expectError: true,
-		},
+		// Note: "tag not found" case (RemoveResource) is tested in full CRUD tests
+		// as it requires a properly initialized tfsdk.State with schema
 		{
 			name:  "API error",
 			tagID: "tag-500",
@@ -315,13 +338,13 @@ func TestTagResource_executeDeleteLogic(t *testing.T) {
 			expectError: false,
 		},
 		{
-			name:  "tag not found",
+			name:  "tag already deleted",
 			tagID: "tag-404",
 			setupHandler: func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusNotFound)
 				w.Write([]byte(`{"message": "Tag not found"}`))
 			},
-			expectError: true,
+			expectError: false,
 		},
 		{
 			name:  "API error",
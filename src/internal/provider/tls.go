@@ -0,0 +1,161 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package provider
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/models"
+)
+
+// buildTLSConfig resolves the TLS settings of the provider configuration.
+// Inline PEM attributes and their file variants are mutually exclusive.
+//
+// Params:
+//   - config: provider configuration model
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - *tls.Config: TLS configuration, nil when the defaults apply
+func buildTLSConfig(config *models.N8nProviderModel, diags *diag.Diagnostics) *tls.Config {
+	opts := client.TLSOptions{
+		CACertPEM:          resolvePEM(config.CACertPEM, config.CACertFile, "ca_cert_pem", "ca_cert_file", diags),
+		ClientCertPEM:      resolvePEM(config.ClientCertPEM, config.ClientCertFile, "client_cert_pem", "client_cert_file", diags),
+		ClientKeyPEM:       resolvePEM(config.ClientKeyPEM, config.ClientKeyFile, "client_key_pem", "client_key_file", diags),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+	}
+
+	// Keep the default transport settings when nothing is customized.
+	if diags.HasError() || opts.IsEmpty() {
+		// Return defaults.
+		return nil
+	}
+
+	// Warn about disabled certificate verification.
+	if opts.InsecureSkipVerify {
+		diags.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"Insecure TLS Configuration",
+			"Server certificate verification is disabled. Connections to the n8n instance are vulnerable to interception; use ca_cert_pem or ca_cert_file instead.",
+		)
+	}
+
+	tlsConfig, err := client.NewTLSConfig(opts)
+	// Check for error.
+	if err != nil {
+		diags.AddError("Invalid TLS Configuration", fmt.Sprintf("Could not build TLS configuration: %s", err.Error()))
+		// Return defaults.
+		return nil
+	}
+
+	// Return TLS configuration.
+	return tlsConfig
+}
+
+// resolvePEM returns the PEM data of an inline attribute or of its file variant.
+//
+// Params:
+//   - inline: inline PEM attribute value
+//   - file: file path attribute value
+//   - inlineAttribute: inline attribute name used for diagnostics
+//   - fileAttribute: file attribute name used for diagnostics
+//   - diags: diagnostics for error reporting
+//
+// Returns:
+//   - []byte: PEM data, nil when neither attribute is set
+func resolvePEM(inline, file types.String, inlineAttribute, fileAttribute string, diags *diag.Diagnostics) []byte {
+	hasInline := isSetString(inline)
+	hasFile := isSetString(file)
+
+	// Reject ambiguous configuration.
+	if hasInline && hasFile {
+		diags.AddAttributeError(
+			path.Root(fileAttribute),
+			"Conflicting TLS Configuration",
+			fmt.Sprintf("Only one of %s and %s can be set.", inlineAttribute, fileAttribute),
+		)
+		// Return nothing.
+		return nil
+	}
+
+	// Use the inline content.
+	if hasInline {
+		// Return inline PEM.
+		return []byte(inline.ValueString())
+	}
+
+	// Nothing configured.
+	if !hasFile {
+		// Return nothing.
+		return nil
+	}
+
+	data, err := loadPEM(file.ValueString(), true)
+	// Check for error.
+	if err != nil {
+		diags.AddAttributeError(path.Root(fileAttribute), "Unable to Read File", err.Error())
+		// Return nothing.
+		return nil
+	}
+
+	// Return file content.
+	return data
+}
+
+// validateTLSConfig checks the TLS attribute combinations before Configure runs.
+// Unknown values are treated as set so conflicts are reported as early as possible.
+//
+// Params:
+//   - config: provider configuration model
+//   - diags: diagnostics for error reporting
+func validateTLSConfig(config *models.N8nProviderModel, diags *diag.Diagnostics) {
+	pairs := []struct {
+		inline, file                   types.String
+		inlineAttribute, fileAttribute string
+	}{
+		{config.CACertPEM, config.CACertFile, "ca_cert_pem", "ca_cert_file"},
+		{config.ClientCertPEM, config.ClientCertFile, "client_cert_pem", "client_cert_file"},
+		{config.ClientKeyPEM, config.ClientKeyFile, "client_key_pem", "client_key_file"},
+	}
+
+	// Each inline attribute conflicts with its file variant.
+	for _, pair := range pairs {
+		// Check for conflict.
+		if !pair.inline.IsNull() && !pair.file.IsNull() {
+			diags.AddAttributeError(
+				path.Root(pair.fileAttribute),
+				"Conflicting TLS Configuration",
+				fmt.Sprintf("Only one of %s and %s can be set.", pair.inlineAttribute, pair.fileAttribute),
+			)
+		}
+	}
+
+	hasCert := !config.ClientCertPEM.IsNull() || !config.ClientCertFile.IsNull()
+	hasKey := !config.ClientKeyPEM.IsNull() || !config.ClientKeyFile.IsNull()
+	// The client certificate and key are only usable together.
+	if hasCert != hasKey {
+		diags.AddError(
+			"Incomplete TLS Client Certificate",
+			"Mutual TLS requires both a client certificate (client_cert_pem or client_cert_file) and a client key (client_key_pem or client_key_file).",
+		)
+	}
+}
+
+// isSetString reports whether a string attribute holds a non-empty known value.
+//
+// Params:
+//   - value: attribute value
+//
+// Returns:
+//   - bool: true when the value is known and not empty
+func isSetString(value types.String) bool {
+	// Return whether value is usable.
+	return !value.IsNull() && !value.IsUnknown() && value.ValueString() != ""
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// generateTestKeyPair creates a self-signed certificate and returns its PEM encoded cert and key.
+func generateTestKeyPair(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "n8n-provider-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+// writeTestFile writes content to a temporary file and returns its path.
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	filePath := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(filePath, []byte(content), 0o600))
+	return filePath
+}
+
+// newTLSTestModel returns a provider model with every TLS attribute null.
+func newTLSTestModel() *models.N8nProviderModel {
+	return &models.N8nProviderModel{
+		CACertPEM:          types.StringNull(),
+		CACertFile:         types.StringNull(),
+		ClientCertPEM:      types.StringNull(),
+		ClientKeyPEM:       types.StringNull(),
+		ClientCertFile:     types.StringNull(),
+		ClientKeyFile:      types.StringNull(),
+		InsecureSkipVerify: types.BoolNull(),
+	}
+}
+
+// Test_buildTLSConfig tests the buildTLSConfig function.
+func Test_buildTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := generateTestKeyPair(t)
+	_, otherKeyPEM := generateTestKeyPair(t)
+
+	tests := []struct {
+		name            string
+		configure       func(t *testing.T, config *models.N8nProviderModel)
+		wantNil         bool
+		wantInsecure    bool
+		wantClientCerts int
+		wantWarning     bool
+		wantErr         bool
+	}{
+		{
+			name:      "defaults when nothing is configured",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {},
+			wantNil:   true,
+		},
+		{
+			name: "inline CA certificate",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.CACertPEM = types.StringValue(certPEM)
+			},
+		},
+		{
+			name: "CA certificate from file",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.CACertFile = types.StringValue(writeTestFile(t, "ca.pem", certPEM))
+			},
+		},
+		{
+			name: "client key pair from inline and file attributes",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.ClientCertPEM = types.StringValue(certPEM)
+				config.ClientKeyFile = types.StringValue(writeTestFile(t, "client.key", keyPEM))
+			},
+			wantClientCerts: 1,
+		},
+		{
+			name: "insecure skip verify emits warning",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.InsecureSkipVerify = types.BoolValue(true)
+			},
+			wantInsecure: true,
+			wantWarning:  true,
+		},
+		{
+			name: "error case - conflicting CA attributes",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.CACertPEM = types.StringValue(certPEM)
+				config.CACertFile = types.StringValue(writeTestFile(t, "ca.pem", certPEM))
+			},
+			wantNil: true,
+			wantErr: true,
+		},
+		{
+			name: "error case - missing file",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.CACertFile = types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))
+			},
+			wantNil: true,
+			wantErr: true,
+		},
+		{
+			name: "error case - mismatched key pair",
+			configure: func(t *testing.T, config *models.N8nProviderModel) {
+				config.ClientCertPEM = types.StringValue(certPEM)
+				config.ClientKeyPEM = types.StringValue(otherKeyPEM)
+			},
+			wantNil: true,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := newTLSTestModel()
+			tt.configure(t, config)
+			diags := diag.Diagnostics{}
+
+			got := buildTLSConfig(config, &diags)
+
+			assert.Equal(t, tt.wantErr, diags.HasError())
+			assert.Equal(t, tt.wantWarning, diags.WarningsCount() > 0)
+			assert.Equal(t, tt.wantNil, got == nil)
+			// Check resolved settings.
+			if got != nil {
+				assert.Equal(t, tt.wantInsecure, got.InsecureSkipVerify)
+				assert.Len(t, got.Certificates, tt.wantClientCerts)
+			}
+		})
+	}
+}
+
+// Test_validateTLSConfig tests the validateTLSConfig function.
+func Test_validateTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		configure func(config *models.N8nProviderModel)
+		wantErrs  int
+	}{
+		{
+			name:      "accepts empty configuration",
+			configure: func(config *models.N8nProviderModel) {},
+		},
+		{
+			name: "accepts complete client key pair",
+			configure: func(config *models.N8nProviderModel) {
+				config.ClientCertFile = types.StringValue("client.pem")
+				config.ClientKeyPEM = types.StringUnknown()
+			},
+		},
+		{
+			name: "error case - conflicting CA attributes",
+			configure: func(config *models.N8nProviderModel) {
+				config.CACertPEM = types.StringValue("pem")
+				config.CACertFile = types.StringValue("ca.pem")
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "error case - certificate without key",
+			configure: func(config *models.N8nProviderModel) {
+				config.ClientCertPEM = types.StringValue("pem")
+			},
+			wantErrs: 1,
+		},
+		{
+			name: "error case - conflicting key attributes",
+			configure: func(config *models.N8nProviderModel) {
+				config.ClientCertPEM = types.StringValue("pem")
+				config.ClientKeyPEM = types.StringValue("pem")
+				config.ClientKeyFile = types.StringValue("client.key")
+			},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := newTLSTestModel()
+			tt.configure(config)
+			diags := diag.Diagnostics{}
+
+			validateTLSConfig(config, &diags)
+
+			assert.Equal(t, tt.wantErrs, diags.ErrorsCount())
+		})
+	}
+}
+
+// Test_resolvePEM tests the resolvePEM function.
+func Test_resolvePEM(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		inline  types.String
+		file    func(t *testing.T) types.String
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:   "returns nil when unset",
+			inline: types.StringNull(),
+			file:   func(t *testing.T) types.String { return types.StringNull() },
+			want:   nil,
+		},
+		{
+			name:   "returns inline content",
+			inline: types.StringValue("inline"),
+			file:   func(t *testing.T) types.String { return types.StringNull() },
+			want:   []byte("inline"),
+		},
+		{
+			name:   "reads file content",
+			inline: types.StringNull(),
+			file:   func(t *testing.T) types.String { return types.StringValue(writeTestFile(t, "ca.pem", "from file")) },
+			want:   []byte("from file"),
+		},
+		{
+			name:   "ignores empty strings",
+			inline: types.StringValue(""),
+			file:   func(t *testing.T) types.String { return types.StringUnknown() },
+			want:   nil,
+		},
+		{
+			name:    "error case - both attributes set",
+			inline:  types.StringValue("inline"),
+			file:    func(t *testing.T) types.String { return types.StringValue("ca.pem") },
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := diag.Diagnostics{}
+
+			got := resolvePEM(tt.inline, tt.file(t), "ca_cert_pem", "ca_cert_file", &diags)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, diags.HasError())
+		})
+	}
+}
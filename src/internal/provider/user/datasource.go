@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/user/models"
 )
@@ -237,9 +238,11 @@ func (d *UserDataSource) fetchUser(ctx context.Context, identifier string, resp
 	}
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error retrieving user",
-			fmt.Sprintf("Could not retrieve user with identifier %s: %s\nHTTP Response: %v", identifier, err.Error(), httpResp),
+			fmt.Sprintf("Could not retrieve user with identifier %s", identifier),
+			err, httpResp,
 		)
 		// Return with error.
 		return nil
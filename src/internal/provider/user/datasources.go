@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/constants"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -171,29 +172,19 @@ func (d *UsersDataSource) Configure(_ctx context.Context, req datasource.Configu
 func (d *UsersDataSource) Read(ctx context.Context, _req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data models.DataSources
 
-	userList, httpResp, err := d.client.APIClient.UserAPI.UsersGet(ctx).Execute()
-	// Check for non-nil value.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	users, httpResp, err := client.ListAll(d.client.APIClient.UserAPI.UsersGet(ctx), d.client.PageSize)
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing users",
-			fmt.Sprintf("Could not list users: %s\nHTTP Response: %v", err.Error(), httpResp),
-		)
+		shared.AddAPIError(&resp.Diagnostics, "Error listing users", "Could not list users", err, httpResp)
 		// Return result.
 		return
 	}
 
 	data.Users = make([]models.Item, 0, constants.DEFAULT_LIST_CAPACITY)
-	// Check if user data is present.
-	if userList.Data != nil {
-		// Iterate through each user in the list and map to item model.
-		for _, user := range userList.Data {
-			item := mapUserToItem(&user)
-			data.Users = append(data.Users, item)
-		}
+	// Iterate through each user in the list and map to item model.
+	for _, user := range users {
+		item := mapUserToItem(&user)
+		data.Users = append(data.Users, item)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
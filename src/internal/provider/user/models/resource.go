@@ -6,6 +6,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -14,12 +15,13 @@ import (
 // Resource describes the user resource data model.
 // Maps n8n user attributes to Terraform schema for managing user accounts and roles.
 type Resource struct {
-	ID        types.String `tfsdk:"id"`
-	Email     types.String `tfsdk:"email"`
-	FirstName types.String `tfsdk:"first_name"`
-	LastName  types.String `tfsdk:"last_name"`
-	Role      types.String `tfsdk:"role"`
-	IsPending types.Bool   `tfsdk:"is_pending"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	ID        types.String   `tfsdk:"id"`
+	Email     types.String   `tfsdk:"email"`
+	FirstName types.String   `tfsdk:"first_name"`
+	LastName  types.String   `tfsdk:"last_name"`
+	Role      types.String   `tfsdk:"role"`
+	IsPending types.Bool     `tfsdk:"is_pending"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+	UpdatedAt types.String   `tfsdk:"updated_at"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
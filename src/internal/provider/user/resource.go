@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/user/models"
 )
@@ -99,10 +100,13 @@ func (r *UserResource) Metadata(_ctx context.Context, req resource.MetadataReque
 //
 // Returns:
 //   - (none)
-func (r *UserResource) Schema(_ctx context.Context, _req resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *UserResource) Schema(ctx context.Context, _req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages n8n users. Only available for the instance owner. Note: The API only supports updating the user's role, not other fields.",
 		Attributes:          r.schemaAttributes(),
+		Blocks: map[string]schema.Block{
+			"timeouts": shared.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -198,6 +202,9 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_CREATE, "creating user", plan.Timeouts.Create, &resp.Diagnostics)
+	defer done()
+
 	// Execute create logic
 	if !r.executeCreateLogic(ctx, plan, resp) {
 		// Return with error.
@@ -286,9 +293,12 @@ func (r *UserResource) executeUserHTTPRequest(ctx context.Context, jsonBody []by
 		// Return empty slice on error.
 		return []byte{}, 0
 	}
+	// Forward the API key and every custom provider header.
+	for name, value := range cfg.DefaultHeader {
+		httpReq.Header.Set(name, value)
+	}
 	httpReq.Header.Set("Content-Type", "application/json")
 	httpReq.Header.Set("Accept", "application/json")
-	httpReq.Header.Set("X-N8N-API-KEY", cfg.DefaultHeader["X-N8N-API-KEY"])
 	httpClient := cfg.HTTPClient
 	// Check for nil value.
 	if httpClient == nil {
@@ -325,7 +335,7 @@ func (r *UserResource) executeUserHTTPRequest(ctx context.Context, jsonBody []by
 func (r *UserResource) parseUserCreateResponse(body []byte, statusCode int, resp *resource.CreateResponse) string {
 	// Check HTTP status is in success range.
 	if statusCode < HTTP_STATUS_SUCCESS_MIN || statusCode >= HTTP_STATUS_SUCCESS_MAX {
-		resp.Diagnostics.AddError("Error creating user", fmt.Sprintf("API returned status %d: %s", statusCode, string(body)))
+		shared.ParseAPIError(statusCode, body).AddTo(&resp.Diagnostics, path.Root("email"), "Error creating user", "Could not create user")
 		// Return empty string on error.
 		return ""
 	}
@@ -404,9 +414,11 @@ func (r *UserResource) fetchFullUserDetails(ctx context.Context, userID string,
 	}
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error reading created user",
-			fmt.Sprintf("User was created but could not read full details: %s\nHTTP Response: %v", err.Error(), httpResp),
+			"User was created but could not read full details",
+			err, httpResp,
 		)
 		// Return nil on error.
 		return nil
@@ -434,6 +446,9 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_READ, "reading user", state.Timeouts.Read, &resp.Diagnostics)
+	defer done()
+
 	// Execute read logic
 	if !r.executeReadLogic(ctx, state, resp) {
 		// Return with error.
@@ -462,9 +477,16 @@ func (r *UserResource) executeReadLogic(ctx context.Context, state *models.Resou
 	}
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// The user was deleted outside Terraform.
+		if shared.RemoveIfNotFound(ctx, err, httpResp, &resp.State, "user", state.ID.ValueString()) {
+			// Return failure.
+			return false
+		}
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error reading user",
-			fmt.Sprintf("Could not read user %s: %s\nHTTP Response: %v", state.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not read user %s", state.ID.ValueString()),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
@@ -498,6 +520,9 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_UPDATE, "updating user", plan.Timeouts.Update, &resp.Diagnostics)
+	defer done()
+
 	// Execute update logic
 	if !r.executeUpdateLogic(ctx, plan, state, resp) {
 		// Return with error.
@@ -597,9 +622,12 @@ func (r *UserResource) updateRoleIfChanged(ctx context.Context, plan, state *mod
 	}
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIAttributeError(
+			&resp.Diagnostics,
+			path.Root("role"),
 			"Error updating user role",
-			fmt.Sprintf("Could not update role for user %s: %s\nHTTP Response: %v", state.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not update role for user %s", state.ID.ValueString()),
+			err, httpResp,
 		)
 	}
 }
@@ -621,9 +649,11 @@ func (r *UserResource) refreshUserData(ctx context.Context, userID string, resp
 	}
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error reading user after update",
-			fmt.Sprintf("Could not read user %s after update: %s\nHTTP Response: %v", userID, err.Error(), httpResp),
+			fmt.Sprintf("Could not read user %s after update", userID),
+			err, httpResp,
 		)
 		// Return nil on error.
 		return nil
@@ -651,6 +681,9 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_DELETE, "deleting user", state.Timeouts.Delete, &resp.Diagnostics)
+	defer done()
+
 	// Execute delete logic
 	r.executeDeleteLogic(ctx, state, resp)
 }
@@ -674,9 +707,16 @@ func (r *UserResource) executeDeleteLogic(ctx context.Context, state *models.Res
 	}
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// The user was already deleted outside Terraform.
+		if shared.IgnoreNotFound(ctx, err, httpResp, "user", state.ID.ValueString()) {
+			// Return success.
+			return true
+		}
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error deleting user",
-			fmt.Sprintf("Could not delete user %s: %s\nHTTP Response: %v", state.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not delete user %s", state.ID.ValueString()),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
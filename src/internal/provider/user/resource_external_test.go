@@ -176,6 +176,7 @@ func TestUserResource_Create(t *testing.T) {
 					"is_pending": tftypes.NewValue(tftypes.Bool, nil),
 					"created_at": tftypes.NewValue(tftypes.String, nil),
 					"updated_at": tftypes.NewValue(tftypes.String, nil),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				plan := tfsdk.Plan{
@@ -260,6 +261,7 @@ func TestUserResource_Create(t *testing.T) {
 					"is_pending": tftypes.NewValue(tftypes.Bool, nil),
 					"created_at": tftypes.NewValue(tftypes.String, nil),
 					"updated_at": tftypes.NewValue(tftypes.String, nil),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				plan := tfsdk.Plan{
@@ -329,6 +331,7 @@ func TestUserResource_Read(t *testing.T) {
 					"is_pending": tftypes.NewValue(tftypes.Bool, false),
 					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
 					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -380,7 +383,7 @@ func TestUserResource_Read(t *testing.T) {
 			},
 		},
 		{
-			name: "error - read with API error",
+			name: "read removes user deleted outside Terraform",
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -409,6 +412,59 @@ func TestUserResource_Read(t *testing.T) {
 					"is_pending": tftypes.NewValue(tftypes.Bool, false),
 					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
 					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
+				})
+
+				state := tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    stateRaw,
+				}
+
+				req := resource.ReadRequest{
+					State: state,
+				}
+				resp := &resource.ReadResponse{
+					State: state,
+				}
+
+				r.Read(ctx, req, resp)
+
+				// Verify removal
+				assert.False(t, resp.Diagnostics.HasError())
+				assert.True(t, resp.State.Raw.IsNull())
+			},
+		},
+		{
+			name: "error - read with API error",
+			testFunc: func(t *testing.T) {
+				t.Helper()
+				handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+					w.Write([]byte(`{"message": "Internal server error"}`))
+				})
+
+				n8nClient, server := setupTestClient(t, handler)
+				defer server.Close()
+
+				r := user.NewUserResource()
+				r.Configure(context.Background(), resource.ConfigureRequest{
+					ProviderData: n8nClient,
+				}, &resource.ConfigureResponse{})
+
+				ctx := context.Background()
+				schemaResp := resource.SchemaResponse{}
+				r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"id":         tftypes.NewValue(tftypes.String, "user-123"),
+					"email":      tftypes.NewValue(tftypes.String, "test@example.com"),
+					"first_name": tftypes.NewValue(tftypes.String, "John"),
+					"last_name":  tftypes.NewValue(tftypes.String, "Doe"),
+					"role":       tftypes.NewValue(tftypes.String, "global:member"),
+					"is_pending": tftypes.NewValue(tftypes.Bool, false),
+					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -474,6 +530,7 @@ func TestUserResource_Update(t *testing.T) {
 					"is_pending": tftypes.NewValue(tftypes.Bool, false),
 					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
 					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				plan := tfsdk.Plan{
@@ -491,6 +548,7 @@ func TestUserResource_Update(t *testing.T) {
 					"is_pending": tftypes.NewValue(tftypes.Bool, false),
 					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
 					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -541,6 +599,7 @@ func TestUserResource_Update(t *testing.T) {
 					"is_pending": tftypes.NewValue(tftypes.Bool, false),
 					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
 					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -592,6 +651,7 @@ func TestUserResource_Update(t *testing.T) {
 					"is_pending": tftypes.NewValue(tftypes.Bool, false),
 					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
 					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				plan := tfsdk.Plan{
@@ -608,6 +668,7 @@ func TestUserResource_Update(t *testing.T) {
 					"is_pending": tftypes.NewValue(tftypes.Bool, false),
 					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
 					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -672,6 +733,7 @@ func TestUserResource_Delete(t *testing.T) {
 					"is_pending": tftypes.NewValue(tftypes.Bool, false),
 					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
 					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -750,6 +812,7 @@ func TestUserResource_Delete(t *testing.T) {
 					"is_pending": tftypes.NewValue(tftypes.Bool, false),
 					"created_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
 					"updated_at": tftypes.NewValue(tftypes.String, "2024-01-01T00:00:00Z"),
+					"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				})
 
 				state := tfsdk.State{
@@ -801,6 +864,7 @@ func TestUserResource_ImportState(t *testing.T) {
 		"is_pending": tftypes.NewValue(tftypes.Bool, nil),
 		"created_at": tftypes.NewValue(tftypes.String, nil),
 		"updated_at": tftypes.NewValue(tftypes.String, nil),
+		"timeouts":   tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 	})
 
 	req := resource.ImportStateRequest{
@@ -220,15 +220,8 @@ func TestUserResource_executeReadLogic(t *testing.T) {
 			expectError: false,
 			expectEmail: "retrieved@example.com",
 		},
-		{
-			name:   "user not found",
-			userID: "user-404",
-			setupHandler: func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusNotFound)
-				w.Write([]byte(`{"message": "User not found"}`))
-			},
-			expectError: true,
-		},
+		// Note: "user not found" case (RemoveResource) is tested in full CRUD tests
+		// as it requires a properly initialized tfsdk.State with schema
 		{
 			name:   "API error",
 			userID: "user-500",
@@ -457,13 +450,13 @@ func TestUserResource_executeDeleteLogic(t *testing.T) {
 			expectError: false,
 		},
 		{
-			name:   "user not found",
+			name:   "user already deleted",
 			userID: "user-404",
 			setupHandler: func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusNotFound)
 				w.Write([]byte(`{"message": "User not found"}`))
 			},
-			expectError: true,
+			expectError: false,
 		},
 		{
 			name:   "API error",
@@ -1236,6 +1229,21 @@ func TestUserResource_executeUserHTTPRequest(t *testing.T) {
 			expectError:  false,
 			expectStatus: http.StatusCreated,
 		},
+		{
+			name: "success - forwards provider headers",
+			setupHandler: func(w http.ResponseWriter, r *http.Request) {
+				// Reject requests missing the API key or the custom header.
+				if r.Header.Get("X-N8N-API-KEY") != "test-key" || r.Header.Get("CF-Access-Client-Id") != "client-id" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`[{"user":{"id":"user-123"},"error":""}]`))
+			},
+			expectBody:   true,
+			expectError:  false,
+			expectStatus: http.StatusCreated,
+		},
 		{
 			name: "error - server error",
 			setupHandler: func(w http.ResponseWriter, _ *http.Request) {
@@ -1256,6 +1264,7 @@ func TestUserResource_executeUserHTTPRequest(t *testing.T) {
 			cfg.Servers = n8nsdk.ServerConfigurations{{URL: server.URL, Description: "Test server"}}
 			cfg.HTTPClient = server.Client()
 			cfg.AddDefaultHeader("X-N8N-API-KEY", "test-key")
+			cfg.AddDefaultHeader("CF-Access-Client-Id", "client-id")
 			apiClient := n8nsdk.NewAPIClient(cfg)
 			r := &UserResource{
 				client: &client.N8nClient{APIClient: apiClient},
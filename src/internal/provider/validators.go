@@ -0,0 +1,583 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+)
+
+// Ensure validators implement the framework interfaces.
+var (
+	_ validator.String  = durationValidator{}
+	_ validator.Int64   = nonNegativeInt64Validator{}
+	_ validator.Int64   = pageSizeValidator{}
+	_ validator.Float64 = nonNegativeFloat64Validator{}
+	_ validator.String  = certificatePEMValidator{}
+	_ validator.String  = privateKeyPEMValidator{}
+	_ validator.Map     = headersValidator{}
+	_ validator.String  = proxyURLValidator{}
+)
+
+// API_KEY_HEADER is the header carrying the n8n API key, reserved for the api_key attribute.
+const API_KEY_HEADER string = "X-N8N-API-KEY"
+
+// durationValidator validates that a string attribute holds a non-negative Go duration.
+type durationValidator struct{}
+
+// Description returns a plain text description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v durationValidator) Description(_ctx context.Context) string {
+	// Return description.
+	return "value must be a non-negative duration such as \"500ms\", \"2s\" or \"1m\""
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	// Return description.
+	return v.Description(ctx)
+}
+
+// ValidateString checks that the configured value parses as a duration.
+//
+// Params:
+//   - ctx: context for the operation
+//   - req: validation request holding the value
+//   - resp: validation response collecting diagnostics
+func (v durationValidator) ValidateString(_ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Skip values that cannot be validated yet.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		// Return early.
+		return
+	}
+
+	_, err := parseDuration(req.ConfigValue.ValueString())
+	// Check for error.
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Duration", err.Error())
+	}
+}
+
+// nonNegativeInt64Validator validates that an int64 attribute is zero or positive.
+type nonNegativeInt64Validator struct{}
+
+// Description returns a plain text description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v nonNegativeInt64Validator) Description(_ctx context.Context) string {
+	// Return description.
+	return "value must be zero or positive"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v nonNegativeInt64Validator) MarkdownDescription(ctx context.Context) string {
+	// Return description.
+	return v.Description(ctx)
+}
+
+// ValidateInt64 checks that the configured value is not negative.
+//
+// Params:
+//   - ctx: context for the operation
+//   - req: validation request holding the value
+//   - resp: validation response collecting diagnostics
+func (v nonNegativeInt64Validator) ValidateInt64(_ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	// Skip values that cannot be validated yet.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		// Return early.
+		return
+	}
+
+	// Check sign.
+	if req.ConfigValue.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Value",
+			fmt.Sprintf("Expected a value greater than or equal to 0, got: %d", req.ConfigValue.ValueInt64()),
+		)
+	}
+}
+
+// pageSizeValidator validates that an int64 attribute is a page size accepted by the n8n API.
+type pageSizeValidator struct{}
+
+// Description returns a plain text description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v pageSizeValidator) Description(_ctx context.Context) string {
+	// Return description.
+	return fmt.Sprintf("value must be between 1 and %d", client.MAX_PAGE_SIZE)
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v pageSizeValidator) MarkdownDescription(ctx context.Context) string {
+	// Return description.
+	return v.Description(ctx)
+}
+
+// ValidateInt64 checks that the configured value is within the API page size bounds.
+//
+// Params:
+//   - ctx: context for the operation
+//   - req: validation request holding the value
+//   - resp: validation response collecting diagnostics
+func (v pageSizeValidator) ValidateInt64(_ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	// Skip values that cannot be validated yet.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		// Return early.
+		return
+	}
+
+	// Check bounds.
+	if value := req.ConfigValue.ValueInt64(); value < 1 || value > client.MAX_PAGE_SIZE {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Value",
+			fmt.Sprintf("Expected a value between 1 and %d, got: %d", client.MAX_PAGE_SIZE, value),
+		)
+	}
+}
+
+// nonNegativeFloat64Validator validates that a float64 attribute is zero or positive.
+type nonNegativeFloat64Validator struct{}
+
+// Description returns a plain text description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v nonNegativeFloat64Validator) Description(_ctx context.Context) string {
+	// Return description.
+	return "value must be zero or positive"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v nonNegativeFloat64Validator) MarkdownDescription(ctx context.Context) string {
+	// Return description.
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 checks that the configured value is not negative.
+//
+// Params:
+//   - ctx: context for the operation
+//   - req: validation request holding the value
+//   - resp: validation response collecting diagnostics
+func (v nonNegativeFloat64Validator) ValidateFloat64(_ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	// Skip values that cannot be validated yet.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		// Return early.
+		return
+	}
+
+	// Check sign.
+	if req.ConfigValue.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Value",
+			fmt.Sprintf("Expected a value greater than or equal to 0, got: %g", req.ConfigValue.ValueFloat64()),
+		)
+	}
+}
+
+// certificatePEMValidator validates that a string attribute holds PEM encoded certificates.
+// When fromFile is set, the attribute holds the path of a file with the certificates.
+type certificatePEMValidator struct {
+	fromFile bool
+}
+
+// Description returns a plain text description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v certificatePEMValidator) Description(_ctx context.Context) string {
+	// Describe file variant.
+	if v.fromFile {
+		// Return description.
+		return "value must be the path of a file holding PEM encoded certificates"
+	}
+	// Return description.
+	return "value must hold PEM encoded certificates"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v certificatePEMValidator) MarkdownDescription(ctx context.Context) string {
+	// Return description.
+	return v.Description(ctx)
+}
+
+// ValidateString checks that the configured value parses as PEM certificates.
+//
+// Params:
+//   - ctx: context for the operation
+//   - req: validation request holding the value
+//   - resp: validation response collecting diagnostics
+func (v certificatePEMValidator) ValidateString(_ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Skip values that cannot be validated yet.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		// Return early.
+		return
+	}
+
+	data, err := loadPEM(req.ConfigValue.ValueString(), v.fromFile)
+	// Check for read error.
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Unable to Read File", err.Error())
+		// Return early.
+		return
+	}
+
+	_, err = client.ParseCertificatesPEM(data)
+	// Check for parse error.
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid PEM Certificate", fmt.Sprintf("Could not parse certificate: %s", err.Error()))
+	}
+}
+
+// privateKeyPEMValidator validates that a string attribute holds a PEM encoded private key.
+// When fromFile is set, the attribute holds the path of a file with the key.
+type privateKeyPEMValidator struct {
+	fromFile bool
+}
+
+// Description returns a plain text description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v privateKeyPEMValidator) Description(_ctx context.Context) string {
+	// Describe file variant.
+	if v.fromFile {
+		// Return description.
+		return "value must be the path of a file holding a PEM encoded private key"
+	}
+	// Return description.
+	return "value must hold a PEM encoded private key"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v privateKeyPEMValidator) MarkdownDescription(ctx context.Context) string {
+	// Return description.
+	return v.Description(ctx)
+}
+
+// ValidateString checks that the configured value parses as a PEM private key.
+//
+// Params:
+//   - ctx: context for the operation
+//   - req: validation request holding the value
+//   - resp: validation response collecting diagnostics
+func (v privateKeyPEMValidator) ValidateString(_ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Skip values that cannot be validated yet.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		// Return early.
+		return
+	}
+
+	data, err := loadPEM(req.ConfigValue.ValueString(), v.fromFile)
+	// Check for read error.
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Unable to Read File", err.Error())
+		// Return early.
+		return
+	}
+
+	err = client.ValidatePrivateKeyPEM(data)
+	// Check for parse error.
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid PEM Private Key", fmt.Sprintf("Could not parse private key: %s", err.Error()))
+	}
+}
+
+// headersValidator validates the names and values of custom HTTP headers.
+type headersValidator struct{}
+
+// Description returns a plain text description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v headersValidator) Description(_ctx context.Context) string {
+	// Return description.
+	return "keys must be valid HTTP header names other than " + API_KEY_HEADER + " and values must not contain line breaks"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v headersValidator) MarkdownDescription(ctx context.Context) string {
+	// Return description.
+	return v.Description(ctx)
+}
+
+// ValidateMap checks every configured header name and value.
+//
+// Params:
+//   - ctx: context for the operation
+//   - req: validation request holding the value
+//   - resp: validation response collecting diagnostics
+func (v headersValidator) ValidateMap(_ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	// Skip values that cannot be validated yet.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		// Return early.
+		return
+	}
+
+	// Check each header.
+	for name, value := range req.ConfigValue.Elements() {
+		attributePath := req.Path.AtMapKey(name)
+		// Check name syntax.
+		if !isHeaderName(name) {
+			resp.Diagnostics.AddAttributeError(attributePath, "Invalid Header Name", fmt.Sprintf("%q is not a valid HTTP header name.", name))
+			continue
+		}
+		// The API key header is managed by api_key.
+		if strings.EqualFold(name, API_KEY_HEADER) {
+			resp.Diagnostics.AddAttributeError(
+				attributePath,
+				"Reserved Header Name",
+				fmt.Sprintf("%s is set from the api_key attribute and cannot be overridden through headers.", API_KEY_HEADER),
+			)
+			continue
+		}
+		headerValue, ok := value.(types.String)
+		// Reject values that would split the header.
+		if ok && strings.ContainsAny(headerValue.ValueString(), "\r\n") {
+			resp.Diagnostics.AddAttributeError(attributePath, "Invalid Header Value", fmt.Sprintf("The value of header %q must not contain line breaks.", name))
+		}
+	}
+}
+
+// proxyURLValidator validates that a string attribute holds an absolute proxy URL.
+type proxyURLValidator struct{}
+
+// Description returns a plain text description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v proxyURLValidator) Description(_ctx context.Context) string {
+	// Return description.
+	return "value must be an absolute http, https or socks5 URL such as \"http://proxy.example.com:3128\""
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v proxyURLValidator) MarkdownDescription(ctx context.Context) string {
+	// Return description.
+	return v.Description(ctx)
+}
+
+// ValidateString checks that the configured value parses as a proxy URL.
+//
+// Params:
+//   - ctx: context for the operation
+//   - req: validation request holding the value
+//   - resp: validation response collecting diagnostics
+func (v proxyURLValidator) ValidateString(_ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Skip values that cannot be validated yet.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		// Return early.
+		return
+	}
+
+	_, err := parseProxyURL(req.ConfigValue.ValueString())
+	// Check for error.
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Proxy URL", err.Error())
+	}
+}
+
+// parseProxyURL parses an absolute proxy URL with a supported scheme.
+//
+// Params:
+//   - value: proxy URL (e.g., "http://proxy.example.com:3128")
+//
+// Returns:
+//   - *url.URL: parsed URL
+//   - error: error if the value is not a supported proxy URL
+func parseProxyURL(value string) (*url.URL, error) {
+	proxyURL, err := url.Parse(value)
+	// Check for error.
+	if err != nil {
+		// Return error.
+		return nil, fmt.Errorf("%q is not a valid URL: %w", value, err)
+	}
+
+	// Only schemes understood by net/http are supported.
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		// Return error.
+		return nil, fmt.Errorf("%q must use the http, https or socks5 scheme", value)
+	}
+
+	// A host is required to reach the proxy.
+	if proxyURL.Host == "" {
+		// Return error.
+		return nil, fmt.Errorf("%q must include a host", value)
+	}
+
+	// Return parsed URL.
+	return proxyURL, nil
+}
+
+// isHeaderName reports whether name is a valid HTTP header field name (RFC 9110 token).
+//
+// Params:
+//   - name: header name
+//
+// Returns:
+//   - bool: true when every character is a token character
+func isHeaderName(name string) bool {
+	// Empty names are invalid.
+	if name == "" {
+		// Return invalid.
+		return false
+	}
+
+	// Check each character.
+	for _, char := range name {
+		isAlphaNum := (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z') || (char >= '0' && char <= '9')
+		// Reject characters outside the token set.
+		if !isAlphaNum && !strings.ContainsRune("!#$%&'*+-.^_`|~", char) {
+			// Return invalid.
+			return false
+		}
+	}
+
+	// Return valid.
+	return true
+}
+
+// loadPEM returns the PEM data of an attribute, reading it from disk for file attributes.
+//
+// Params:
+//   - value: inline PEM content or file path
+//   - fromFile: whether value is a file path
+//
+// Returns:
+//   - []byte: PEM data
+//   - error: error if the file cannot be read
+func loadPEM(value string, fromFile bool) ([]byte, error) {
+	// Inline PEM content is used as is.
+	if !fromFile {
+		// Return inline content.
+		return []byte(value), nil
+	}
+
+	data, err := os.ReadFile(value)
+	// Check for error.
+	if err != nil {
+		// Return error.
+		return nil, fmt.Errorf("could not read %q: %w", value, err)
+	}
+
+	// Return file content.
+	return data, nil
+}
+
+// parseDuration parses a non-negative Go duration string.
+//
+// Params:
+//   - value: duration string (e.g., "2s")
+//
+// Returns:
+//   - time.Duration: parsed duration
+//   - error: error if the value is not a valid non-negative duration
+func parseDuration(value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	// Check for error.
+	if err != nil {
+		// Return error.
+		return 0, fmt.Errorf("%q is not a valid duration: %w", value, err)
+	}
+
+	// Reject negative durations.
+	if duration < 0 {
+		// Return error.
+		return 0, fmt.Errorf("%q must not be negative", value)
+	}
+
+	// Return parsed duration.
+	return duration, nil
+}
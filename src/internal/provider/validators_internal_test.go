@@ -0,0 +1,344 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package provider
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_durationValidator tests the durationValidator type.
+func Test_durationValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "accepts seconds", value: types.StringValue("2s"), wantErr: false},
+		{name: "accepts composite durations", value: types.StringValue("1m30s"), wantErr: false},
+		{name: "accepts zero", value: types.StringValue("0s"), wantErr: false},
+		{name: "skips null values", value: types.StringNull(), wantErr: false},
+		{name: "skips unknown values", value: types.StringUnknown(), wantErr: false},
+		{name: "error case - rejects missing unit", value: types.StringValue("10"), wantErr: true},
+		{name: "error case - rejects negative durations", value: types.StringValue("-1s"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{Path: path.Root("retry_wait_min"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			durationValidator{}.ValidateString(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			assert.NotEmpty(t, durationValidator{}.MarkdownDescription(context.Background()))
+		})
+	}
+}
+
+// Test_nonNegativeInt64Validator tests the nonNegativeInt64Validator type.
+func Test_nonNegativeInt64Validator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   types.Int64
+		wantErr bool
+	}{
+		{name: "accepts zero", value: types.Int64Value(0), wantErr: false},
+		{name: "accepts positive values", value: types.Int64Value(5), wantErr: false},
+		{name: "skips null values", value: types.Int64Null(), wantErr: false},
+		{name: "error case - rejects negative values", value: types.Int64Value(-1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Int64Request{Path: path.Root("max_retries"), ConfigValue: tt.value}
+			resp := &validator.Int64Response{}
+
+			nonNegativeInt64Validator{}.ValidateInt64(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			assert.NotEmpty(t, nonNegativeInt64Validator{}.MarkdownDescription(context.Background()))
+		})
+	}
+}
+
+// Test_pageSizeValidator tests the pageSizeValidator type.
+func Test_pageSizeValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   types.Int64
+		wantErr bool
+	}{
+		{name: "accepts the smallest page", value: types.Int64Value(1), wantErr: false},
+		{name: "accepts the largest page", value: types.Int64Value(250), wantErr: false},
+		{name: "skips null values", value: types.Int64Null(), wantErr: false},
+		{name: "error case - rejects zero", value: types.Int64Value(0), wantErr: true},
+		{name: "error case - rejects pages larger than the API limit", value: types.Int64Value(251), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Int64Request{Path: path.Root("page_size"), ConfigValue: tt.value}
+			resp := &validator.Int64Response{}
+
+			pageSizeValidator{}.ValidateInt64(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			assert.NotEmpty(t, pageSizeValidator{}.MarkdownDescription(context.Background()))
+		})
+	}
+}
+
+// Test_parseDuration tests the parseDuration function.
+func Test_parseDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "parses milliseconds", value: "250ms", want: 250 * time.Millisecond},
+		{name: "parses minutes", value: "2m", want: 2 * time.Minute},
+		{name: "error case - empty string", value: "", wantErr: true},
+		{name: "error case - negative duration", value: "-5s", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseDuration(tt.value)
+
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// Test_nonNegativeFloat64Validator tests the nonNegativeFloat64Validator type.
+func Test_nonNegativeFloat64Validator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   types.Float64
+		wantErr bool
+	}{
+		{name: "accepts zero", value: types.Float64Value(0), wantErr: false},
+		{name: "accepts fractional values", value: types.Float64Value(0.5), wantErr: false},
+		{name: "skips unknown values", value: types.Float64Unknown(), wantErr: false},
+		{name: "error case - rejects negative values", value: types.Float64Value(-0.1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Float64Request{Path: path.Root("requests_per_second"), ConfigValue: tt.value}
+			resp := &validator.Float64Response{}
+
+			nonNegativeFloat64Validator{}.ValidateFloat64(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			assert.NotEmpty(t, nonNegativeFloat64Validator{}.MarkdownDescription(context.Background()))
+		})
+	}
+}
+
+// Test_certificatePEMValidator tests the certificatePEMValidator type.
+func Test_certificatePEMValidator(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := generateTestKeyPair(t)
+
+	tests := []struct {
+		name      string
+		validator certificatePEMValidator
+		value     func(t *testing.T) types.String
+		wantErr   bool
+	}{
+		{name: "accepts inline certificate", value: func(t *testing.T) types.String { return types.StringValue(certPEM) }},
+		{name: "accepts certificate file", validator: certificatePEMValidator{fromFile: true}, value: func(t *testing.T) types.String {
+			return types.StringValue(writeTestFile(t, "ca.pem", certPEM))
+		}},
+		{name: "skips unknown values", value: func(t *testing.T) types.String { return types.StringUnknown() }},
+		{name: "error case - rejects private key", value: func(t *testing.T) types.String { return types.StringValue(keyPEM) }, wantErr: true},
+		{name: "error case - rejects garbage", value: func(t *testing.T) types.String { return types.StringValue("not a certificate") }, wantErr: true},
+		{name: "error case - rejects missing file", validator: certificatePEMValidator{fromFile: true}, value: func(t *testing.T) types.String {
+			return types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))
+		}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{Path: path.Root("ca_cert_pem"), ConfigValue: tt.value(t)}
+			resp := &validator.StringResponse{}
+
+			tt.validator.ValidateString(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			assert.NotEmpty(t, tt.validator.MarkdownDescription(context.Background()))
+		})
+	}
+}
+
+// Test_privateKeyPEMValidator tests the privateKeyPEMValidator type.
+func Test_privateKeyPEMValidator(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := generateTestKeyPair(t)
+
+	tests := []struct {
+		name      string
+		validator privateKeyPEMValidator
+		value     func(t *testing.T) types.String
+		wantErr   bool
+	}{
+		{name: "accepts inline key", value: func(t *testing.T) types.String { return types.StringValue(keyPEM) }},
+		{name: "accepts key file", validator: privateKeyPEMValidator{fromFile: true}, value: func(t *testing.T) types.String {
+			return types.StringValue(writeTestFile(t, "client.key", keyPEM))
+		}},
+		{name: "skips null values", value: func(t *testing.T) types.String { return types.StringNull() }},
+		{name: "error case - rejects certificate", value: func(t *testing.T) types.String { return types.StringValue(certPEM) }, wantErr: true},
+		{name: "error case - rejects missing file", validator: privateKeyPEMValidator{fromFile: true}, value: func(t *testing.T) types.String {
+			return types.StringValue(filepath.Join(t.TempDir(), "missing.key"))
+		}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{Path: path.Root("client_key_pem"), ConfigValue: tt.value(t)}
+			resp := &validator.StringResponse{}
+
+			tt.validator.ValidateString(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			assert.NotEmpty(t, tt.validator.MarkdownDescription(context.Background()))
+		})
+	}
+}
+
+// Test_headersValidator tests the headersValidator type.
+func Test_headersValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   types.Map
+		wantErr bool
+	}{
+		{name: "accepts proxy headers", value: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"CF-Access-Client-Id":     types.StringValue("id"),
+			"CF-Access-Client-Secret": types.StringValue("secret"),
+			"Authorization":           types.StringValue("Bearer token"),
+		})},
+		{name: "skips null values", value: types.MapNull(types.StringType)},
+		{name: "error case - rejects invalid name", value: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"Bad Header": types.StringValue("value"),
+		}), wantErr: true},
+		{name: "error case - rejects API key header", value: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"x-n8n-api-key": types.StringValue("other"),
+		}), wantErr: true},
+		{name: "error case - rejects line breaks in value", value: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"X-Custom": types.StringValue("value\r\nX-Injected: true"),
+		}), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.MapRequest{Path: path.Root("headers"), ConfigValue: tt.value}
+			resp := &validator.MapResponse{}
+
+			headersValidator{}.ValidateMap(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			assert.NotEmpty(t, headersValidator{}.MarkdownDescription(context.Background()))
+		})
+	}
+}
+
+// Test_proxyURLValidator tests the proxyURLValidator type.
+func Test_proxyURLValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "accepts http proxy", value: types.StringValue("http://proxy.example.com:3128")},
+		{name: "accepts https proxy", value: types.StringValue("https://proxy.example.com")},
+		{name: "skips unknown values", value: types.StringUnknown()},
+		{name: "error case - rejects relative URL", value: types.StringValue("proxy.example.com:3128"), wantErr: true},
+		{name: "error case - rejects unparsable URL", value: types.StringValue("http://[::1"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{Path: path.Root("proxy_url"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			proxyURLValidator{}.ValidateString(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			assert.NotEmpty(t, proxyURLValidator{}.MarkdownDescription(context.Background()))
+		})
+	}
+}
+
+// Test_isHeaderName tests the isHeaderName function.
+func Test_isHeaderName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "accepts dashed name", input: "CF-Access-Client-Id", want: true},
+		{name: "accepts token symbols", input: "X-Custom_Header.v1", want: true},
+		{name: "error case - rejects empty name", input: "", want: false},
+		{name: "error case - rejects colon", input: "X-Header:", want: false},
+		{name: "error case - rejects non ASCII", input: "X-Héader", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, isHeaderName(tt.input))
+		})
+	}
+}
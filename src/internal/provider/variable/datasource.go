@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/variable/models"
 )
@@ -222,28 +223,16 @@ func (d *VariableDataSource) fetchVariable(ctx context.Context, data *models.Dat
 	}
 
 	// List all variables and filter client-side (API limitation)
-	variableList, httpResp, err := apiReq.Execute()
-	// Close HTTP response body if present
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	variables, httpResp, err := client.ListAll(apiReq, d.client.PageSize)
 	// Handle API errors
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing variables",
-			fmt.Sprintf("Could not list variables: %s\nHTTP Response: %v", err.Error(), httpResp),
-		)
+		shared.AddAPIError(&resp.Diagnostics, "Error listing variables", "Could not list variables", err, httpResp)
 		// Return with error.
 		return nil
 	}
 
 	// Find variable by ID or key
-	var variable *n8nsdk.Variable
-	var found bool
-	// Check if variable data exists in response
-	if variableList.Data != nil {
-		variable, found = findVariableByIDOrKey(variableList.Data, data.ID, data.Key)
-	}
+	variable, found := findVariableByIDOrKey(variables, data.ID, data.Key)
 
 	// Verify variable was found
 	if !found {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/constants"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/variable/models"
@@ -195,22 +196,16 @@ func (d *VariablesDataSource) Read(ctx context.Context, req datasource.ReadReque
 	// Build API request with optional filters
 	apiReq := d.buildAPIRequestWithFilters(ctx, &data)
 
-	variableList, httpResp, err := apiReq.Execute()
-	// Ensure the HTTP response body is properly closed to prevent resource leaks
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	// Fetch every page of the filtered list
+	variables, httpResp, err := client.ListAll(apiReq, d.client.PageSize)
 	// If the API request failed, report the error and return early
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing variables",
-			fmt.Sprintf("Could not list variables: %s\nHTTP Response: %v", err.Error(), httpResp),
-		)
+		shared.AddAPIError(&resp.Diagnostics, "Error listing variables", "Could not list variables", err, httpResp)
 		// Return with error.
 		return
 	}
 
-	d.populateVariables(&data, variableList)
+	d.populateVariables(&data, variables)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -242,17 +237,11 @@ func (d *VariablesDataSource) buildAPIRequestWithFilters(ctx context.Context, da
 //
 // Params:
 //   - data: DataSources model to populate
-//   - variableList: API response with variable data
-func (d *VariablesDataSource) populateVariables(data *models.DataSources, variableList *n8nsdk.VariableList) {
+//   - variables: variables returned by the API
+func (d *VariablesDataSource) populateVariables(data *models.DataSources, variables []n8nsdk.Variable) {
 	data.Variables = make([]models.Item, 0, constants.DEFAULT_LIST_CAPACITY)
-	// If the API returned variable data, process each variable
-	if variableList.Data == nil {
-		// Return result.
-		return
-	}
-
 	// For each variable returned from the API, map it to the Terraform model
-	for _, variable := range variableList.Data {
+	for _, variable := range variables {
 		item := d.mapVariableToItem(&variable)
 		data.Variables = append(data.Variables, *item)
 	}
@@ -254,14 +254,12 @@ func TestVariablesDataSource_populateVariables(t *testing.T) {
 				t.Helper()
 				ds := &VariablesDataSource{}
 				data := &models.DataSources{}
-				variableList := &n8nsdk.VariableList{
-					Data: []n8nsdk.Variable{
-						{Id: ptrString("var-1"), Key: "key1", Value: "value1"},
-						{Id: ptrString("var-2"), Key: "key2", Value: "value2"},
-					},
+				variables := []n8nsdk.Variable{
+					{Id: ptrString("var-1"), Key: "key1", Value: "value1"},
+					{Id: ptrString("var-2"), Key: "key2", Value: "value2"},
 				}
 
-				ds.populateVariables(data, variableList)
+				ds.populateVariables(data, variables)
 
 				assert.NotNil(t, data.Variables)
 				assert.Len(t, data.Variables, 2)
@@ -275,11 +273,9 @@ func TestVariablesDataSource_populateVariables(t *testing.T) {
 				t.Helper()
 				ds := &VariablesDataSource{}
 				data := &models.DataSources{}
-				variableList := &n8nsdk.VariableList{
-					Data: nil,
-				}
+				var variables []n8nsdk.Variable
 
-				ds.populateVariables(data, variableList)
+				ds.populateVariables(data, variables)
 
 				assert.NotNil(t, data.Variables)
 				assert.Len(t, data.Variables, 0)
@@ -291,11 +287,9 @@ func TestVariablesDataSource_populateVariables(t *testing.T) {
 				t.Helper()
 				ds := &VariablesDataSource{}
 				data := &models.DataSources{}
-				variableList := &n8nsdk.VariableList{
-					Data: []n8nsdk.Variable{},
-				}
+				variables := []n8nsdk.Variable{}
 
-				ds.populateVariables(data, variableList)
+				ds.populateVariables(data, variables)
 
 				assert.NotNil(t, data.Variables)
 				assert.Len(t, data.Variables, 0)
@@ -318,11 +312,7 @@ func TestVariablesDataSource_populateVariables(t *testing.T) {
 					}
 				}
 
-				variableList := &n8nsdk.VariableList{
-					Data: variables,
-				}
-
-				ds.populateVariables(data, variableList)
+				ds.populateVariables(data, variables)
 
 				assert.NotNil(t, data.Variables)
 				assert.Len(t, data.Variables, 100)
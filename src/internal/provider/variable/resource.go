@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/variable/models"
 )
@@ -24,6 +25,7 @@ var (
 	_ VariableResourceInterface        = &VariableResource{}
 	_ resource.ResourceWithConfigure   = &VariableResource{}
 	_ resource.ResourceWithImportState = &VariableResource{}
+	_ resource.ResourceWithModifyPlan  = &VariableResource{}
 )
 
 // VariableResourceInterface defines the interface for VariableResource.
@@ -37,6 +39,7 @@ type VariableResourceInterface interface {
 	Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse)
 	Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse)
 	ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse)
+	ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse)
 }
 
 // VariableResource defines the resource implementation for n8n variables.
@@ -92,7 +95,7 @@ func (r *VariableResource) Metadata(_ctx context.Context, req resource.MetadataR
 //	(none, modifies resp parameter in place)
 func (r *VariableResource) Schema(_ctx context.Context, _req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "n8n variable resource. Note: API limitations require workarounds for Read operations.",
+		MarkdownDescription: "n8n variable resource. Note: API limitations require workarounds for Read operations. Requires the Enterprise variables feature; planning fails on instances without it.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -113,7 +116,7 @@ func (r *VariableResource) Schema(_ctx context.Context, _req resource.SchemaRequ
 				Computed:            true,
 			},
 			"project_id": schema.StringAttribute{
-				MarkdownDescription: "Project ID to associate this variable with",
+				MarkdownDescription: "Project ID to associate this variable with. Defaults to the provider `default_project_id`.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -152,6 +155,24 @@ func (r *VariableResource) Configure(_ctx context.Context, req resource.Configur
 	r.client = clientData
 }
 
+// ModifyPlan fails the plan when the n8n instance does not license variables, then plans
+// the project of the variable.
+//
+// Params:
+//   - ctx: Context for the operation
+//   - req: ModifyPlan request containing config, state and plan
+//   - resp: ModifyPlan response to update the plan
+func (r *VariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	shared.RequireFeature(r.client, client.FEATURE_VARIABLES, "n8n_variable", resp)
+	// Check for unsupported feature.
+	if resp.Diagnostics.HasError() {
+		// Return early.
+		return
+	}
+
+	shared.ModifyPlanProjectID(ctx, r.client, req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 // Workaround: API returns 201 with no body, so we must call LIST to get the ID.
 //
@@ -236,9 +257,12 @@ func (r *VariableResource) executeVariableCreate(ctx context.Context, plan *mode
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIAttributeError(
+			&resp.Diagnostics,
+			path.Root("key"),
 			"Error creating variable",
-			fmt.Sprintf("Could not create variable: %s\nHTTP Response: %v", err.Error(), httpResp),
+			"Could not create variable",
+			err, httpResp,
 		)
 		return false
 	}
@@ -256,29 +280,22 @@ func (r *VariableResource) executeVariableCreate(ctx context.Context, plan *mode
 // Returns:
 //   - *n8nsdk.Variable: found variable or nil if not found
 func (r *VariableResource) findCreatedVariable(ctx context.Context, plan *models.Resource, resp *resource.CreateResponse) *n8nsdk.Variable {
-	variableList, httpResp, err := r.client.APIClient.VariablesAPI.VariablesGet(ctx).Execute()
-	// Check for non-nil value.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	variables, httpResp, err := client.ListAll(r.client.APIClient.VariablesAPI.VariablesGet(ctx), r.client.PageSize)
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error reading variable after creation",
-			fmt.Sprintf("Variable was created but could not retrieve ID: %s\nHTTP Response: %v", err.Error(), httpResp),
+			"Variable was created but could not retrieve ID",
+			err, httpResp,
 		)
 		// Return with error.
 		return nil
 	}
 
 	// Find our variable by key
-	var foundVariable *n8nsdk.Variable
-	var found bool
-	// Check for non-nil value.
-	if variableList.Data != nil {
-		foundVariable, found = findVariableByKey(variableList.Data, plan.Key.ValueString())
-	}
+	foundVariable, found := findVariableByKey(variables, plan.Key.ValueString())
 
 	// Check condition.
 	if !found {
@@ -335,27 +352,25 @@ func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, r
 // Returns:
 //   - bool: True if read succeeded, false otherwise
 func (r *VariableResource) executeReadLogic(ctx context.Context, state *models.Resource, resp *resource.ReadResponse) bool {
-	// Fetch variable list from API
-	variableList, httpResp, err := r.client.APIClient.VariablesAPI.VariablesGet(ctx).Execute()
-	// Check for non-nil value.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	// Fetch every variable from API
+	variables, httpResp, err := client.ListAll(r.client.APIClient.VariablesAPI.VariablesGet(ctx), r.client.PageSize)
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error reading variable",
-			fmt.Sprintf("Could not read variable ID %s: %s\nHTTP Response: %v", state.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not read variable ID %s", state.ID.ValueString()),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
 	}
 
 	// Find variable by ID
-	foundVariable := r.findVariableByID(variableList, state.ID.ValueString())
+	foundVariable, found := findVariableByID(variables, state.ID.ValueString())
 	// Check condition.
-	if foundVariable == nil {
+	if !found {
 		// Variable not found = deleted outside Terraform
 		resp.State.RemoveResource(ctx)
 		// Return failure.
@@ -369,32 +384,6 @@ func (r *VariableResource) executeReadLogic(ctx context.Context, state *models.R
 	return true
 }
 
-// findVariableByID finds a variable in the list by ID.
-//
-// Params:
-//   - variableList: list of variables from API
-//   - id: variable ID to find
-//
-// Returns:
-//   - *n8nsdk.Variable: found variable or nil if not found
-func (r *VariableResource) findVariableByID(variableList *n8nsdk.VariableList, id string) *n8nsdk.Variable {
-	// Check for non-nil value.
-	if variableList.Data == nil {
-		// Return result.
-		return nil
-	}
-	// Iterate over items.
-	for _, v := range variableList.Data {
-		// Check for non-nil value.
-		if v.Id != nil && *v.Id == id {
-			// Return matching variable.
-			return &v
-		}
-	}
-	// Return result.
-	return nil
-}
-
 // updateStateFromVariable updates the state model with data from the found variable.
 //
 // Params:
@@ -514,9 +503,12 @@ func (r *VariableResource) executeVariableUpdate(ctx context.Context, plan *mode
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIAttributeError(
+			&resp.Diagnostics,
+			path.Root("key"),
 			"Error updating variable",
-			fmt.Sprintf("Could not update variable ID %s: %s\nHTTP Response: %v", plan.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not update variable ID %s", plan.ID.ValueString()),
+			err, httpResp,
 		)
 		return false
 	}
@@ -534,29 +526,22 @@ func (r *VariableResource) executeVariableUpdate(ctx context.Context, plan *mode
 // Returns:
 //   - *n8nsdk.Variable: found variable or nil if not found
 func (r *VariableResource) findUpdatedVariable(ctx context.Context, plan *models.Resource, resp *resource.UpdateResponse) *n8nsdk.Variable {
-	variableList, httpResp, err := r.client.APIClient.VariablesAPI.VariablesGet(ctx).Execute()
-	// Check for non-nil value.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	variables, httpResp, err := client.ListAll(r.client.APIClient.VariablesAPI.VariablesGet(ctx), r.client.PageSize)
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error reading variable after update",
-			fmt.Sprintf("Variable was updated but could not verify: %s\nHTTP Response: %v", err.Error(), httpResp),
+			"Variable was updated but could not verify",
+			err, httpResp,
 		)
 		// Return with error.
 		return nil
 	}
 
 	// Find our variable by ID
-	var foundVariable *n8nsdk.Variable
-	var found bool
-	// Check for non-nil value.
-	if variableList.Data != nil {
-		foundVariable, found = findVariableByID(variableList.Data, plan.ID.ValueString())
-	}
+	foundVariable, found := findVariableByID(variables, plan.ID.ValueString())
 
 	// Check condition.
 	if !found {
@@ -616,9 +601,16 @@ func (r *VariableResource) executeDeleteLogic(ctx context.Context, state *models
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// The variable was already deleted outside Terraform.
+		if shared.IgnoreNotFound(ctx, err, httpResp, "variable", state.ID.ValueString()) {
+			// Return success.
+			return true
+		}
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error deleting variable",
-			fmt.Sprintf("Could not delete variable ID %s: %s\nHTTP Response: %v", state.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not delete variable ID %s", state.ID.ValueString()),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
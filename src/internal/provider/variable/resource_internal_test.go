@@ -3,16 +3,22 @@ package variable
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/variable/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // setupTestClient creates a test N8nClient with httptest server.
@@ -145,6 +151,33 @@ func TestVariableResource_executeCreateLogic(t *testing.T) {
 	}
 }
 
+// TestVariableResource_executeCreateLogic_ReadOnly tests that a read-only client refuses the creation.
+func TestVariableResource_executeCreateLogic_ReadOnly(t *testing.T) {
+	t.Parallel()
+
+	var writes int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writes++
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	r := &VariableResource{client: client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{ReadOnly: true})}
+	plan := &models.Resource{
+		Key:   types.StringValue("TEST_VAR"),
+		Value: types.StringValue("test_value"),
+	}
+	resp := &resource.CreateResponse{}
+
+	result := r.executeCreateLogic(context.Background(), plan, resp)
+
+	assert.False(t, result, "Should return false when writes are refused")
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Provider Is Read-Only", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "the provider refused POST /api/v1/variables")
+	assert.Zero(t, writes, "Request should never reach the server")
+}
+
 // TestVariableResource_executeReadLogic tests the executeReadLogic method with error cases.
 func TestVariableResource_executeReadLogic(t *testing.T) {
 	t.Parallel()
@@ -231,6 +264,41 @@ func TestVariableResource_executeReadLogic(t *testing.T) {
 	}
 }
 
+// TestVariableResource_executeReadLogic_ListCache tests that refreshing many variables costs one list call.
+func TestVariableResource_executeReadLogic_ListCache(t *testing.T) {
+	t.Parallel()
+
+	const count = 50
+
+	var lists atomic.Int32
+	variables := make([]map[string]any, 0, count)
+	for i := range count {
+		variables = append(variables, map[string]any{"id": fmt.Sprintf("var-%d", i), "key": fmt.Sprintf("KEY_%d", i), "value": "v"})
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lists.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"data": variables})
+	}))
+	defer server.Close()
+
+	r := &VariableResource{client: client.NewN8nClient(server.URL, "key")}
+
+	var wg sync.WaitGroup
+	for i := range count {
+		wg.Go(func() {
+			state := &models.Resource{ID: types.StringValue(fmt.Sprintf("var-%d", i))}
+			resp := &resource.ReadResponse{}
+
+			assert.True(t, r.executeReadLogic(context.Background(), state, resp))
+			assert.Equal(t, fmt.Sprintf("KEY_%d", i), state.Key.ValueString())
+		})
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), lists.Load(), "concurrent reads should share one list call")
+}
+
 // TestVariableResource_executeUpdateLogic tests the executeUpdateLogic method with error cases.
 func TestVariableResource_executeUpdateLogic(t *testing.T) {
 	t.Parallel()
@@ -352,13 +420,13 @@ func TestVariableResource_executeDeleteLogic(t *testing.T) {
 			expectError: false,
 		},
 		{
-			name:       "variable not found",
+			name:       "variable already deleted",
 			variableID: "var-404",
 			setupHandler: func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusNotFound)
 				w.Write([]byte(`{"message": "Variable not found"}`))
 			},
-			expectError: true,
+			expectError: false,
 		},
 		{
 			name:       "API error",
@@ -878,3 +946,55 @@ func TestVariableResource_findUpdatedVariable(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+// TestVariableResource_ModifyPlan tests that the plan fails when variables are not licensed.
+func TestVariableResource_ModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		settings string
+		wantErr  bool
+	}{
+		{name: "licensed instance", settings: `{"data":{"versionCli":"1.64.0","enterprise":{"variables":true}}}`},
+		{name: "error case - variables not licensed", settings: `{"data":{"versionCli":"1.64.0","enterprise":{"variables":false}}}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Serve the instance settings used by feature detection.
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Write([]byte(tt.settings))
+			}))
+			defer server.Close()
+			r := NewVariableResource()
+			r.client = client.NewN8nClientWithOptions(server.URL, "key", client.ClientOptions{})
+			r.client.Instance, _ = client.DetectInstance(context.Background(), r.client.BaseURL, r.client.APIClient)
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+			attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attrType := range objectType.AttributeTypes {
+				attrs[name] = tftypes.NewValue(attrType, nil)
+			}
+			raw := tftypes.NewValue(objectType, attrs)
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(context.Background(), req, resp)
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			// Check the gating message.
+			if tt.wantErr {
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "n8n_variable requires the Enterprise variables feature")
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+)
+
+// CONNECT_FUNCTION_OPTIONS_ARGUMENT is the position of the options argument of the connect function.
+const CONNECT_FUNCTION_OPTIONS_ARGUMENT int64 = 2
+
+// connectFunctionOptions are the options supported by the connect function.
+var connectFunctionOptions []string = []string{"source_output", "source_output_index", "target_input", "target_input_index"}
+
+// Ensure ConnectFunction implements required interfaces.
+var _ function.Function = &ConnectFunction{}
+
+// ConnectFunction builds the JSON of a connection between two workflow nodes without storing it in the state.
+// Its output is identical to the connection_json of the n8n_workflow_connection resource.
+type ConnectFunction struct{}
+
+// NewConnectFunction creates a new ConnectFunction instance.
+//
+// Returns:
+//   - function.Function: the connect function
+func NewConnectFunction() function.Function {
+	// Return new instance.
+	return &ConnectFunction{}
+}
+
+// Metadata returns the function name.
+//
+// Params:
+//   - _ctx: The context for the request (unused).
+//   - _req: The metadata request (unused).
+//   - resp: The metadata response to populate.
+func (f *ConnectFunction) Metadata(_ctx context.Context, _req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "connect"
+}
+
+// Definition defines the parameters and return type of the function.
+//
+// Params:
+//   - _ctx: The context for the request (unused).
+//   - _req: The definition request (unused).
+//   - resp: The definition response to populate.
+func (f *ConnectFunction) Definition(_ctx context.Context, _req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the JSON of a connection between workflow nodes",
+		MarkdownDescription: "Builds the JSON of a connection between two workflow nodes, identical to the " +
+			"`connection_json` of the `n8n_workflow_connection` resource, without storing anything in the state. " +
+			"Combine connections with `merge_connections` to build `connections_json`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "source",
+				MarkdownDescription: "Name of the source node",
+			},
+			function.StringParameter{
+				Name:                "target",
+				MarkdownDescription: "Name of the destination node",
+			},
+			function.DynamicParameter{
+				Name:           "options",
+				AllowNullValue: true,
+				MarkdownDescription: "Optional settings, or `null`: `source_output` (default `main`), `source_output_index` " +
+					"(default 0), `target_input` (default `main`) and `target_input_index` (default 0)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the connection JSON.
+//
+// Params:
+//   - ctx: The context for the request.
+//   - req: The run request containing the arguments.
+//   - resp: The run response to populate.
+func (f *ConnectFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var source, target string
+	var options types.Dynamic
+
+	resp.Error = req.Arguments.Get(ctx, &source, &target, &options)
+	// Check for argument errors.
+	if resp.Error != nil {
+		return
+	}
+
+	plan, funcErr := buildConnectionModel(ctx, source, target, options)
+	// Check for option errors.
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	r := NewWorkflowConnectionResource()
+	plan.ID = types.StringValue(r.generateConnectionID(plan))
+	var diags diag.Diagnostics
+	// Check if JSON generation failed.
+	if !r.generateConnectionJSON(plan, &diags) {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, plan.ConnectionJSON.ValueString())
+}
+
+// buildConnectionModel builds the connection resource model from the function arguments,
+// applying the defaults of the n8n_workflow_connection resource.
+//
+// Params:
+//   - ctx: The context for the conversion.
+//   - source: Name of the source node.
+//   - target: Name of the destination node.
+//   - options: Options argument.
+//
+// Returns:
+//   - *models.ConnectionResource: The connection model.
+//   - *function.FuncError: Error if an option is invalid.
+func buildConnectionModel(ctx context.Context, source, target string, options types.Dynamic) (*models.ConnectionResource, *function.FuncError) {
+	opts, funcErr := decodeFunctionOptions(ctx, options, CONNECT_FUNCTION_OPTIONS_ARGUMENT, connectFunctionOptions)
+	// Check for decoding errors.
+	if funcErr != nil {
+		// Return error.
+		return nil, funcErr
+	}
+
+	plan := &models.ConnectionResource{
+		SourceNode: types.StringValue(source),
+		TargetNode: types.StringValue(target),
+	}
+	var outputErr, outputIndexErr, inputErr, inputIndexErr *function.FuncError
+	plan.SourceOutput, outputErr = opts.String("source_output", types.StringValue(DEFAULT_OUTPUT_TYPE))
+	plan.SourceOutputIndex, outputIndexErr = opts.Int64("source_output_index", DEFAULT_OUTPUT_INDEX)
+	plan.TargetInput, inputErr = opts.String("target_input", types.StringValue(DEFAULT_INPUT_TYPE))
+	plan.TargetInputIndex, inputIndexErr = opts.Int64("target_input_index", DEFAULT_INPUT_INDEX)
+
+	// Return model.
+	return plan, function.ConcatFuncErrors(outputErr, outputIndexErr, inputErr, inputIndexErr)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		options types.Dynamic
+		plan    *models.ConnectionResource
+		wantErr string
+	}{
+		{
+			name:    "defaults match the resource",
+			options: types.DynamicNull(),
+			plan: &models.ConnectionResource{
+				SourceNode:        types.StringValue("IF"),
+				SourceOutput:      types.StringValue(DEFAULT_OUTPUT_TYPE),
+				SourceOutputIndex: types.Int64Value(DEFAULT_OUTPUT_INDEX),
+				TargetNode:        types.StringValue("Slack"),
+				TargetInput:       types.StringValue(DEFAULT_INPUT_TYPE),
+				TargetInputIndex:  types.Int64Value(DEFAULT_INPUT_INDEX),
+			},
+		},
+		{
+			name: "options match the resource",
+			options: dynamicOptions(t, map[string]attr.Value{
+				"source_output":       types.StringValue("ai_tool"),
+				"source_output_index": types.Int64Value(1),
+				"target_input":        types.StringValue("ai_tool"),
+				"target_input_index":  types.Int64Value(2),
+			}),
+			plan: &models.ConnectionResource{
+				SourceNode:        types.StringValue("IF"),
+				SourceOutput:      types.StringValue("ai_tool"),
+				SourceOutputIndex: types.Int64Value(1),
+				TargetNode:        types.StringValue("Slack"),
+				TargetInput:       types.StringValue("ai_tool"),
+				TargetInputIndex:  types.Int64Value(2),
+			},
+		},
+		{
+			name:    "error case - unsupported option",
+			options: dynamicOptions(t, map[string]attr.Value{"output": types.StringValue("main")}),
+			wantErr: `Unsupported option "output"`,
+		},
+		{
+			name:    "error case - fractional index",
+			options: dynamicOptions(t, map[string]attr.Value{"source_output_index": types.Float64Value(0.5)}),
+			wantErr: `Option "source_output_index" must be a whole number`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, funcErr := runFunction(t, NewConnectFunction(), types.StringUnknown(),
+				types.StringValue("IF"), types.StringValue("Slack"), tt.options,
+			)
+
+			if tt.wantErr != "" {
+				require.NotNil(t, funcErr)
+				assert.Contains(t, funcErr.Text, tt.wantErr)
+				return
+			}
+			require.Nil(t, funcErr)
+
+			// The function output is byte-identical to the resource connection_json.
+			r := NewWorkflowConnectionResource()
+			var diags diag.Diagnostics
+			require.True(t, r.generateConnectionJSON(tt.plan, &diags))
+			assert.Equal(t, tt.plan.ConnectionJSON, result)
+		})
+	}
+}
+
+func TestConnectFunction_Definition(t *testing.T) {
+	t.Parallel()
+
+	f := NewConnectFunction()
+	metaResp := &function.MetadataResponse{}
+	f.Metadata(context.Background(), function.MetadataRequest{}, metaResp)
+	defResp := &function.DefinitionResponse{}
+	f.Definition(context.Background(), function.DefinitionRequest{}, defResp)
+
+	assert.Equal(t, "connect", metaResp.Name)
+	assert.Len(t, defResp.Definition.Parameters, int(CONNECT_FUNCTION_OPTIONS_ARGUMENT)+1)
+	assert.False(t, defResp.Diagnostics.HasError())
+}
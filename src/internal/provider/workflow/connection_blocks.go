@@ -0,0 +1,309 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+)
+
+// Connection types of the AI agent nodes, in addition to DEFAULT_OUTPUT_TYPE.
+const (
+	// CONNECTION_TYPE_AI_LANGUAGE_MODEL connects a chat model to an agent or chain.
+	CONNECTION_TYPE_AI_LANGUAGE_MODEL string = "ai_languageModel"
+	// CONNECTION_TYPE_AI_TOOL connects a tool to an agent.
+	CONNECTION_TYPE_AI_TOOL string = "ai_tool"
+	// CONNECTION_TYPE_AI_MEMORY connects a memory to an agent.
+	CONNECTION_TYPE_AI_MEMORY string = "ai_memory"
+	// CONNECTION_TYPE_AI_OUTPUT_PARSER connects an output parser to an agent or chain.
+	CONNECTION_TYPE_AI_OUTPUT_PARSER string = "ai_outputParser"
+)
+
+// MAX_CONNECTION_INDEX is the highest output or input index of a connection.
+// n8n stores a list entry for every output index below it, so the bound keeps the connections small.
+const MAX_CONNECTION_INDEX int64 = 1000
+
+// connectionIndexValidator validates the output and input indexes of a connection.
+var connectionIndexValidator int64BetweenValidator = int64BetweenValidator{min: 0, max: MAX_CONNECTION_INDEX}
+
+// connectionBlockAttributeTypes are the attribute types of a connection block.
+var connectionBlockAttributeTypes map[string]attr.Type = map[string]attr.Type{
+	"from":        types.StringType,
+	"from_output": types.StringType,
+	"from_index":  types.Int64Type,
+	"to":          types.StringType,
+	"to_input":    types.StringType,
+	"to_index":    types.Int64Type,
+}
+
+// connectionBlockObjectType is the object type of a connection block.
+var connectionBlockObjectType types.ObjectType = types.ObjectType{AttrTypes: connectionBlockAttributeTypes}
+
+// connectionBlock returns the node_connection block of the workflow resource schema.
+// It is not named connection, which Terraform reserves for provisioners.
+//
+// Returns:
+//   - schema.SetNestedBlock: the connection block definition
+func (r *WorkflowResource) connectionBlock() schema.SetNestedBlock {
+	// Return connection block.
+	return schema.SetNestedBlock{
+		MarkdownDescription: "Connection between two workflow nodes, as an alternative to `connections_json`. " +
+			"AI agent nodes use the output types `ai_languageModel`, `ai_tool`, `ai_memory` and `ai_outputParser`. " +
+			"Conflicts with `connections_json`.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"from": schema.StringAttribute{
+					MarkdownDescription: "Name of the source node",
+					Required:            true,
+				},
+				"from_output": schema.StringAttribute{
+					MarkdownDescription: "Output type of the source node (e.g., 'main', 'ai_languageModel', 'ai_tool'). Defaults to 'main'.",
+					Optional:            true,
+				},
+				"from_index": schema.Int64Attribute{
+					MarkdownDescription: "Output index of the source node, for nodes with several outputs (e.g., IF, Switch). Between 0 and 1000, defaults to 0.",
+					Optional:            true,
+					Validators:          []validator.Int64{connectionIndexValidator},
+				},
+				"to": schema.StringAttribute{
+					MarkdownDescription: "Name of the target node",
+					Required:            true,
+				},
+				"to_input": schema.StringAttribute{
+					MarkdownDescription: "Input type of the target node. Defaults to `from_output`.",
+					Optional:            true,
+				},
+				"to_index": schema.Int64Attribute{
+					MarkdownDescription: "Input index of the target node, for nodes with several inputs (e.g., Merge). Between 0 and 1000, defaults to 0.",
+					Optional:            true,
+					Validators:          []validator.Int64{connectionIndexValidator},
+				},
+			},
+		},
+	}
+}
+
+// hasConnectionBlocks checks whether connection blocks are configured.
+//
+// Params:
+//   - connections: The connection blocks
+//
+// Returns:
+//   - bool: True if at least one connection block is configured
+func hasConnectionBlocks(connections types.Set) bool {
+	// Return result.
+	return !connections.IsNull() && !connections.IsUnknown() && len(connections.Elements()) > 0
+}
+
+// validateConnectionBlocks checks that connections are configured with either connection blocks
+// or connections_json. Unknown values count as set.
+//
+// Params:
+//   - config: The resource configuration
+//   - diags: Diagnostics for error reporting
+func validateConnectionBlocks(config *models.Resource, diags *diag.Diagnostics) {
+	hasBlocks := config.Connections.IsUnknown() || hasConnectionBlocks(config.Connections)
+	// Check for conflict.
+	if hasBlocks && !config.ConnectionsJSON.IsNull() {
+		diags.AddAttributeError(
+			path.Root("connections_json"),
+			"Conflicting Workflow Connections",
+			"Only one of connections_json or connection blocks can be set.",
+		)
+	}
+}
+
+// connectionEdgeFromBlock converts a connection block to a connection, applying the defaults.
+//
+// Params:
+//   - block: The connection block
+//
+// Returns:
+//   - connectionEdge: The connection
+func connectionEdgeFromBlock(block *models.Connection) connectionEdge {
+	edge := connectionEdge{
+		SourceNode:        block.From.ValueString(),
+		SourceOutput:      DEFAULT_OUTPUT_TYPE,
+		SourceOutputIndex: block.FromIndex.ValueInt64(),
+		TargetNode:        block.To.ValueString(),
+		TargetInputIndex:  block.ToIndex.ValueInt64(),
+	}
+	// Set output type if configured.
+	if !block.FromOutput.IsNull() && !block.FromOutput.IsUnknown() {
+		edge.SourceOutput = block.FromOutput.ValueString()
+	}
+	// AI connections use the same type on both ends.
+	edge.TargetInput = edge.SourceOutput
+	// Set input type if configured.
+	if !block.ToInput.IsNull() && !block.ToInput.IsUnknown() {
+		edge.TargetInput = block.ToInput.ValueString()
+	}
+
+	// Return connection.
+	return edge
+}
+
+// connectionsFromBlocks converts connection blocks to the n8n connections format.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - blocks: The connection blocks
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - map[string]any: The connections keyed by source node
+func connectionsFromBlocks(ctx context.Context, blocks types.Set, diags *diag.Diagnostics) map[string]any {
+	var connectionModels []models.Connection
+	diags.Append(blocks.ElementsAs(ctx, &connectionModels, false)...)
+
+	merged := map[string]map[string][][]connectionTarget{}
+	// Add each connection.
+	for i := range connectionModels {
+		addConnectionEdge(merged, connectionEdgeFromBlock(&connectionModels[i]))
+	}
+
+	connections := make(map[string]any, len(merged))
+	// Convert to the SDK type.
+	for source, outputs := range merged {
+		connections[source] = outputs
+	}
+
+	// Return connections.
+	return connections
+}
+
+// connectionEdgesFromWorkflow lists the connections of n8n connections, sorted for stable results.
+//
+// Params:
+//   - connections: The connections returned by n8n
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - []connectionEdge: The connections
+func connectionEdgesFromWorkflow(connections map[string]any, diags *diag.Diagnostics) []connectionEdge {
+	var typed map[string]map[string][][]connectionTarget
+	encoded, err := json.Marshal(connections)
+	// Check for conversion error.
+	if err == nil {
+		err = json.Unmarshal(encoded, &typed)
+	}
+	// Check for conversion error.
+	if err != nil {
+		diags.AddError("Invalid workflow connections", fmt.Sprintf("Could not decode workflow connections: %s", err.Error()))
+		// Return no connections.
+		return nil
+	}
+
+	edges := []connectionEdge{}
+	// Collect each connection.
+	for source, outputs := range typed {
+		// Collect each output type.
+		for outputType, slots := range outputs {
+			// Collect each output index.
+			for index, targets := range slots {
+				// Collect each target.
+				for _, target := range targets {
+					edges = append(edges, connectionEdge{
+						SourceNode:        source,
+						SourceOutput:      outputType,
+						SourceOutputIndex: int64(index),
+						TargetNode:        target.Node,
+						TargetInput:       target.Type,
+						TargetInputIndex:  target.Index,
+					})
+				}
+			}
+		}
+	}
+	slices.SortFunc(edges, func(a, b connectionEdge) int {
+		// Return comparison by source, then by target.
+		return cmp.Or(
+			cmp.Compare(a.SourceNode, b.SourceNode),
+			cmp.Compare(a.SourceOutput, b.SourceOutput),
+			cmp.Compare(a.SourceOutputIndex, b.SourceOutputIndex),
+			cmp.Compare(a.TargetNode, b.TargetNode),
+			cmp.Compare(a.TargetInput, b.TargetInput),
+			cmp.Compare(a.TargetInputIndex, b.TargetInputIndex),
+		)
+	})
+
+	// Return connections.
+	return edges
+}
+
+// mapConnectionBlocks updates the connection blocks from the connections returned by n8n.
+// Prior blocks describing a returned connection are kept as-is, with their unset defaults.
+// Blocks are only maintained when they are configured.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - connections: The connections returned by n8n
+//   - prior: The prior connection blocks, from the plan or the state
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - types.Set: The updated connection blocks
+func mapConnectionBlocks(ctx context.Context, connections map[string]any, prior types.Set, diags *diag.Diagnostics) types.Set {
+	// Keep unconfigured blocks as-is.
+	if !hasConnectionBlocks(prior) {
+		// Check for an untyped value.
+		if prior.ElementType(ctx) == nil {
+			// Return null blocks.
+			return types.SetNull(connectionBlockObjectType)
+		}
+		// Return prior blocks.
+		return prior
+	}
+
+	var priorModels []models.Connection
+	diags.Append(prior.ElementsAs(ctx, &priorModels, false)...)
+	// Check for conversion errors.
+	if diags.HasError() {
+		// Return prior blocks.
+		return prior
+	}
+
+	byEdge := make(map[connectionEdge]models.Connection, len(priorModels))
+	// Index prior blocks by connection.
+	for i := range priorModels {
+		byEdge[connectionEdgeFromBlock(&priorModels[i])] = priorModels[i]
+	}
+
+	edges := connectionEdgesFromWorkflow(connections, diags)
+	blocks := make([]models.Connection, 0, len(edges))
+	// Map each connection.
+	for _, edge := range edges {
+		// Keep the prior block.
+		if block, found := byEdge[edge]; found {
+			blocks = append(blocks, block)
+			continue
+		}
+		blocks = append(blocks, models.Connection{
+			From:       types.StringValue(edge.SourceNode),
+			FromOutput: types.StringValue(edge.SourceOutput),
+			FromIndex:  types.Int64Value(edge.SourceOutputIndex),
+			To:         types.StringValue(edge.TargetNode),
+			ToInput:    types.StringValue(edge.TargetInput),
+			ToIndex:    types.Int64Value(edge.TargetInputIndex),
+		})
+	}
+
+	set, setDiags := types.SetValueFrom(ctx, connectionBlockObjectType, blocks)
+	diags.Append(setDiags...)
+
+	// Return connection blocks.
+	return set
+}
@@ -0,0 +1,213 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testConnectionBlock returns a connection block with only the required attributes set.
+func testConnectionBlock(from, to string) models.Connection {
+	return models.Connection{
+		From:       types.StringValue(from),
+		FromOutput: types.StringNull(),
+		FromIndex:  types.Int64Null(),
+		To:         types.StringValue(to),
+		ToInput:    types.StringNull(),
+		ToIndex:    types.Int64Null(),
+	}
+}
+
+// testConnectionBlocks converts connection blocks to a set value.
+func testConnectionBlocks(t *testing.T, connections ...models.Connection) types.Set {
+	t.Helper()
+	set, diags := types.SetValueFrom(context.Background(), connectionBlockObjectType, connections)
+	require.False(t, diags.HasError())
+
+	return set
+}
+
+// testDecodedConnections returns connections as decoded from an n8n response.
+func testDecodedConnections(t *testing.T, raw string) map[string]any {
+	t.Helper()
+	var connections map[string]any
+	require.NoError(t, json.Unmarshal([]byte(raw), &connections))
+
+	return connections
+}
+
+func Test_validateConnectionBlocks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  func(t *testing.T) *models.Resource
+		wantErr bool
+	}{
+		{
+			name: "connection blocks only",
+			config: func(t *testing.T) *models.Resource {
+				t.Helper()
+				return &models.Resource{
+					Connections:     testConnectionBlocks(t, testConnectionBlock("Webhook", "Set")),
+					ConnectionsJSON: jsontypes.NewNull(jsontypes.KIND_CONNECTIONS),
+				}
+			},
+		},
+		{
+			name: "connections_json only",
+			config: func(t *testing.T) *models.Resource {
+				t.Helper()
+				return &models.Resource{
+					Connections:     types.SetNull(connectionBlockObjectType),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("{}"),
+				}
+			},
+		},
+		{
+			name: "error case - connection blocks and connections_json",
+			config: func(t *testing.T) *models.Resource {
+				t.Helper()
+				return &models.Resource{
+					Connections:     testConnectionBlocks(t, testConnectionBlock("Webhook", "Set")),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("{}"),
+				}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var diags diag.Diagnostics
+			validateConnectionBlocks(tt.config(t), &diags)
+			assert.Equal(t, tt.wantErr, diags.HasError())
+		})
+	}
+}
+
+func Test_connectionsFromBlocks(t *testing.T) {
+	t.Parallel()
+
+	falseBranch := testConnectionBlock("IF", "Log")
+	falseBranch.FromIndex = types.Int64Value(1)
+	model := testConnectionBlock("OpenAI Chat Model", "AI Agent")
+	model.FromOutput = types.StringValue(CONNECTION_TYPE_AI_LANGUAGE_MODEL)
+	tool := testConnectionBlock("Calculator", "AI Agent")
+	tool.FromOutput = types.StringValue(CONNECTION_TYPE_AI_TOOL)
+	memory := testConnectionBlock("Memory", "AI Agent")
+	memory.FromOutput = types.StringValue(CONNECTION_TYPE_AI_MEMORY)
+	parser := testConnectionBlock("Parser", "AI Agent")
+	parser.FromOutput = types.StringValue(CONNECTION_TYPE_AI_OUTPUT_PARSER)
+	merge := testConnectionBlock("Log", "Merge")
+	merge.ToIndex = types.Int64Value(1)
+
+	var diags diag.Diagnostics
+	connections := connectionsFromBlocks(context.Background(), testConnectionBlocks(t, falseBranch, model, tool, memory, parser, merge), &diags)
+	require.False(t, diags.HasError())
+
+	encoded, err := json.Marshal(connections)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"IF": {"main": [[], [{"index": 0, "node": "Log", "type": "main"}]]},
+		"OpenAI Chat Model": {"ai_languageModel": [[{"index": 0, "node": "AI Agent", "type": "ai_languageModel"}]]},
+		"Calculator": {"ai_tool": [[{"index": 0, "node": "AI Agent", "type": "ai_tool"}]]},
+		"Memory": {"ai_memory": [[{"index": 0, "node": "AI Agent", "type": "ai_memory"}]]},
+		"Parser": {"ai_outputParser": [[{"index": 0, "node": "AI Agent", "type": "ai_outputParser"}]]},
+		"Log": {"main": [[{"index": 1, "node": "Merge", "type": "main"}]]}
+	}`, string(encoded))
+}
+
+func Test_connectionEdgesFromWorkflow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		raw     map[string]any
+		want    []connectionEdge
+		wantErr bool
+	}{
+		{
+			name: "sorted edges with empty outputs",
+			raw: testDecodedConnections(t, `{
+				"Webhook": {"main": [[{"node": "Set", "type": "main", "index": 0}]]},
+				"IF": {"main": [[], [{"node": "Log", "type": "main", "index": 0}]]},
+				"Set": {"main": [[]]}
+			}`),
+			want: []connectionEdge{
+				{SourceNode: "IF", SourceOutput: "main", SourceOutputIndex: 1, TargetNode: "Log", TargetInput: "main"},
+				{SourceNode: "Webhook", SourceOutput: "main", TargetNode: "Set", TargetInput: "main"},
+			},
+		},
+		{
+			name:    "error case - invalid connections",
+			raw:     map[string]any{"Webhook": "invalid"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var diags diag.Diagnostics
+			edges := connectionEdgesFromWorkflow(tt.raw, &diags)
+			assert.Equal(t, tt.wantErr, diags.HasError())
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, edges)
+			}
+		})
+	}
+}
+
+func Test_mapConnectionBlocks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("keeps unset defaults and reports drift", func(t *testing.T) {
+		t.Parallel()
+		model := testConnectionBlock("OpenAI Chat Model", "AI Agent")
+		model.FromOutput = types.StringValue(CONNECTION_TYPE_AI_LANGUAGE_MODEL)
+		prior := testConnectionBlocks(t, testConnectionBlock("Webhook", "AI Agent"), model)
+		connections := testDecodedConnections(t, `{
+			"Webhook": {"main": [[{"node": "AI Agent", "type": "main", "index": 0}]]},
+			"OpenAI Chat Model": {"ai_languageModel": [[{"node": "AI Agent", "type": "ai_languageModel", "index": 0}]]},
+			"AI Agent": {"main": [[{"node": "Respond", "type": "main", "index": 0}]]}
+		}`)
+
+		var diags diag.Diagnostics
+		set := mapConnectionBlocks(ctx, connections, prior, &diags)
+		require.False(t, diags.HasError())
+
+		var blocks []models.Connection
+		require.False(t, set.ElementsAs(ctx, &blocks, false).HasError())
+		require.Len(t, blocks, 3)
+		assert.Contains(t, blocks, testConnectionBlock("Webhook", "AI Agent"))
+		assert.Contains(t, blocks, model)
+		assert.Contains(t, blocks, models.Connection{
+			From:       types.StringValue("AI Agent"),
+			FromOutput: types.StringValue("main"),
+			FromIndex:  types.Int64Value(0),
+			To:         types.StringValue("Respond"),
+			ToInput:    types.StringValue("main"),
+			ToIndex:    types.Int64Value(0),
+		})
+	})
+
+	t.Run("keeps unconfigured blocks", func(t *testing.T) {
+		t.Parallel()
+		var diags diag.Diagnostics
+		set := mapConnectionBlocks(ctx, map[string]any{}, types.Set{}, &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, set.IsNull())
+		assert.Equal(t, connectionBlockObjectType, set.ElementType(ctx))
+	})
+}
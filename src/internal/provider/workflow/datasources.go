@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/constants"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -160,38 +161,29 @@ func (d *WorkflowsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		apiReq = apiReq.Active(data.Active.ValueBool())
 	}
 
-	workflowList, httpResp, err := apiReq.Execute()
-	// Check for non-nil value.
-	if httpResp != nil && httpResp.Body != nil {
-		defer httpResp.Body.Close()
-	}
+	// Fetch every page of the filtered list
+	workflows, httpResp, err := client.ListAll(apiReq, d.client.PageSize)
 
 	// Check for error.
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading workflows",
-			fmt.Sprintf("Could not read workflows: %s\nHTTP Response: %v", err.Error(), httpResp),
-		)
+		shared.AddAPIError(&resp.Diagnostics, "Error reading workflows", "Could not read workflows", err, httpResp)
 		// Return result.
 		return
 	}
 
 	// Map response to state
 	data.Workflows = make([]models.Item, 0, constants.DEFAULT_LIST_CAPACITY)
-	// Check for non-nil value.
-	if workflowList.Data != nil {
-		// Iterate over items.
-		for _, workflow := range workflowList.Data {
-			workflowModel := models.Item{
-				ID:   types.StringPointerValue(workflow.Id),
-				Name: types.StringValue(workflow.Name),
-			}
-			// Check for non-nil value.
-			if workflow.Active != nil {
-				workflowModel.Active = types.BoolPointerValue(workflow.Active)
-			}
-			data.Workflows = append(data.Workflows, workflowModel)
+	// Iterate over items.
+	for _, workflow := range workflows {
+		workflowModel := models.Item{
+			ID:   types.StringPointerValue(workflow.Id),
+			Name: types.StringValue(workflow.Name),
+		}
+		// Check for non-nil value.
+		if workflow.Active != nil {
+			workflowModel.Active = types.BoolPointerValue(workflow.Active)
 		}
+		data.Workflows = append(data.Workflows, workflowModel)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
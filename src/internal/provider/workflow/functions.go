@@ -0,0 +1,285 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// functionOptions holds the decoded options argument of a provider function.
+type functionOptions struct {
+	// values are the options indexed by name
+	values map[string]any
+
+	// argument is the position of the options argument, used in errors
+	argument int64
+}
+
+// decodeFunctionOptions decodes the options object of a provider function.
+// A null argument yields no options.
+//
+// Params:
+//   - ctx: context for the conversion
+//   - options: options argument, an object or a map
+//   - argument: position of the argument
+//   - allowed: supported option names
+//
+// Returns:
+//   - *functionOptions: decoded options
+//   - *function.FuncError: error if the argument is not an object or has unsupported options
+func decodeFunctionOptions(ctx context.Context, options types.Dynamic, argument int64, allowed []string) (*functionOptions, *function.FuncError) {
+	decoded := &functionOptions{values: map[string]any{}, argument: argument}
+	// No options.
+	if options.IsNull() || options.IsUnderlyingValueNull() {
+		// Return empty options.
+		return decoded, nil
+	}
+
+	raw, err := options.UnderlyingValue().ToTerraformValue(ctx)
+	// Check for conversion error.
+	if err != nil {
+		// Return error.
+		return nil, function.NewArgumentFuncError(argument, fmt.Sprintf("Invalid options: %s", err.Error()))
+	}
+	value, err := terraformValueToGo(raw)
+	// Check for conversion error.
+	if err != nil {
+		// Return error.
+		return nil, function.NewArgumentFuncError(argument, fmt.Sprintf("Invalid options: %s", err.Error()))
+	}
+	values, ok := value.(map[string]any)
+	// Options must be an object.
+	if !ok {
+		// Return error.
+		return nil, function.NewArgumentFuncError(argument, "Invalid options: expected an object")
+	}
+	// Reject unsupported options.
+	for name := range values {
+		// Check for unsupported option.
+		if !slices.Contains(allowed, name) {
+			// Return error.
+			return nil, function.NewArgumentFuncError(argument, fmt.Sprintf(
+				"Unsupported option %q, expected one of: %s", name, strings.Join(allowed, ", "),
+			))
+		}
+	}
+	decoded.values = values
+
+	// Return decoded options.
+	return decoded, nil
+}
+
+// String returns a string option.
+//
+// Params:
+//   - name: option name
+//   - fallback: value when the option is not set
+//
+// Returns:
+//   - types.String: option value
+//   - *function.FuncError: error if the option is not a string
+func (o *functionOptions) String(name string, fallback types.String) (types.String, *function.FuncError) {
+	value, found := o.values[name]
+	// Use the fallback for missing options.
+	if !found || value == nil {
+		// Return fallback.
+		return fallback, nil
+	}
+	s, ok := value.(string)
+	// Check type.
+	if !ok {
+		// Return error.
+		return fallback, function.NewArgumentFuncError(o.argument, fmt.Sprintf("Option %q must be a string", name))
+	}
+
+	// Return option value.
+	return types.StringValue(s), nil
+}
+
+// Int64 returns a whole number option.
+//
+// Params:
+//   - name: option name
+//   - fallback: value when the option is not set
+//
+// Returns:
+//   - types.Int64: option value
+//   - *function.FuncError: error if the option is not a whole number
+func (o *functionOptions) Int64(name string, fallback int64) (types.Int64, *function.FuncError) {
+	value, found := o.values[name]
+	// Use the fallback for missing options.
+	if !found || value == nil {
+		// Return fallback.
+		return types.Int64Value(fallback), nil
+	}
+	number, ok := value.(json.Number)
+	// Check type.
+	if !ok {
+		// Return error.
+		return types.Int64Value(fallback), function.NewArgumentFuncError(o.argument, fmt.Sprintf("Option %q must be a number", name))
+	}
+	i, err := number.Int64()
+	// Check for whole number.
+	if err != nil {
+		// Return error.
+		return types.Int64Value(fallback), function.NewArgumentFuncError(o.argument, fmt.Sprintf("Option %q must be a whole number", name))
+	}
+
+	// Return option value.
+	return types.Int64Value(i), nil
+}
+
+// Bool returns a boolean option.
+//
+// Params:
+//   - name: option name
+//   - fallback: value when the option is not set
+//
+// Returns:
+//   - types.Bool: option value
+//   - *function.FuncError: error if the option is not a boolean
+func (o *functionOptions) Bool(name string, fallback bool) (types.Bool, *function.FuncError) {
+	value, found := o.values[name]
+	// Use the fallback for missing options.
+	if !found || value == nil {
+		// Return fallback.
+		return types.BoolValue(fallback), nil
+	}
+	b, ok := value.(bool)
+	// Check type.
+	if !ok {
+		// Return error.
+		return types.BoolValue(fallback), function.NewArgumentFuncError(o.argument, fmt.Sprintf("Option %q must be a boolean", name))
+	}
+
+	// Return option value.
+	return types.BoolValue(b), nil
+}
+
+// JSON returns an option given either as a JSON string or as a Terraform object.
+//
+// Params:
+//   - name: option name
+//   - fallback: JSON value when the option is not set
+//
+// Returns:
+//   - types.String: option value as JSON
+//   - *function.FuncError: error if the option cannot be encoded
+func (o *functionOptions) JSON(name, fallback string) (types.String, *function.FuncError) {
+	value, found := o.values[name]
+	// Use the fallback for missing options.
+	if !found || value == nil {
+		// Return fallback.
+		return types.StringValue(fallback), nil
+	}
+	// Strings already hold JSON.
+	if s, ok := value.(string); ok {
+		// Return option value.
+		return types.StringValue(s), nil
+	}
+	encoded, err := json.Marshal(value)
+	// Check for encoding error.
+	if err != nil {
+		// Return error.
+		return types.StringValue(fallback), function.NewArgumentFuncError(o.argument, fmt.Sprintf("Option %q cannot be encoded: %s", name, err.Error()))
+	}
+
+	// Return encoded option.
+	return types.StringValue(string(encoded)), nil
+}
+
+// terraformValueToGo converts a Terraform value to the Go value json.Marshal encodes like jsonencode.
+// Numbers become json.Number to keep their exact decimal form.
+//
+// Params:
+//   - value: known Terraform value
+//
+// Returns:
+//   - any: string, json.Number, bool, []any, map[string]any or nil
+//   - error: error if the value is unknown
+func terraformValueToGo(value tftypes.Value) (any, error) {
+	// Functions only receive known values.
+	if !value.IsKnown() {
+		// Return error.
+		return nil, fmt.Errorf("value is unknown")
+	}
+	// Null values.
+	if value.IsNull() {
+		// Return nil.
+		return nil, nil
+	}
+
+	typ := value.Type()
+	// Convert by type.
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := value.As(&s)
+		// Return string.
+		return s, err
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		err := value.As(&n)
+		// Return exact number.
+		return json.Number(n.Text('f', -1)), err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+		// Return boolean.
+		return b, err
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		// Check for conversion error.
+		if err := value.As(&elems); err != nil {
+			// Return error.
+			return nil, err
+		}
+		list := make([]any, 0, len(elems))
+		// Convert elements.
+		for _, elem := range elems {
+			converted, err := terraformValueToGo(elem)
+			// Check for conversion error.
+			if err != nil {
+				// Return error.
+				return nil, err
+			}
+			list = append(list, converted)
+		}
+		// Return list.
+		return list, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var attrs map[string]tftypes.Value
+		// Check for conversion error.
+		if err := value.As(&attrs); err != nil {
+			// Return error.
+			return nil, err
+		}
+		object := make(map[string]any, len(attrs))
+		// Convert attributes.
+		for name, attr := range attrs {
+			converted, err := terraformValueToGo(attr)
+			// Check for conversion error.
+			if err != nil {
+				// Return error.
+				return nil, err
+			}
+			object[name] = converted
+		}
+		// Return object.
+		return object, nil
+	default:
+		// Return error.
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runFunction runs a provider function and returns its result.
+func runFunction(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	req := function.RunRequest{Arguments: function.NewArgumentsData(args)}
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), req, resp)
+
+	// Return result.
+	return resp.Result.Value(), resp.Error
+}
+
+// dynamicOptions builds a dynamic options argument from string, number and boolean attributes.
+func dynamicOptions(t *testing.T, values map[string]attr.Value) types.Dynamic {
+	t.Helper()
+
+	attrTypes := make(map[string]attr.Type, len(values))
+	for name, value := range values {
+		attrTypes[name] = value.Type(context.Background())
+	}
+
+	// Return options.
+	return types.DynamicValue(types.ObjectValueMust(attrTypes, values))
+}
+
+func TestDecodeFunctionOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		options types.Dynamic
+		want    map[string]any
+		wantErr string
+	}{
+		{
+			name:    "null options",
+			options: types.DynamicNull(),
+			want:    map[string]any{},
+		},
+		{
+			name: "object options",
+			options: dynamicOptions(t, map[string]attr.Value{
+				"notes":        types.StringValue("hello"),
+				"type_version": types.Int64Value(2),
+				"disabled":     types.BoolValue(true),
+			}),
+			want: map[string]any{"notes": "hello", "type_version": json.Number("2"), "disabled": true},
+		},
+		{
+			name: "nested options",
+			options: dynamicOptions(t, map[string]attr.Value{
+				"parameters": types.ObjectValueMust(
+					map[string]attr.Type{"path": types.StringType, "methods": types.TupleType{ElemTypes: []attr.Type{types.StringType}}},
+					map[string]attr.Value{
+						"path":    types.StringValue("hook"),
+						"methods": types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("GET")}),
+					},
+				),
+			}),
+			want: map[string]any{"parameters": map[string]any{"path": "hook", "methods": []any{"GET"}}},
+		},
+		{
+			name:    "error case - options are not an object",
+			options: types.DynamicValue(types.StringValue("main")),
+			wantErr: "Invalid options: expected an object",
+		},
+		{
+			name:    "error case - unsupported option",
+			options: dynamicOptions(t, map[string]attr.Value{"color": types.StringValue("red")}),
+			wantErr: `Unsupported option "color", expected one of: notes, disabled, type_version, parameters`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, funcErr := decodeFunctionOptions(context.Background(), tt.options, 2, []string{"notes", "disabled", "type_version", "parameters"})
+
+			if tt.wantErr != "" {
+				require.NotNil(t, funcErr)
+				assert.Equal(t, tt.wantErr, funcErr.Text)
+				require.NotNil(t, funcErr.FunctionArgument)
+				assert.Equal(t, int64(2), *funcErr.FunctionArgument)
+				return
+			}
+			require.Nil(t, funcErr)
+			assert.Equal(t, tt.want, opts.values)
+		})
+	}
+}
+
+func TestFunctionOptions_Getters(t *testing.T) {
+	t.Parallel()
+
+	opts := &functionOptions{
+		values: map[string]any{
+			"text":     "value",
+			"count":    json.Number("3"),
+			"fraction": json.Number("1.5"),
+			"flag":     true,
+			"object":   map[string]any{"b": json.Number("2"), "a": "x"},
+		},
+		argument: 1,
+	}
+
+	t.Run("values are returned", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := opts.String("text", types.StringNull())
+		assert.Nil(t, err)
+		assert.Equal(t, types.StringValue("value"), text)
+
+		count, err := opts.Int64("count", 1)
+		assert.Nil(t, err)
+		assert.Equal(t, types.Int64Value(3), count)
+
+		flag, err := opts.Bool("flag", false)
+		assert.Nil(t, err)
+		assert.Equal(t, types.BoolValue(true), flag)
+
+		object, err := opts.JSON("object", "{}")
+		assert.Nil(t, err)
+		assert.Equal(t, types.StringValue(`{"a":"x","b":2}`), object)
+
+		raw, err := opts.JSON("text", "{}")
+		assert.Nil(t, err)
+		assert.Equal(t, types.StringValue("value"), raw)
+	})
+
+	t.Run("fallbacks are used for missing options", func(t *testing.T) {
+		t.Parallel()
+
+		text, _ := opts.String("missing", types.StringValue("main"))
+		count, _ := opts.Int64("missing", 1)
+		flag, _ := opts.Bool("missing", false)
+		object, _ := opts.JSON("missing", "{}")
+
+		assert.Equal(t, types.StringValue("main"), text)
+		assert.Equal(t, types.Int64Value(1), count)
+		assert.Equal(t, types.BoolValue(false), flag)
+		assert.Equal(t, types.StringValue("{}"), object)
+	})
+
+	t.Run("error case - wrong types", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := opts.String("count", types.StringNull())
+		assert.Equal(t, `Option "count" must be a string`, err.Text)
+
+		_, err = opts.Int64("text", 1)
+		assert.Equal(t, `Option "text" must be a number`, err.Text)
+
+		_, err = opts.Int64("fraction", 1)
+		assert.Equal(t, `Option "fraction" must be a whole number`, err.Text)
+
+		_, err = opts.Bool("text", false)
+		assert.Equal(t, `Option "text" must be a boolean`, err.Text)
+	})
+}
@@ -0,0 +1,648 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/nodecatalog"
+)
+
+// Node types with a special role in the workflow graph.
+const (
+	// STICKY_NOTE_NODE_TYPE is the type of the canvas notes, which are never connected.
+	STICKY_NOTE_NODE_TYPE string = "n8n-nodes-base.stickyNote"
+	// START_NODE_TYPE is the type of the legacy start node.
+	START_NODE_TYPE string = "n8n-nodes-base.start"
+	// TRIGGER_NODE_SUFFIX ends the names of most n8n trigger node types.
+	TRIGGER_NODE_SUFFIX string = "Trigger"
+)
+
+// manualTriggerNodeTypes are the trigger node types that start a workflow without activating it.
+var manualTriggerNodeTypes []string = []string{
+	START_NODE_TYPE,
+	"n8n-nodes-base.manualTrigger",
+	"n8n-nodes-base.executeWorkflowTrigger",
+	"n8n-nodes-base.errorTrigger",
+	"@n8n/n8n-nodes-langchain.manualChatTrigger",
+}
+
+// graphNode is a workflow node, as needed to validate the workflow graph and the node types.
+type graphNode struct {
+	// name is the node name, used by the connections.
+	name string
+	// id is the node identifier, empty when generated by n8n.
+	id string
+	// nodeType is the n8n node type.
+	nodeType string
+	// typeVersion is the node type version, zero when not known yet.
+	typeVersion float64
+	// parameters are the parameter names, nil when not known yet.
+	parameters []string
+	// disabled is set when the node is disabled.
+	disabled bool
+	// namePath is the path of the node name in the configuration, the node itself when nodes are keyed by name.
+	namePath path.Path
+	// idPath is the path of the node identifier in the configuration.
+	idPath path.Path
+	// typePath is the path of the node type in the configuration.
+	typePath path.Path
+	// typeVersionPath is the path of the node type version in the configuration.
+	typeVersionPath path.Path
+	// parametersPath is the path of the node parameters in the configuration.
+	parametersPath path.Path
+}
+
+// graphConnection is a workflow connection, as needed to validate the workflow graph.
+type graphConnection struct {
+	// edge is the connection.
+	edge connectionEdge
+	// path is the path of the connection in the configuration.
+	path path.Path
+}
+
+// workflowGraph holds the nodes and connections of a workflow.
+type workflowGraph struct {
+	// nodes are the workflow nodes, in configuration order.
+	nodes []graphNode
+	// connections are the workflow connections.
+	connections []graphConnection
+}
+
+// triggerStatus tells whether a node type starts workflow executions.
+type triggerStatus int
+
+// Trigger statuses of the node types.
+const (
+	// TRIGGER_STATUS_NONE is the status of the node types that do not start executions.
+	TRIGGER_STATUS_NONE triggerStatus = iota
+	// TRIGGER_STATUS_MANUAL is the status of the triggers that start executions without activation.
+	TRIGGER_STATUS_MANUAL
+	// TRIGGER_STATUS_ACTIVATION is the status of the trigger, webhook and poller nodes that allow activation.
+	TRIGGER_STATUS_ACTIVATION
+	// TRIGGER_STATUS_UNKNOWN is the status of the node types missing from the node catalog or allowlisted.
+	TRIGGER_STATUS_UNKNOWN
+)
+
+// nodeTriggerStatus returns whether a node type starts workflow executions.
+// The node catalog records the n8n trigger nodes, including the pollers and the webhook.
+// Node types it does not know, or that the provider allowlist skips, have an unknown status,
+// like the "*Trigger" types it lists without the trigger flag.
+//
+// Params:
+//   - catalog: The node catalog
+//   - nodeType: The n8n node type
+//   - allowlist: The node types and packages skipped by the node catalog validation
+//
+// Returns:
+//   - triggerStatus: The trigger status of the node type
+func nodeTriggerStatus(catalog *nodecatalog.Catalog, nodeType string, allowlist []string) triggerStatus {
+	// Check for a manual trigger, including the legacy start node n8n does not group with the triggers.
+	if slices.Contains(manualTriggerNodeTypes, nodeType) {
+		// Return manual trigger.
+		return TRIGGER_STATUS_MANUAL
+	}
+	node, ok := catalog.Lookup(nodeType)
+	// Check for a node type unknown to the catalog.
+	if !ok || nodecatalog.Allowlisted(nodeType, allowlist) {
+		// Return unknown status.
+		return TRIGGER_STATUS_UNKNOWN
+	}
+	// Check for a trigger.
+	if node.Trigger {
+		// Return activation trigger.
+		return TRIGGER_STATUS_ACTIVATION
+	}
+	// The catalog may not flag every trigger until it is generated from the n8n sources.
+	if strings.HasSuffix(nodeType, TRIGGER_NODE_SUFFIX) {
+		// Return unknown status.
+		return TRIGGER_STATUS_UNKNOWN
+	}
+	// Return no trigger.
+	return TRIGGER_STATUS_NONE
+}
+
+// workflowGraphFromModel reads the workflow graph from a configuration or a plan.
+// The nodes attribute and connection blocks take precedence over nodes_json and connections_json, like on apply.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - model: The resource configuration or plan
+//
+// Returns:
+//   - *workflowGraph: The workflow graph
+//   - bool: False if the graph is not known yet or cannot be parsed
+func workflowGraphFromModel(ctx context.Context, model *models.Resource) (*workflowGraph, bool) {
+	var graph workflowGraph
+	var known bool
+
+	// Check for the nodes attribute.
+	switch {
+	case model.Nodes.IsUnknown():
+		known = false
+	case hasNodes(model.Nodes):
+		graph.nodes, known = graphNodesFromAttribute(ctx, model.Nodes)
+	default:
+		graph.nodes, known = graphNodesFromJSON(model.NodesJSON)
+	}
+	// Check for unknown nodes.
+	if !known {
+		// Return unknown graph.
+		return nil, false
+	}
+
+	// Check for connection blocks.
+	switch {
+	case model.Connections.IsUnknown():
+		known = false
+	case hasConnectionBlocks(model.Connections):
+		graph.connections, known = graphConnectionsFromBlocks(ctx, model.Connections)
+	default:
+		graph.connections, known = graphConnectionsFromJSON(model.ConnectionsJSON)
+	}
+	// Check for unknown connections.
+	if !known {
+		// Return unknown graph.
+		return nil, false
+	}
+
+	// Return graph.
+	return &graph, true
+}
+
+// graphNodesFromAttribute reads the graph nodes from the nodes attribute.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - nodes: The nodes attribute
+//
+// Returns:
+//   - []graphNode: The graph nodes, sorted by name
+//   - bool: False if a node type is not known yet
+func graphNodesFromAttribute(ctx context.Context, nodes types.Map) ([]graphNode, bool) {
+	var diags diag.Diagnostics
+	byName, names := nodeModels(ctx, nodes, &diags)
+	// Check for conversion errors.
+	if diags.HasError() {
+		// Return unknown nodes.
+		return nil, false
+	}
+
+	result := make([]graphNode, 0, len(names))
+	// Read each node.
+	for _, name := range names {
+		value := byName[name]
+		// Check for unknown values.
+		if value.Type.IsUnknown() || value.Disabled.IsUnknown() {
+			// Return unknown nodes.
+			return nil, false
+		}
+		node := graphNode{
+			name:            name,
+			id:              value.ID.ValueString(),
+			nodeType:        value.Type.ValueString(),
+			disabled:        value.Disabled.ValueBool(),
+			namePath:        nodePath(name),
+			idPath:          nodePath(name).AtName("id"),
+			typePath:        nodePath(name).AtName("type"),
+			typeVersionPath: nodePath(name).AtName("type_version"),
+			parametersPath:  nodePath(name).AtName("parameters"),
+		}
+		// Read the type version, applied with its default when unset.
+		switch {
+		case value.TypeVersion.IsNull():
+			node.typeVersion = float64(DEFAULT_TYPE_VERSION)
+		case !value.TypeVersion.IsUnknown():
+			node.typeVersion = value.TypeVersion.ValueFloat64()
+		}
+		// Read the parameter names when known.
+		if !value.Parameters.IsUnknown() {
+			node.parameters = parameterNames(value.Parameters.ValueString())
+		}
+		result = append(result, node)
+	}
+
+	// Return nodes.
+	return result, true
+}
+
+// graphNodesFromJSON reads the graph nodes from nodes_json.
+//
+// Params:
+//   - nodesJSON: The nodes JSON value
+//
+// Returns:
+//   - []graphNode: The graph nodes
+//   - bool: False if the value is not known yet or is not valid JSON
+func graphNodesFromJSON(nodesJSON jsontypes.Value) ([]graphNode, bool) {
+	// Check for unknown value.
+	if nodesJSON.IsUnknown() {
+		// Return unknown nodes.
+		return nil, false
+	}
+	// Check for unset nodes.
+	if nodesJSON.IsNull() {
+		// Return no nodes.
+		return []graphNode{}, true
+	}
+
+	var sdkNodes []n8nsdk.Node
+	// Invalid JSON is reported on apply.
+	if err := json.Unmarshal([]byte(nodesJSON.ValueString()), &sdkNodes); err != nil {
+		// Return unknown nodes.
+		return nil, false
+	}
+
+	nodes := make([]graphNode, 0, len(sdkNodes))
+	// Read each node.
+	for _, node := range sdkNodes {
+		entry := graphNode{
+			name:            node.GetName(),
+			id:              node.GetId(),
+			nodeType:        node.GetType(),
+			parameters:      slices.Collect(maps.Keys(node.Parameters)),
+			disabled:        node.GetDisabled(),
+			namePath:        path.Root("nodes_json"),
+			idPath:          path.Root("nodes_json"),
+			typePath:        path.Root("nodes_json"),
+			typeVersionPath: path.Root("nodes_json"),
+			parametersPath:  path.Root("nodes_json"),
+		}
+		// Read the type version, required by n8n.
+		if node.TypeVersion != nil {
+			entry.typeVersion = float32Value(*node.TypeVersion)
+		}
+		nodes = append(nodes, entry)
+	}
+
+	// Return nodes.
+	return nodes, true
+}
+
+// graphConnectionsFromBlocks reads the graph connections from connection blocks.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - blocks: The connection blocks
+//
+// Returns:
+//   - []graphConnection: The graph connections
+//   - bool: False if a connection endpoint is not known yet
+func graphConnectionsFromBlocks(ctx context.Context, blocks types.Set) ([]graphConnection, bool) {
+	connections := make([]graphConnection, 0, len(blocks.Elements()))
+	// Read each connection.
+	for _, element := range blocks.Elements() {
+		object, ok := element.(types.Object)
+		// Check for unexpected element.
+		if !ok {
+			// Return unknown connections.
+			return nil, false
+		}
+		var block models.Connection
+		// Check for conversion errors.
+		if object.As(ctx, &block, basetypes.ObjectAsOptions{}).HasError() {
+			// Return unknown connections.
+			return nil, false
+		}
+		// Check for unknown values.
+		if block.From.IsUnknown() || block.To.IsUnknown() || block.FromOutput.IsUnknown() || block.ToInput.IsUnknown() {
+			// Return unknown connections.
+			return nil, false
+		}
+		connections = append(connections, graphConnection{
+			edge: connectionEdgeFromBlock(&block),
+			path: path.Root("node_connection").AtSetValue(element),
+		})
+	}
+
+	// Return connections.
+	return connections, true
+}
+
+// graphConnectionsFromJSON reads the graph connections from connections_json.
+//
+// Params:
+//   - connectionsJSON: The connections JSON value
+//
+// Returns:
+//   - []graphConnection: The graph connections
+//   - bool: False if the value is not known yet or is not valid JSON
+func graphConnectionsFromJSON(connectionsJSON jsontypes.Value) ([]graphConnection, bool) {
+	// Check for unknown value.
+	if connectionsJSON.IsUnknown() {
+		// Return unknown connections.
+		return nil, false
+	}
+	// Check for unset connections.
+	if connectionsJSON.IsNull() {
+		// Return no connections.
+		return []graphConnection{}, true
+	}
+
+	var raw map[string]any
+	// Invalid JSON is reported on apply.
+	if err := json.Unmarshal([]byte(connectionsJSON.ValueString()), &raw); err != nil {
+		// Return unknown connections.
+		return nil, false
+	}
+	var parseDiags diag.Diagnostics
+	edges := connectionEdgesFromWorkflow(raw, &parseDiags)
+	// Invalid connections are reported on apply.
+	if parseDiags.HasError() {
+		// Return unknown connections.
+		return nil, false
+	}
+
+	connections := make([]graphConnection, 0, len(edges))
+	// Read each connection.
+	for _, edge := range edges {
+		connections = append(connections, graphConnection{edge: edge, path: path.Root("connections_json")})
+	}
+
+	// Return connections.
+	return connections, true
+}
+
+// validateWorkflowGraph reports the errors of a workflow graph that n8n would only reject on apply,
+// or that would break the workflow executions.
+//
+// Params:
+//   - graph: The workflow graph
+//   - diags: Diagnostics for error reporting
+func validateWorkflowGraph(graph *workflowGraph, diags *diag.Diagnostics) {
+	validateGraphNodes(graph, diags)
+	validateGraphConnections(graph, diags)
+	validateGraphCycles(graph, diags)
+}
+
+// validateGraphTriggers reports the nodes that never execute and the active workflows that cannot
+// be activated. The trigger nodes are read from the node catalog, so the nodes the catalog does not
+// know, or that the provider allowlist skips, only lead to warnings.
+//
+// Params:
+//   - graph: The workflow graph
+//   - catalog: The node catalog
+//   - allowlist: The node types and packages skipped by the node catalog validation
+//   - active: The configured activation
+//   - diags: Diagnostics for error reporting
+func validateGraphTriggers(graph *workflowGraph, catalog *nodecatalog.Catalog, allowlist []string, active types.Bool, diags *diag.Diagnostics) {
+	statuses := make(map[string]triggerStatus, len(graph.nodes))
+	// Read the trigger status of each node type.
+	for _, node := range graph.nodes {
+		statuses[node.nodeType] = nodeTriggerStatus(catalog, node.nodeType, allowlist)
+	}
+	validateGraphOrphans(graph, statuses, diags)
+	// Check for activation.
+	if active.ValueBool() {
+		validateGraphActivation(graph, statuses, diags)
+	}
+}
+
+// validateGraphNodes reports duplicate node names and identifiers.
+//
+// Params:
+//   - graph: The workflow graph
+//   - diags: Diagnostics for error reporting
+func validateGraphNodes(graph *workflowGraph, diags *diag.Diagnostics) {
+	names := make(map[string]bool, len(graph.nodes))
+	ids := make(map[string]bool, len(graph.nodes))
+	// Check each node.
+	for _, node := range graph.nodes {
+		// Check for duplicate name.
+		if names[node.name] {
+			diags.AddAttributeError(
+				node.namePath,
+				"Duplicate Workflow Node Name",
+				fmt.Sprintf("Node name %q is used by several nodes. n8n identifies nodes by name in connections.", node.name),
+			)
+		}
+		names[node.name] = true
+		// Skip identifiers generated by n8n.
+		if node.id == "" {
+			continue
+		}
+		// Check for duplicate identifier.
+		if ids[node.id] {
+			diags.AddAttributeError(
+				node.idPath,
+				"Duplicate Workflow Node ID",
+				fmt.Sprintf("Node ID %q of node %q is used by several nodes.", node.id, node.name),
+			)
+		}
+		ids[node.id] = true
+	}
+}
+
+// validateGraphConnections reports connections whose source or target node is not defined.
+//
+// Params:
+//   - graph: The workflow graph
+//   - diags: Diagnostics for error reporting
+func validateGraphConnections(graph *workflowGraph, diags *diag.Diagnostics) {
+	names := make(map[string]bool, len(graph.nodes))
+	// Index node names.
+	for _, node := range graph.nodes {
+		names[node.name] = true
+	}
+	// Check each connection.
+	for _, connection := range graph.connections {
+		// Check each endpoint.
+		for _, endpoint := range []string{connection.edge.SourceNode, connection.edge.TargetNode} {
+			// Check for dangling endpoint.
+			if !names[endpoint] {
+				diags.AddAttributeError(
+					connection.path,
+					"Invalid Workflow Connection",
+					fmt.Sprintf("Connection from %q to %q refers to node %q, which is not defined in the workflow.",
+						connection.edge.SourceNode, connection.edge.TargetNode, endpoint),
+				)
+			}
+		}
+	}
+}
+
+// validateGraphOrphans warns about non-trigger nodes without any connection, which never execute.
+// Nodes whose trigger status is unknown are skipped.
+//
+// Params:
+//   - graph: The workflow graph
+//   - statuses: The trigger status of each node type
+//   - diags: Diagnostics for error reporting
+func validateGraphOrphans(graph *workflowGraph, statuses map[string]triggerStatus, diags *diag.Diagnostics) {
+	connected := make(map[string]bool, len(graph.nodes))
+	// Index connected nodes.
+	for _, connection := range graph.connections {
+		connected[connection.edge.SourceNode] = true
+		connected[connection.edge.TargetNode] = true
+	}
+	// Check each node.
+	for _, node := range graph.nodes {
+		// Skip connected nodes, note nodes and nodes that may be triggers.
+		if connected[node.name] || statuses[node.nodeType] != TRIGGER_STATUS_NONE || node.nodeType == STICKY_NOTE_NODE_TYPE {
+			continue
+		}
+		diags.AddAttributeWarning(
+			node.namePath,
+			"Orphaned Workflow Node",
+			fmt.Sprintf("Node %q is not a trigger and has no connection, so it never executes.", node.name),
+		)
+	}
+}
+
+// validateGraphCycles reports cycles of AI connections. n8n allows loops of main connections,
+// but a sub-node (language model, tool, memory, output parser) cannot depend on itself.
+//
+// Params:
+//   - graph: The workflow graph
+//   - diags: Diagnostics for error reporting
+func validateGraphCycles(graph *workflowGraph, diags *diag.Diagnostics) {
+	next := make(map[string][]string, len(graph.nodes))
+	// Index AI connections.
+	for _, connection := range graph.connections {
+		// Skip main connections.
+		if connection.edge.SourceOutput == DEFAULT_OUTPUT_TYPE {
+			continue
+		}
+		next[connection.edge.SourceNode] = append(next[connection.edge.SourceNode], connection.edge.TargetNode)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(graph.nodes))
+	var inCycle func(name string) bool
+	inCycle = func(name string) bool {
+		state[name] = visiting
+		// Visit each target.
+		for _, target := range next[name] {
+			// Check for a path back to a visiting node.
+			if state[target] == visiting || (state[target] == unvisited && inCycle(target)) {
+				// Return cycle found.
+				return true
+			}
+		}
+		state[name] = visited
+		// Return no cycle.
+		return false
+	}
+
+	// Check each node, in configuration order.
+	for _, node := range graph.nodes {
+		// Check for cycle.
+		if state[node.name] == unvisited && inCycle(node.name) {
+			diags.AddAttributeError(
+				node.namePath,
+				"Workflow Connection Cycle",
+				fmt.Sprintf("Node %q is part of a cycle of AI connections. n8n only allows loops of main connections.", node.name),
+			)
+			// Report the first cycle only, the state of the other nodes is partial.
+			return
+		}
+	}
+}
+
+// validateGraphActivation reports active workflows without any node able to start them.
+// When no known trigger is found but a node type has an unknown trigger status, it only warns.
+//
+// Params:
+//   - graph: The workflow graph
+//   - statuses: The trigger status of each node type
+//   - diags: Diagnostics for error reporting
+func validateGraphActivation(graph *workflowGraph, statuses map[string]triggerStatus, diags *diag.Diagnostics) {
+	var unknown []string
+	// Check each node.
+	for _, node := range graph.nodes {
+		// Skip disabled nodes.
+		if node.disabled {
+			continue
+		}
+		// Check for an enabled trigger.
+		switch statuses[node.nodeType] {
+		case TRIGGER_STATUS_ACTIVATION:
+			// Return valid activation.
+			return
+		case TRIGGER_STATUS_UNKNOWN:
+			// Record each node type once.
+			if !slices.Contains(unknown, node.nodeType) {
+				unknown = append(unknown, node.nodeType)
+			}
+		}
+	}
+	// Check for node types that may be triggers.
+	if len(unknown) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("active"),
+			"Workflow May Not Be Activated",
+			fmt.Sprintf("An active workflow needs at least one enabled trigger, webhook or poller node. "+
+				"The node catalog does not tell whether these node types are triggers: %s. "+
+				"n8n rejects the activation on apply if none of them is.", strings.Join(unknown, ", ")),
+		)
+		// Return early.
+		return
+	}
+	diags.AddAttributeError(
+		path.Root("active"),
+		"Workflow Cannot Be Activated",
+		"An active workflow needs at least one enabled trigger, webhook or poller node "+
+			"(e.g., n8n-nodes-base.webhook or n8n-nodes-base.scheduleTrigger). Manual triggers cannot activate a workflow.",
+	)
+}
+
+// modifyPlanWorkflowGraph checks the workflow nodes and triggers of the plan against the node catalog,
+// which depends on the provider community_node_allowlist, and validates the workflow graph of the plan
+// when it was not known yet when the configuration was validated, e.g., when nodes_json
+// references other resources.
+//
+// Params:
+//   - ctx: Context for the operation
+//   - req: ModifyPlan request containing config, state and plan
+//   - resp: ModifyPlan response collecting diagnostics
+func (r *WorkflowResource) modifyPlanWorkflowGraph(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy.
+	if req.Plan.Raw.IsNull() {
+		// Return early.
+		return
+	}
+
+	var config, plan models.Resource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	// Check for error.
+	if resp.Diagnostics.HasError() {
+		// Return early.
+		return
+	}
+
+	graph, known := workflowGraphFromModel(ctx, &plan)
+	// Check for an unknown graph.
+	if !known {
+		// Return early.
+		return
+	}
+	catalog := nodecatalog.Default()
+	allowlist := communityNodeAllowlist(r.client)
+	validateNodeCatalog(catalog, graph.nodes, allowlist, &resp.Diagnostics)
+	validateGraphTriggers(graph, catalog, allowlist, config.Active, &resp.Diagnostics)
+
+	// Check whether the graph was already validated with the configuration.
+	if _, configKnown := workflowGraphFromModel(ctx, &config); !configKnown {
+		validateWorkflowGraph(graph, &resp.Diagnostics)
+	}
+}
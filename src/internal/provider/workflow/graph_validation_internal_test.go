@@ -0,0 +1,406 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/nodecatalog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testGraphModel returns a configuration with nodes_json and connections_json.
+func testGraphModel(nodesJSON, connectionsJSON string) *models.Resource {
+	return &models.Resource{
+		Nodes:           types.MapNull(nodeObjectType),
+		Connections:     types.SetNull(connectionBlockObjectType),
+		NodesJSON:       jsontypes.NewNodesValue(nodesJSON),
+		ConnectionsJSON: jsontypes.NewConnectionsValue(connectionsJSON),
+	}
+}
+
+func Test_nodeTriggerStatus(t *testing.T) {
+	t.Parallel()
+
+	seed, err := nodecatalog.Parse([]byte(`{"n8n_version":"1.0.0","nodes":{"n8n-nodes-base.acmeTrigger":{}}}`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		nodeType  string
+		catalog   *nodecatalog.Catalog
+		allowlist []string
+		want      triggerStatus
+	}{
+		{nodeType: "n8n-nodes-base.webhook", want: TRIGGER_STATUS_ACTIVATION},
+		{nodeType: "n8n-nodes-base.scheduleTrigger", want: TRIGGER_STATUS_ACTIVATION},
+		{nodeType: "@n8n/n8n-nodes-langchain.chatTrigger", want: TRIGGER_STATUS_ACTIVATION},
+		{nodeType: "n8n-nodes-base.cron", want: TRIGGER_STATUS_ACTIVATION},
+		{nodeType: "n8n-nodes-base.emailReadImap", want: TRIGGER_STATUS_ACTIVATION},
+		{nodeType: "n8n-nodes-base.manualTrigger", want: TRIGGER_STATUS_MANUAL},
+		{nodeType: "n8n-nodes-base.executeWorkflowTrigger", want: TRIGGER_STATUS_MANUAL},
+		{nodeType: "n8n-nodes-base.start", want: TRIGGER_STATUS_MANUAL},
+		{nodeType: "n8n-nodes-base.set", want: TRIGGER_STATUS_NONE},
+		{nodeType: "n8n-nodes-base.respondToWebhook", want: TRIGGER_STATUS_NONE},
+		{nodeType: "n8n-nodes-base.slackTool", want: TRIGGER_STATUS_NONE},
+		{nodeType: "n8n-nodes-acme.invoice", want: TRIGGER_STATUS_UNKNOWN},
+		{nodeType: "n8n-nodes-base.set", allowlist: []string{"n8n-nodes-base.set"}, want: TRIGGER_STATUS_UNKNOWN},
+		{nodeType: "n8n-nodes-base.acmeTrigger", catalog: seed, want: TRIGGER_STATUS_UNKNOWN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.nodeType, func(t *testing.T) {
+			t.Parallel()
+			catalog := tt.catalog
+			// Use the embedded catalog by default.
+			if catalog == nil {
+				catalog = nodecatalog.Default()
+			}
+			assert.Equal(t, tt.want, nodeTriggerStatus(catalog, tt.nodeType, tt.allowlist))
+		})
+	}
+}
+
+func Test_workflowGraphFromModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("reads JSON attributes", func(t *testing.T) {
+		t.Parallel()
+		graph, known := workflowGraphFromModel(ctx, testGraphModel(
+			`[{"id":"n1","name":"Webhook","type":"n8n-nodes-base.webhook","typeVersion":2.1,"parameters":{"path":"hook"}},{"name":"Set","type":"n8n-nodes-base.set","disabled":true}]`,
+			`{"Webhook":{"main":[[{"node":"Set","type":"main","index":0}]]}}`,
+		))
+		require.True(t, known)
+		require.Len(t, graph.nodes, 2)
+		assert.Equal(t, "n1", graph.nodes[0].id)
+		assert.Equal(t, 2.1, graph.nodes[0].typeVersion)
+		assert.Equal(t, []string{"path"}, graph.nodes[0].parameters)
+		assert.True(t, graph.nodes[1].disabled)
+		assert.Zero(t, graph.nodes[1].typeVersion)
+		require.Len(t, graph.connections, 1)
+		assert.Equal(t, "Set", graph.connections[0].edge.TargetNode)
+	})
+
+	t.Run("reads the nodes attribute and connection blocks with their paths", func(t *testing.T) {
+		t.Parallel()
+		model := &models.Resource{
+			Nodes:           testNodes(t, map[string]models.Node{"Webhook": testNode(t)}),
+			Connections:     testConnectionBlocks(t, testConnectionBlock("Webhook", "Set")),
+			NodesJSON:       jsontypes.NewUnknown(jsontypes.KIND_NODES),
+			ConnectionsJSON: jsontypes.NewUnknown(jsontypes.KIND_CONNECTIONS),
+		}
+		graph, known := workflowGraphFromModel(ctx, model)
+		require.True(t, known)
+		require.Len(t, graph.nodes, 1)
+		assert.Equal(t, "Webhook", graph.nodes[0].name)
+		assert.Equal(t, path.Root("nodes").AtMapKey("Webhook"), graph.nodes[0].namePath)
+		assert.Equal(t, path.Root("nodes").AtMapKey("Webhook").AtName("type_version"), graph.nodes[0].typeVersionPath)
+		assert.Equal(t, float64(DEFAULT_TYPE_VERSION), graph.nodes[0].typeVersion)
+		require.Len(t, graph.connections, 1)
+		assert.Equal(t, DEFAULT_OUTPUT_TYPE, graph.connections[0].edge.SourceOutput)
+	})
+
+	t.Run("unset attributes are empty", func(t *testing.T) {
+		t.Parallel()
+		model := &models.Resource{
+			Nodes:           types.MapNull(nodeObjectType),
+			Connections:     types.SetNull(connectionBlockObjectType),
+			NodesJSON:       jsontypes.NewNull(jsontypes.KIND_NODES),
+			ConnectionsJSON: jsontypes.NewNull(jsontypes.KIND_CONNECTIONS),
+		}
+		graph, known := workflowGraphFromModel(ctx, model)
+		require.True(t, known)
+		assert.Empty(t, graph.nodes)
+		assert.Empty(t, graph.connections)
+	})
+
+	t.Run("error case - unknown nodes", func(t *testing.T) {
+		t.Parallel()
+		model := testGraphModel("[]", "{}")
+		model.NodesJSON = jsontypes.NewUnknown(jsontypes.KIND_NODES)
+		_, known := workflowGraphFromModel(ctx, model)
+		assert.False(t, known)
+	})
+
+	t.Run("error case - unknown node type", func(t *testing.T) {
+		t.Parallel()
+		node := testNode(t)
+		node.Type = types.StringUnknown()
+		model := testGraphModel("[]", "{}")
+		model.Nodes = testNodes(t, map[string]models.Node{"Webhook": node})
+		_, known := workflowGraphFromModel(ctx, model)
+		assert.False(t, known)
+	})
+
+	t.Run("error case - invalid connections JSON", func(t *testing.T) {
+		t.Parallel()
+		_, known := workflowGraphFromModel(ctx, testGraphModel("[]", "{"))
+		assert.False(t, known)
+	})
+}
+
+func Test_validateWorkflowGraph(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		nodes       string
+		connections string
+		wantErr     bool
+		wantSummary string
+	}{
+		{
+			name:        "valid workflow",
+			nodes:       `[{"name":"Webhook","type":"n8n-nodes-base.webhook"},{"name":"Set","type":"n8n-nodes-base.set"}]`,
+			connections: `{"Webhook":{"main":[[{"node":"Set","type":"main","index":0}]]}}`,
+		},
+		{
+			name: "loops of main connections are allowed",
+			nodes: `[{"name":"Trigger","type":"n8n-nodes-base.manualTrigger"},` +
+				`{"name":"Loop","type":"n8n-nodes-base.splitInBatches"},{"name":"Set","type":"n8n-nodes-base.set"}]`,
+			connections: `{"Trigger":{"main":[[{"node":"Loop","type":"main","index":0}]]},` +
+				`"Loop":{"main":[[],[{"node":"Set","type":"main","index":0}]]},` +
+				`"Set":{"main":[[{"node":"Loop","type":"main","index":0}]]}}`,
+		},
+		{
+			name: "AI sub-nodes are connected",
+			nodes: `[{"name":"Chat","type":"@n8n/n8n-nodes-langchain.chatTrigger"},{"name":"Agent","type":"@n8n/n8n-nodes-langchain.agent"},` +
+				`{"name":"Model","type":"@n8n/n8n-nodes-langchain.lmChatOpenAi"},{"name":"Note","type":"n8n-nodes-base.stickyNote"}]`,
+			connections: `{"Chat":{"main":[[{"node":"Agent","type":"main","index":0}]]},` +
+				`"Model":{"ai_languageModel":[[{"node":"Agent","type":"ai_languageModel","index":0}]]}}`,
+		},
+		{
+			name:        "error case - dangling connection",
+			nodes:       `[{"name":"Webhook","type":"n8n-nodes-base.webhook"},{"name":"Set","type":"n8n-nodes-base.set"}]`,
+			connections: `{"Webhook":{"main":[[{"node":"Set","type":"main","index":0},{"node":"Sett","type":"main","index":0}]]}}`,
+			wantErr:     true,
+			wantSummary: "Invalid Workflow Connection",
+		},
+		{
+			name:        "error case - duplicate node names",
+			nodes:       `[{"name":"Webhook","type":"n8n-nodes-base.webhook"},{"name":"Webhook","type":"n8n-nodes-base.webhook"}]`,
+			connections: `{}`,
+			wantErr:     true,
+			wantSummary: "Duplicate Workflow Node Name",
+		},
+		{
+			name:        "error case - duplicate node IDs",
+			nodes:       `[{"id":"n1","name":"Webhook","type":"n8n-nodes-base.webhook"},{"id":"n1","name":"Other","type":"n8n-nodes-base.webhook"}]`,
+			connections: `{}`,
+			wantErr:     true,
+			wantSummary: "Duplicate Workflow Node ID",
+		},
+		{
+			name:  "error case - cycle of AI connections",
+			nodes: `[{"name":"Agent","type":"@n8n/n8n-nodes-langchain.agent"},{"name":"Tool","type":"@n8n/n8n-nodes-langchain.agentTool"}]`,
+			connections: `{"Tool":{"ai_tool":[[{"node":"Agent","type":"ai_tool","index":0}]]},` +
+				`"Agent":{"ai_tool":[[{"node":"Tool","type":"ai_tool","index":0}]]}}`,
+			wantErr:     true,
+			wantSummary: "Workflow Connection Cycle",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			graph, known := workflowGraphFromModel(context.Background(), testGraphModel(tt.nodes, tt.connections))
+			require.True(t, known)
+
+			var diags diag.Diagnostics
+			validateWorkflowGraph(graph, &diags)
+			assert.Equal(t, tt.wantErr, diags.HasError())
+			assert.Zero(t, diags.WarningsCount())
+			if tt.wantSummary != "" {
+				require.NotEmpty(t, diags.Errors())
+				assert.Equal(t, tt.wantSummary, diags.Errors()[0].Summary())
+			}
+		})
+	}
+}
+
+func Test_validateGraphTriggers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		nodes        string
+		connections  string
+		allowlist    []string
+		active       types.Bool
+		wantErr      bool
+		wantWarnings int
+		wantSummary  string
+	}{
+		{
+			name:        "webhook activates the workflow",
+			nodes:       `[{"name":"Webhook","type":"n8n-nodes-base.webhook"},{"name":"Set","type":"n8n-nodes-base.set"}]`,
+			connections: `{"Webhook":{"main":[[{"node":"Set","type":"main","index":0}]]}}`,
+			active:      types.BoolValue(true),
+		},
+		{
+			name:        "trigger without Trigger suffix activates the workflow",
+			nodes:       `[{"name":"IMAP","type":"n8n-nodes-base.emailReadImap"}]`,
+			connections: `{}`,
+			active:      types.BoolValue(true),
+		},
+		{
+			name:        "inactive workflow without trigger",
+			nodes:       `[{"name":"Trigger","type":"n8n-nodes-base.manualTrigger"}]`,
+			connections: `{}`,
+			active:      types.BoolValue(false),
+		},
+		{
+			name:         "orphaned node is a warning",
+			nodes:        `[{"name":"Webhook","type":"n8n-nodes-base.webhook"},{"name":"Set","type":"n8n-nodes-base.set"}]`,
+			connections:  `{}`,
+			active:       types.BoolNull(),
+			wantWarnings: 1,
+			wantSummary:  "Orphaned Workflow Node",
+		},
+		{
+			name:        "unknown node types are not orphaned",
+			nodes:       `[{"name":"Webhook","type":"n8n-nodes-base.webhook"},{"name":"Invoice","type":"n8n-nodes-acme.invoice"}]`,
+			connections: `{}`,
+			active:      types.BoolNull(),
+		},
+		{
+			name:         "activation with a community node is a warning",
+			nodes:        `[{"name":"Invoice","type":"n8n-nodes-acme.invoice"}]`,
+			connections:  `{}`,
+			allowlist:    []string{"n8n-nodes-acme"},
+			active:       types.BoolValue(true),
+			wantWarnings: 1,
+			wantSummary:  "Workflow May Not Be Activated",
+		},
+		{
+			name:         "activation with an allowlisted node is a warning",
+			nodes:        `[{"name":"Set","type":"n8n-nodes-base.set"}]`,
+			connections:  `{}`,
+			allowlist:    []string{"n8n-nodes-base.set"},
+			active:       types.BoolValue(true),
+			wantWarnings: 1,
+			wantSummary:  "Workflow May Not Be Activated",
+		},
+		{
+			name:        "error case - activation with a manual trigger",
+			nodes:       `[{"name":"Trigger","type":"n8n-nodes-base.manualTrigger"},{"name":"Set","type":"n8n-nodes-base.set"}]`,
+			connections: `{"Trigger":{"main":[[{"node":"Set","type":"main","index":0}]]}}`,
+			active:      types.BoolValue(true),
+			wantErr:     true,
+			wantSummary: "Workflow Cannot Be Activated",
+		},
+		{
+			name:        "error case - activation with a disabled trigger",
+			nodes:       `[{"name":"Webhook","type":"n8n-nodes-base.webhook","disabled":true}]`,
+			connections: `{}`,
+			active:      types.BoolValue(true),
+			wantErr:     true,
+			wantSummary: "Workflow Cannot Be Activated",
+		},
+		{
+			name:        "error case - activation without nodes",
+			nodes:       `[]`,
+			connections: `{}`,
+			active:      types.BoolValue(true),
+			wantErr:     true,
+			wantSummary: "Workflow Cannot Be Activated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			graph, known := workflowGraphFromModel(context.Background(), testGraphModel(tt.nodes, tt.connections))
+			require.True(t, known)
+
+			var diags diag.Diagnostics
+			validateGraphTriggers(graph, nodecatalog.Default(), tt.allowlist, tt.active, &diags)
+			assert.Equal(t, tt.wantErr, diags.HasError())
+			assert.Equal(t, tt.wantWarnings, diags.WarningsCount())
+			if tt.wantSummary != "" {
+				require.NotEmpty(t, diags)
+				assert.Equal(t, tt.wantSummary, diags[0].Summary())
+			}
+		})
+	}
+}
+
+func Test_validateWorkflowGraph_nodesAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	webhook := testNode(t)
+	webhook.ID = types.StringValue("node-1")
+	duplicate := testNode(t)
+	duplicate.ID = types.StringValue("node-1")
+	duplicate.Type = types.StringValue("n8n-nodes-base.noOp")
+	model := &models.Resource{
+		Nodes:           testNodes(t, map[string]models.Node{"Webhook": webhook, "Webhook 2": duplicate}),
+		Connections:     types.SetNull(connectionBlockObjectType),
+		NodesJSON:       jsontypes.NewNull(jsontypes.KIND_NODES),
+		ConnectionsJSON: jsontypes.NewNull(jsontypes.KIND_CONNECTIONS),
+	}
+	graph, known := workflowGraphFromModel(context.Background(), model)
+	require.True(t, known)
+
+	var diags diag.Diagnostics
+	validateWorkflowGraph(graph, &diags)
+	require.Len(t, diags.Errors(), 1)
+	withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	assert.Equal(t, path.Root("nodes").AtMapKey("Webhook 2").AtName("id"), withPath.Path())
+}
+
+func TestWorkflowResource_modifyPlanWorkflowGraph(t *testing.T) {
+	t.Parallel()
+
+	duplicates := `[{"name":"Webhook","type":"n8n-nodes-base.webhook"},{"name":"Webhook","type":"n8n-nodes-base.webhook"}]`
+
+	tests := []struct {
+		name       string
+		configJSON tftypes.Value
+		wantErr    bool
+	}{
+		{name: "skips a graph validated with the configuration", configJSON: tftypes.NewValue(tftypes.String, duplicates)},
+		{name: "error case - validates a graph unknown in the configuration", configJSON: tftypes.NewValue(tftypes.String, tftypes.UnknownValue), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := NewWorkflowResource()
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			configAttrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			planAttrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attrType := range objectType.AttributeTypes {
+				configAttrs[name] = tftypes.NewValue(attrType, nil)
+				planAttrs[name] = tftypes.NewValue(attrType, nil)
+			}
+			configAttrs["nodes_json"] = tt.configJSON
+			planAttrs["nodes_json"] = tftypes.NewValue(tftypes.String, duplicates)
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, configAttrs)},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, planAttrs)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.modifyPlanWorkflowGraph(ctx, req, resp)
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+		})
+	}
+}
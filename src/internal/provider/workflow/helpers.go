@@ -10,20 +10,29 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
 )
 
 // CALLER_POLICY_DEFAULT is the default value for the CallerPolicy workflow setting.
 // The n8n API returns this value even when not explicitly set by the user.
-const CALLER_POLICY_DEFAULT string = "workflowsFromSameOwner"
+const CALLER_POLICY_DEFAULT string = jsontypes.CALLER_POLICY_DEFAULT
 
 // parseWorkflowJSON parses the JSON fields from a workflow model.
+// The nodes attribute, connection blocks and the settings attribute take precedence over nodes_json,
+// connections_json and settings_json when they are configured.
 //
 // Params:
+//   - ctx: Context for the conversion
 //   - plan: The workflow resource model containing JSON data
 //   - diags: Diagnostics for error reporting
 //
@@ -31,11 +40,18 @@ const CALLER_POLICY_DEFAULT string = "workflowsFromSameOwner"
 //   - []n8nsdk.Node: Parsed workflow nodes
 //   - map[string]any: Parsed workflow connections
 //   - n8nsdk.WorkflowSettings: Parsed workflow settings
-func parseWorkflowJSON(plan *models.Resource, diags *diag.Diagnostics) ([]n8nsdk.Node, map[string]any, n8nsdk.WorkflowSettings) {
+func parseWorkflowJSON(ctx context.Context, plan *models.Resource, diags *diag.Diagnostics) ([]n8nsdk.Node, map[string]any, n8nsdk.WorkflowSettings) {
 	// Parse nodes
 	var nodes []n8nsdk.Node
-	// Check for non-nil value.
-	if !plan.NodesJSON.IsNull() && !plan.NodesJSON.IsUnknown() {
+	// Check for the nodes attribute.
+	if hasNodes(plan.Nodes) {
+		nodes = nodesFromAttribute(ctx, plan.Nodes, diags)
+		// Check for conversion errors.
+		if diags.HasError() {
+			// Return failure status.
+			return []n8nsdk.Node{}, map[string]any{}, n8nsdk.WorkflowSettings{}
+		}
+	} else if !plan.NodesJSON.IsNull() && !plan.NodesJSON.IsUnknown() {
 		// Check for error.
 		if err := json.Unmarshal([]byte(plan.NodesJSON.ValueString()), &nodes); err != nil {
 			diags.AddError("Invalid nodes JSON", fmt.Sprintf("Could not parse nodes_json: %s", err.Error()))
@@ -49,8 +65,15 @@ func parseWorkflowJSON(plan *models.Resource, diags *diag.Diagnostics) ([]n8nsdk
 
 	// Parse connections
 	var connections map[string]any
-	// Check for non-nil value.
-	if !plan.ConnectionsJSON.IsNull() && !plan.ConnectionsJSON.IsUnknown() {
+	// Check for connection blocks.
+	if hasConnectionBlocks(plan.Connections) {
+		connections = connectionsFromBlocks(ctx, plan.Connections, diags)
+		// Check for conversion errors.
+		if diags.HasError() {
+			// Return failure status.
+			return []n8nsdk.Node{}, map[string]any{}, n8nsdk.WorkflowSettings{}
+		}
+	} else if !plan.ConnectionsJSON.IsNull() && !plan.ConnectionsJSON.IsUnknown() {
 		// Check for error.
 		if err := json.Unmarshal([]byte(plan.ConnectionsJSON.ValueString()), &connections); err != nil {
 			diags.AddError("Invalid connections JSON", fmt.Sprintf("Could not parse connections_json: %s", err.Error()))
@@ -64,8 +87,10 @@ func parseWorkflowJSON(plan *models.Resource, diags *diag.Diagnostics) ([]n8nsdk
 
 	// Parse settings
 	var settings n8nsdk.WorkflowSettings
-	// Check for non-nil value.
-	if !plan.SettingsJSON.IsNull() && !plan.SettingsJSON.IsUnknown() {
+	// Check for settings attribute.
+	if hasSettingsAttribute(plan.Settings) {
+		settings = settingsFromAttribute(ctx, plan.Settings, diags)
+	} else if !plan.SettingsJSON.IsNull() && !plan.SettingsJSON.IsUnknown() {
 		// Check for error.
 		if err := json.Unmarshal([]byte(plan.SettingsJSON.ValueString()), &settings); err != nil {
 			diags.AddError("Invalid settings JSON", fmt.Sprintf("Could not parse settings_json: %s", err.Error()))
@@ -211,6 +236,7 @@ func mapWorkflowToModel(ctx context.Context, workflow *n8nsdk.Workflow, plan *mo
 
 	// Tags
 	plan.Tags = mapTagsFromWorkflow(ctx, workflow, diags)
+	plan.TagsAll = plan.Tags
 
 	// Project ID from shared workflow info
 	mapWorkflowProjectID(workflow, plan)
@@ -246,6 +272,9 @@ func mapWorkflowToModel(ctx context.Context, workflow *n8nsdk.Workflow, plan *mo
 
 	// Serialize JSON fields
 	serializeWorkflowJSON(workflow, plan)
+	plan.Nodes = mapNodesAttribute(ctx, workflow.Nodes, plan.Nodes, diags)
+	plan.Connections = mapConnectionBlocks(ctx, workflow.Connections, plan.Connections, diags)
+	plan.Settings = mapSettingsAttribute(ctx, workflow.Settings, plan.Settings, diags)
 }
 
 // serializeWorkflowJSON serializes workflow nodes, connections and settings back to JSON strings.
@@ -261,27 +290,34 @@ func serializeWorkflowJSON(workflow *n8nsdk.Workflow, plan *models.Resource) {
 	if workflow.Nodes != nil {
 		// Check for error.
 		if nodesJSON, err := json.Marshal(workflow.Nodes); err == nil {
-			plan.NodesJSON = types.StringValue(string(nodesJSON))
+			plan.NodesJSON = jsontypes.NewNodesValue(string(nodesJSON))
 		}
 	}
 	// Check for non-nil value.
 	if workflow.Connections != nil {
 		// Check for error.
 		if connectionsJSON, err := json.Marshal(workflow.Connections); err == nil {
-			plan.ConnectionsJSON = types.StringValue(string(connectionsJSON))
+			plan.ConnectionsJSON = jsontypes.NewConnectionsValue(string(connectionsJSON))
 		}
 	}
-	// Normalize settings before serialization to avoid unnecessary diffs.
-	normalizedSettings := normalizeWorkflowSettings(workflow.Settings)
+	normalizedSettings := workflow.Settings
+	// Normalize settings_json to avoid unnecessary diffs; the settings attribute sets the defaults explicitly.
+	if !hasSettingsAttribute(plan.Settings) {
+		normalizedSettings = normalizeWorkflowSettings(workflow.Settings)
+	}
 	// Check for error.
 	if settingsJSON, err := json.Marshal(normalizedSettings); err == nil {
-		plan.SettingsJSON = types.StringValue(string(settingsJSON))
+		plan.SettingsJSON = jsontypes.NewSettingsValue(string(settingsJSON))
 	}
 }
 
 // normalizeWorkflowSettings removes default values from settings.
 // The n8n API returns default values for certain settings even when not explicitly set.
 // This function removes callerPolicy and availableInMCP defaults to match user config.
+// It only applies to settings_json: the settings attribute has the same defaults as the API.
+// The semantic equality of settings_json is not enough on its own: the framework only applies
+// it against a prior value, so an imported workflow would otherwise store the defaults and
+// plan an update against a configuration without them.
 //
 // Params:
 //   - settings: Original workflow settings from API
@@ -362,9 +398,11 @@ func (r *WorkflowResource) handleWorkflowActivation(ctx context.Context, plan, s
 	// Check for error.
 	if err != nil {
 		action := getActivationAction(plan)
-		diags.AddError(
+		shared.AddAPIError(
+			diags,
 			fmt.Sprintf("Error changing workflow activation status to %s", action),
-			fmt.Sprintf("Could not %s workflow ID %s: %s\nHTTP Response: %v", action, plan.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not %s workflow ID %s", action, plan.ID.ValueString()),
+			err, httpResp,
 		)
 	}
 }
@@ -413,21 +451,37 @@ func getActivationAction(plan *models.Resource) string {
 // Returns:
 //   - None: Updates workflow tags via API
 func (r *WorkflowResource) updateWorkflowTags(ctx context.Context, workflowID string, plan *models.Resource, workflow *n8nsdk.Workflow, diags *diag.Diagnostics) {
-	// Check for null value.
-	if plan.Tags.IsNull() || plan.Tags.IsUnknown() {
+	// Check for unknown value.
+	if plan.Tags.IsUnknown() {
+		// Return success status.
+		return
+	}
+
+	defaultIDs := r.resolveDefaultTagIDs(ctx, diags)
+	// Check for default tag resolution errors.
+	if diags.HasError() {
+		// Return failure status.
+		return
+	}
+
+	// Nothing to apply without configured or default tags.
+	if plan.Tags.IsNull() && len(defaultIDs) == 0 {
 		// Return success status.
 		return
 	}
 
 	var tagIDs []string
-	diags.Append(plan.Tags.ElementsAs(ctx, &tagIDs, false)...)
+	// Check for configured tags.
+	if !plan.Tags.IsNull() {
+		diags.Append(plan.Tags.ElementsAs(ctx, &tagIDs, false)...)
+	}
 	// Check condition.
 	if diags.HasError() {
 		// Return failure status.
 		return
 	}
 
-	tagIdsInner := convertTagIDsToTagIdsInner(tagIDs)
+	tagIdsInner := convertTagIDsToTagIdsInner(mergeTagIDs(tagIDs, defaultIDs))
 
 	tags, httpResp, err := r.client.APIClient.WorkflowAPI.WorkflowsIdTagsPut(ctx, workflowID).
 		TagIdsInner(tagIdsInner).
@@ -440,9 +494,12 @@ func (r *WorkflowResource) updateWorkflowTags(ctx context.Context, workflowID st
 
 	// Check for error.
 	if err != nil {
-		diags.AddError(
+		shared.AddAPIAttributeError(
+			diags,
+			path.Root("tags"),
 			"Error updating workflow tags",
-			fmt.Sprintf("Could not update tags for workflow ID %s: %s\nHTTP Response: %v", workflowID, err.Error(), httpResp),
+			fmt.Sprintf("Could not update tags for workflow ID %s", workflowID),
+			err, httpResp,
 		)
 		// Return failure status.
 		return
@@ -451,6 +508,197 @@ func (r *WorkflowResource) updateWorkflowTags(ctx context.Context, workflowID st
 	workflow.Tags = tags
 }
 
+// resolveDefaultTagIDs returns the IDs of the provider default tags, creating missing tags.
+//
+// Params:
+//   - ctx: Context for the API call
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - []string: default tag IDs, nil when the provider has no default tags
+func (r *WorkflowResource) resolveDefaultTagIDs(ctx context.Context, diags *diag.Diagnostics) []string {
+	// No default tags configured.
+	if r.client == nil || r.client.DefaultTags == nil {
+		// Return nothing.
+		return nil
+	}
+
+	ids, err := r.client.DefaultTags.Resolve(ctx, r.client.APIClient)
+	// Check for error.
+	if err != nil {
+		diags.AddError(
+			"Error resolving default tags",
+			fmt.Sprintf("Could not resolve provider default_tags %v: %s", r.client.DefaultTags.Names(), err.Error()),
+		)
+		// Return nothing.
+		return nil
+	}
+
+	// Return resolved IDs.
+	return ids
+}
+
+// lookupDefaultTagIDs returns the IDs of the provider default tags without creating them.
+//
+// Params:
+//   - ctx: Context for the API call
+//
+// Returns:
+//   - []string: IDs of the existing default tags
+//   - bool: true when every default tag ID is known
+func (r *WorkflowResource) lookupDefaultTagIDs(ctx context.Context) ([]string, bool) {
+	// No default tags configured.
+	if r.client == nil || r.client.DefaultTags == nil {
+		// Return known empty defaults.
+		return nil, true
+	}
+
+	ids, complete, err := r.client.DefaultTags.Lookup(ctx, r.client.APIClient)
+	// Check for error.
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Could not look up provider default_tags: %s", err.Error()))
+		// Return unknown defaults.
+		return nil, false
+	}
+
+	// Return existing IDs.
+	return ids, complete
+}
+
+// modifyPlanTagsAll plans tags_all as the union of tags and the provider default tags.
+// The value is unknown when a default tag does not exist yet and will be created on apply.
+//
+// Params:
+//   - ctx: Context for the operation
+//   - req: ModifyPlan request containing config, state and plan
+//   - resp: ModifyPlan response to update the plan
+func (r *WorkflowResource) modifyPlanTagsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		// Return early.
+		return
+	}
+
+	var tags types.Set
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	// Check for error.
+	if resp.Diagnostics.HasError() {
+		// Return early.
+		return
+	}
+
+	defaultIDs, known := r.lookupDefaultTagIDs(ctx)
+	// The effective set cannot be known before unknown values are resolved.
+	if !known || tags.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.SetUnknown(types.StringType))...)
+		// Return early.
+		return
+	}
+
+	var tagIDs []string
+	// Check for configured tags.
+	if !tags.IsNull() {
+		resp.Diagnostics.Append(tags.ElementsAs(ctx, &tagIDs, false)...)
+	}
+	merged := mergeTagIDs(tagIDs, defaultIDs)
+
+	// Null set when empty, matching mapTagsFromWorkflow.
+	tagsAll := types.SetNull(types.StringType)
+	// Check length.
+	if len(merged) > 0 {
+		var setDiags diag.Diagnostics
+		tagsAll, setDiags = types.SetValueFrom(ctx, types.StringType, merged)
+		resp.Diagnostics.Append(setDiags...)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// excludeDefaultTags removes the provider default tags from the tags attribute after a
+// workflow was mapped, so that tags only holds the configured tags and tags_all the full set.
+// Default tags that are also configured explicitly are kept.
+//
+// Params:
+//   - ctx: Context for the operation
+//   - model: The mapped resource model (TagsAll holds every applied tag)
+//   - configured: The tags value before mapping (plan or prior state)
+//   - diags: Diagnostics for error reporting
+func (r *WorkflowResource) excludeDefaultTags(ctx context.Context, model *models.Resource, configured types.Set, diags *diag.Diagnostics) {
+	defaultIDs, _ := r.lookupDefaultTagIDs(ctx)
+	// Nothing to exclude without default tags.
+	if len(defaultIDs) == 0 || model.TagsAll.IsNull() {
+		// Return early.
+		return
+	}
+
+	keep := make(map[string]struct{})
+	// Collect explicitly configured tags.
+	if !configured.IsNull() && !configured.IsUnknown() {
+		var configuredIDs []string
+		diags.Append(configured.ElementsAs(ctx, &configuredIDs, false)...)
+		// Index configured tags.
+		for _, id := range configuredIDs {
+			keep[id] = struct{}{}
+		}
+	}
+	excluded := make(map[string]struct{}, len(defaultIDs))
+	// Index default tags that are not configured.
+	for _, id := range defaultIDs {
+		// Skip explicitly configured defaults.
+		if _, ok := keep[id]; !ok {
+			excluded[id] = struct{}{}
+		}
+	}
+
+	var allIDs []string
+	diags.Append(model.TagsAll.ElementsAs(ctx, &allIDs, false)...)
+	tagIDs := make([]string, 0, len(allIDs))
+	// Filter default tags out.
+	for _, id := range allIDs {
+		// Keep non-default tags.
+		if _, ok := excluded[id]; !ok {
+			tagIDs = append(tagIDs, id)
+		}
+	}
+
+	// Keep null when nothing was configured, matching mapTagsFromWorkflow.
+	if len(tagIDs) == 0 && configured.IsNull() {
+		model.Tags = types.SetNull(types.StringType)
+		// Return early.
+		return
+	}
+
+	tagSet, setDiags := types.SetValueFrom(ctx, types.StringType, tagIDs)
+	diags.Append(setDiags...)
+	model.Tags = tagSet
+}
+
+// mergeTagIDs returns the sorted union of two tag ID lists.
+//
+// Params:
+//   - tagIDs: configured tag IDs
+//   - defaultIDs: provider default tag IDs
+//
+// Returns:
+//   - []string: deduplicated tag IDs
+func mergeTagIDs(tagIDs, defaultIDs []string) []string {
+	seen := make(map[string]struct{}, len(tagIDs)+len(defaultIDs))
+	merged := make([]string, 0, len(tagIDs)+len(defaultIDs))
+	// Add every ID once.
+	for _, id := range append(append([]string(nil), tagIDs...), defaultIDs...) {
+		// Skip duplicates.
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		merged = append(merged, id)
+	}
+
+	sort.Strings(merged)
+	// Return merged IDs.
+	return merged
+}
+
 // createWorkflowViaAPI creates a new workflow via the n8n API.
 //
 // Params:
@@ -472,10 +720,7 @@ func (r *WorkflowResource) createWorkflowViaAPI(ctx context.Context, workflowReq
 
 	// Check for API error
 	if err != nil {
-		diags.AddError(
-			"Error creating workflow",
-			fmt.Sprintf("Could not create workflow, unexpected error: %s\nHTTP Response: %v", err.Error(), httpResp),
-		)
+		shared.AddAPIError(diags, "Error creating workflow", "Could not create workflow", err, httpResp)
 		return nil
 	}
 
@@ -505,9 +750,11 @@ func (r *WorkflowResource) updateWorkflowViaAPI(ctx context.Context, workflowID
 
 	// Check for API error
 	if err != nil {
-		diags.AddError(
+		shared.AddAPIError(
+			diags,
 			"Error updating workflow",
-			fmt.Sprintf("Could not update workflow ID %s: %s\nHTTP Response: %v", workflowID, err.Error(), httpResp),
+			fmt.Sprintf("Could not update workflow ID %s", workflowID),
+			err, httpResp,
 		)
 		return nil
 	}
@@ -543,9 +790,12 @@ func (r *WorkflowResource) transferWorkflowToProject(ctx context.Context, workfl
 
 	// Check for error.
 	if err != nil {
-		diags.AddError(
+		shared.AddAPIAttributeError(
+			diags,
+			path.Root("project_id"),
 			"Error transferring workflow to project",
-			fmt.Sprintf("Could not transfer workflow ID %s to project %s: %s\nHTTP Response: %v", workflowID, projectID, err.Error(), httpResp),
+			fmt.Sprintf("Could not transfer workflow ID %s to project %s", workflowID, projectID),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
@@ -597,8 +847,8 @@ func (r *WorkflowResource) handlePostCreation(ctx context.Context, workflow *n8n
 // Returns:
 //   - *n8nsdk.Workflow: Updated workflow if successful, nil otherwise
 func (r *WorkflowResource) applyPostCreationTagsAndProject(ctx context.Context, workflow *n8nsdk.Workflow, plan *models.Resource, diags *diag.Diagnostics) *n8nsdk.Workflow {
-	// Update tags if provided
-	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() && workflow.Id != nil {
+	// Update tags if provided or required by the provider default tags
+	if workflow.Id != nil {
 		r.updateWorkflowTags(ctx, *workflow.Id, plan, workflow, diags)
 		// Check for tag update errors
 		if diags.HasError() {
@@ -653,9 +903,11 @@ func (r *WorkflowResource) handleProjectAssignment(ctx context.Context, workflow
 	// Return nil on re-fetch failure; add diagnostic since transfer
 	// succeeded but workflow couldn't be re-fetched
 	if err != nil {
-		diags.AddError(
+		shared.AddAPIError(
+			diags,
 			"Error re-fetching workflow after project transfer",
-			fmt.Sprintf("Workflow was transferred to project successfully, but failed to re-fetch workflow ID %s: %s\nHTTP Response: %v", workflowID, err.Error(), httpResp),
+			fmt.Sprintf("Workflow was transferred to project successfully, but failed to re-fetch workflow ID %s", workflowID),
+			err, httpResp,
 		)
 		return nil
 	}
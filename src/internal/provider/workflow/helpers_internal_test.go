@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
 	"github.com/stretchr/testify/assert"
 )
@@ -53,13 +54,13 @@ func Test_parseWorkflowJSON(t *testing.T) {
 				t.Helper()
 				nodesJSON := `[{"name":"Start","type":"n8n-nodes-base.start","position":[100,200]}]`
 				plan := &models.Resource{
-					NodesJSON:       types.StringValue(nodesJSON),
-					ConnectionsJSON: types.StringValue("{}"),
-					SettingsJSON:    types.StringValue("{}"),
+					NodesJSON:       jsontypes.NewNodesValue(nodesJSON),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("{}"),
+					SettingsJSON:    jsontypes.NewSettingsValue("{}"),
 				}
 				diags := &diag.Diagnostics{}
 
-				nodes, connections, settings := parseWorkflowJSON(plan, diags)
+				nodes, connections, settings := parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.False(t, diags.HasError())
 				assert.Len(t, nodes, 1)
@@ -73,13 +74,13 @@ func Test_parseWorkflowJSON(t *testing.T) {
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				plan := &models.Resource{
-					NodesJSON:       types.StringValue("invalid json"),
-					ConnectionsJSON: types.StringValue("{}"),
-					SettingsJSON:    types.StringValue("{}"),
+					NodesJSON:       jsontypes.NewNodesValue("invalid json"),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("{}"),
+					SettingsJSON:    jsontypes.NewSettingsValue("{}"),
 				}
 				diags := &diag.Diagnostics{}
 
-				nodes, connections, settings := parseWorkflowJSON(plan, diags)
+				nodes, connections, settings := parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.True(t, diags.HasError())
 				assert.Empty(t, nodes)
@@ -92,13 +93,13 @@ func Test_parseWorkflowJSON(t *testing.T) {
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				plan := &models.Resource{
-					NodesJSON:       types.StringNull(),
-					ConnectionsJSON: types.StringValue("{}"),
-					SettingsJSON:    types.StringValue("{}"),
+					NodesJSON:       jsontypes.NewNull(jsontypes.KIND_NODES),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("{}"),
+					SettingsJSON:    jsontypes.NewSettingsValue("{}"),
 				}
 				diags := &diag.Diagnostics{}
 
-				nodes, connections, settings := parseWorkflowJSON(plan, diags)
+				nodes, connections, settings := parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.False(t, diags.HasError())
 				assert.Empty(t, nodes)
@@ -111,13 +112,13 @@ func Test_parseWorkflowJSON(t *testing.T) {
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				plan := &models.Resource{
-					NodesJSON:       types.StringUnknown(),
-					ConnectionsJSON: types.StringValue("{}"),
-					SettingsJSON:    types.StringValue("{}"),
+					NodesJSON:       jsontypes.NewUnknown(jsontypes.KIND_NODES),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("{}"),
+					SettingsJSON:    jsontypes.NewSettingsValue("{}"),
 				}
 				diags := &diag.Diagnostics{}
 
-				nodes, connections, settings := parseWorkflowJSON(plan, diags)
+				nodes, connections, settings := parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.False(t, diags.HasError())
 				assert.Empty(t, nodes)
@@ -131,13 +132,13 @@ func Test_parseWorkflowJSON(t *testing.T) {
 				t.Helper()
 				connectionsJSON := `{"Node1":{"main":[[{"node":"Node2","type":"main","index":0}]]}}`
 				plan := &models.Resource{
-					NodesJSON:       types.StringValue("[]"),
-					ConnectionsJSON: types.StringValue(connectionsJSON),
-					SettingsJSON:    types.StringValue("{}"),
+					NodesJSON:       jsontypes.NewNodesValue("[]"),
+					ConnectionsJSON: jsontypes.NewConnectionsValue(connectionsJSON),
+					SettingsJSON:    jsontypes.NewSettingsValue("{}"),
 				}
 				diags := &diag.Diagnostics{}
 
-				nodes, connections, settings := parseWorkflowJSON(plan, diags)
+				nodes, connections, settings := parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.False(t, diags.HasError())
 				assert.NotNil(t, nodes)
@@ -150,13 +151,13 @@ func Test_parseWorkflowJSON(t *testing.T) {
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				plan := &models.Resource{
-					NodesJSON:       types.StringValue("[]"),
-					ConnectionsJSON: types.StringValue("invalid json"),
-					SettingsJSON:    types.StringValue("{}"),
+					NodesJSON:       jsontypes.NewNodesValue("[]"),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("invalid json"),
+					SettingsJSON:    jsontypes.NewSettingsValue("{}"),
 				}
 				diags := &diag.Diagnostics{}
 
-				nodes, connections, settings := parseWorkflowJSON(plan, diags)
+				nodes, connections, settings := parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.True(t, diags.HasError())
 				assert.Empty(t, nodes)
@@ -169,13 +170,13 @@ func Test_parseWorkflowJSON(t *testing.T) {
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				plan := &models.Resource{
-					NodesJSON:       types.StringValue("[]"),
-					ConnectionsJSON: types.StringNull(),
-					SettingsJSON:    types.StringValue("{}"),
+					NodesJSON:       jsontypes.NewNodesValue("[]"),
+					ConnectionsJSON: jsontypes.NewNull(jsontypes.KIND_CONNECTIONS),
+					SettingsJSON:    jsontypes.NewSettingsValue("{}"),
 				}
 				diags := &diag.Diagnostics{}
 
-				nodes, connections, settings := parseWorkflowJSON(plan, diags)
+				nodes, connections, settings := parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.False(t, diags.HasError())
 				assert.NotNil(t, nodes)
@@ -189,13 +190,13 @@ func Test_parseWorkflowJSON(t *testing.T) {
 				t.Helper()
 				settingsJSON := `{"saveExecutionProgress":true,"saveManualExecutions":true}`
 				plan := &models.Resource{
-					NodesJSON:       types.StringValue("[]"),
-					ConnectionsJSON: types.StringValue("{}"),
-					SettingsJSON:    types.StringValue(settingsJSON),
+					NodesJSON:       jsontypes.NewNodesValue("[]"),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("{}"),
+					SettingsJSON:    jsontypes.NewSettingsValue(settingsJSON),
 				}
 				diags := &diag.Diagnostics{}
 
-				nodes, connections, settings := parseWorkflowJSON(plan, diags)
+				nodes, connections, settings := parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.False(t, diags.HasError())
 				assert.NotNil(t, nodes)
@@ -208,13 +209,13 @@ func Test_parseWorkflowJSON(t *testing.T) {
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				plan := &models.Resource{
-					NodesJSON:       types.StringValue("[]"),
-					ConnectionsJSON: types.StringValue("{}"),
-					SettingsJSON:    types.StringValue("invalid json"),
+					NodesJSON:       jsontypes.NewNodesValue("[]"),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("{}"),
+					SettingsJSON:    jsontypes.NewSettingsValue("invalid json"),
 				}
 				diags := &diag.Diagnostics{}
 
-				nodes, connections, settings := parseWorkflowJSON(plan, diags)
+				nodes, connections, settings := parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.True(t, diags.HasError())
 				assert.Empty(t, nodes)
@@ -227,13 +228,13 @@ func Test_parseWorkflowJSON(t *testing.T) {
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				plan := &models.Resource{
-					NodesJSON:       types.StringValue("[]"),
-					ConnectionsJSON: types.StringValue("{}"),
-					SettingsJSON:    types.StringNull(),
+					NodesJSON:       jsontypes.NewNodesValue("[]"),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("{}"),
+					SettingsJSON:    jsontypes.NewNull(jsontypes.KIND_SETTINGS),
 				}
 				diags := &diag.Diagnostics{}
 
-				nodes, connections, settings := parseWorkflowJSON(plan, diags)
+				nodes, connections, settings := parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.False(t, diags.HasError())
 				assert.NotNil(t, nodes)
@@ -581,6 +582,25 @@ func Test_mapWorkflowTimestamps(t *testing.T) {
 				assert.Contains(t, plan.CreatedAt.ValueString(), "2024-01-01T12:00:00")
 			},
 		},
+		{
+			name: "settings attribute keeps the n8n defaults",
+			testFunc: func(t *testing.T) {
+				t.Helper()
+				callerPolicy := CALLER_POLICY_DEFAULT
+				workflow := &n8nsdk.Workflow{
+					Nodes:       []n8nsdk.Node{},
+					Connections: map[string]any{},
+					Settings:    n8nsdk.WorkflowSettings{CallerPolicy: &callerPolicy},
+				}
+				plan := &models.Resource{}
+				serializeWorkflowJSON(workflow, plan)
+				assert.NotContains(t, plan.SettingsJSON.ValueString(), "callerPolicy", "settings_json is normalized")
+
+				plan.Settings = testSettingsObject(t, testSettings())
+				serializeWorkflowJSON(workflow, plan)
+				assert.Contains(t, plan.SettingsJSON.ValueString(), "callerPolicy")
+			},
+		},
 		{
 			name: "error case - nil workflow pointer does not panic",
 			testFunc: func(t *testing.T) {
@@ -755,6 +775,28 @@ func Test_serializeWorkflowJSON(t *testing.T) {
 				assert.False(t, plan.SettingsJSON.IsNull())
 			},
 		},
+		{
+			name: "imported workflow stores settings_json without the server defaults",
+			testFunc: func(t *testing.T) {
+				t.Helper()
+				callerPolicy := CALLER_POLICY_DEFAULT
+				availableInMCP := false
+				executionOrder := "v1"
+				workflow := &n8nsdk.Workflow{
+					Settings: n8nsdk.WorkflowSettings{
+						CallerPolicy:   &callerPolicy,
+						AvailableInMCP: &availableInMCP,
+						ExecutionOrder: &executionOrder,
+					},
+				}
+				// An imported workflow has no prior settings to compare with.
+				plan := &models.Resource{}
+
+				serializeWorkflowJSON(workflow, plan)
+
+				assert.JSONEq(t, `{"executionOrder":"v1"}`, plan.SettingsJSON.ValueString())
+			},
+		},
 		{
 			name: "serialize with nil nodes",
 			testFunc: func(t *testing.T) {
@@ -809,6 +851,25 @@ func Test_serializeWorkflowJSON(t *testing.T) {
 				assert.False(t, plan.SettingsJSON.IsNull())
 			},
 		},
+		{
+			name: "settings attribute keeps the n8n defaults",
+			testFunc: func(t *testing.T) {
+				t.Helper()
+				callerPolicy := CALLER_POLICY_DEFAULT
+				workflow := &n8nsdk.Workflow{
+					Nodes:       []n8nsdk.Node{},
+					Connections: map[string]any{},
+					Settings:    n8nsdk.WorkflowSettings{CallerPolicy: &callerPolicy},
+				}
+				plan := &models.Resource{}
+				serializeWorkflowJSON(workflow, plan)
+				assert.NotContains(t, plan.SettingsJSON.ValueString(), "callerPolicy", "settings_json is normalized")
+
+				plan.Settings = testSettingsObject(t, testSettings())
+				serializeWorkflowJSON(workflow, plan)
+				assert.Contains(t, plan.SettingsJSON.ValueString(), "callerPolicy")
+			},
+		},
 		{
 			name: "error case - nil workflow pointer does not panic",
 			testFunc: func(t *testing.T) {
@@ -2527,3 +2588,178 @@ func TestWorkflowResource_applyPostCreationTagsAndProject_WithMock(t *testing.T)
 		})
 	}
 }
+
+// Test_mergeTagIDs tests the mergeTagIDs function.
+func Test_mergeTagIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		tagIDs     []string
+		defaultIDs []string
+		want       []string
+	}{
+		{name: "merges and sorts", tagIDs: []string{"tag-2"}, defaultIDs: []string{"tag-1"}, want: []string{"tag-1", "tag-2"}},
+		{name: "removes duplicates", tagIDs: []string{"tag-1", "tag-2"}, defaultIDs: []string{"tag-2"}, want: []string{"tag-1", "tag-2"}},
+		{name: "defaults only", tagIDs: nil, defaultIDs: []string{"tag-1"}, want: []string{"tag-1"}},
+		{name: "error case - both empty", tagIDs: nil, defaultIDs: nil, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, mergeTagIDs(tt.tagIDs, tt.defaultIDs))
+		})
+	}
+}
+
+// TestWorkflowResource_excludeDefaultTags tests the excludeDefaultTags method.
+func TestWorkflowResource_excludeDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	tagSet := func(ids ...string) types.Set {
+		values := make([]attr.Value, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, types.StringValue(id))
+		}
+		return types.SetValueMust(types.StringType, values)
+	}
+
+	tests := []struct {
+		name        string
+		defaultTags []string
+		tagsAll     types.Set
+		configured  types.Set
+		want        types.Set
+	}{
+		{
+			name:        "removes default tags",
+			defaultTags: []string{"managed"},
+			tagsAll:     tagSet("tag-1", "tag-default"),
+			configured:  tagSet("tag-1"),
+			want:        tagSet("tag-1"),
+		},
+		{
+			name:        "keeps explicitly configured default tags",
+			defaultTags: []string{"managed"},
+			tagsAll:     tagSet("tag-1", "tag-default"),
+			configured:  tagSet("tag-1", "tag-default"),
+			want:        tagSet("tag-1", "tag-default"),
+		},
+		{
+			name:        "null when only default tags are applied",
+			defaultTags: []string{"managed"},
+			tagsAll:     tagSet("tag-default"),
+			configured:  types.SetNull(types.StringType),
+			want:        types.SetNull(types.StringType),
+		},
+		{
+			name:        "empty set when configured empty",
+			defaultTags: []string{"managed"},
+			tagsAll:     tagSet("tag-default"),
+			configured:  tagSet(),
+			want:        tagSet(),
+		},
+		{
+			name:       "error case - unchanged without default tags",
+			tagsAll:    tagSet("tag-1", "tag-default"),
+			configured: types.SetNull(types.StringType),
+			want:       tagSet("tag-1", "tag-default"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			n8nClient, server := setupTestClientForHelpers(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"data":[{"id":"tag-default","name":"managed"}]}`))
+			})
+			defer server.Close()
+			n8nClient.DefaultTags = client.NewDefaultTags(tt.defaultTags)
+
+			r := &WorkflowResource{client: n8nClient}
+			model := &models.Resource{Tags: tt.tagsAll, TagsAll: tt.tagsAll}
+			diags := &diag.Diagnostics{}
+
+			r.excludeDefaultTags(context.Background(), model, tt.configured, diags)
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.want, model.Tags)
+			assert.Equal(t, tt.tagsAll, model.TagsAll)
+		})
+	}
+}
+
+// TestWorkflowResource_updateWorkflowTags_DefaultTags tests that default tags are merged on apply.
+func TestWorkflowResource_updateWorkflowTags_DefaultTags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		tags        types.Set
+		createFails bool
+		wantPut     []string
+		expectError bool
+	}{
+		{
+			name:    "merges configured and created default tags",
+			tags:    types.SetValueMust(types.StringType, []attr.Value{types.StringValue("tag-1")}),
+			wantPut: []string{"tag-1", "tag-created"},
+		},
+		{
+			name:    "applies default tags without configured tags",
+			tags:    types.SetNull(types.StringType),
+			wantPut: []string{"tag-created"},
+		},
+		{
+			name:        "error case - default tag creation fails",
+			tags:        types.SetNull(types.StringType),
+			createFails: true,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var put []string
+			n8nClient, server := setupTestClientForHelpers(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/tags":
+					w.Write([]byte(`{"data":[]}`))
+				case r.Method == http.MethodPost && r.URL.Path == "/tags":
+					if tt.createFails {
+						w.WriteHeader(http.StatusConflict)
+						return
+					}
+					w.Write([]byte(`{"id":"tag-created","name":"managed"}`))
+				case r.Method == http.MethodPut && r.URL.Path == "/workflows/wf-123/tags":
+					var body []map[string]string
+					json.NewDecoder(r.Body).Decode(&body)
+					for _, tag := range body {
+						put = append(put, tag["id"])
+					}
+					w.Write([]byte(`[]`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+			defer server.Close()
+			n8nClient.DefaultTags = client.NewDefaultTags([]string{"managed"})
+
+			r := &WorkflowResource{client: n8nClient}
+			plan := &models.Resource{Tags: tt.tags}
+			diags := &diag.Diagnostics{}
+
+			r.updateWorkflowTags(context.Background(), "wf-123", plan, &n8nsdk.Workflow{}, diags)
+
+			assert.Equal(t, tt.expectError, diags.HasError())
+			assert.Equal(t, tt.wantPut, put)
+		})
+	}
+}
@@ -0,0 +1,407 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package jsontypes
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+)
+
+// CALLER_POLICY_DEFAULT is the default value for the CallerPolicy workflow setting.
+// The n8n API returns this value even when not explicitly set by the user.
+const CALLER_POLICY_DEFAULT string = "workflowsFromSameOwner"
+
+// serverNodeFields are node fields n8n generates when they are not configured.
+var serverNodeFields []string = []string{"id", "webhookId", "createdAt", "updatedAt"}
+
+// SemanticallyEqual compares workflow JSON of the given kind.
+// Key order and number formatting are ignored for every kind. In addition:
+//   - nodes are matched by name, n8n generated fields and parameters added by n8n are ignored;
+//   - connections without any target are ignored;
+//   - the callerPolicy and availableInMCP defaults are ignored;
+//   - node parameters added by n8n with an empty or zero value are ignored.
+//
+// Params:
+//   - kind: workflow attribute kind
+//   - prior: prior JSON, from the configuration or the state
+//   - next: new JSON, as returned by n8n
+//
+// Returns:
+//   - bool: true if both describe the same workflow data
+func SemanticallyEqual(kind Kind, prior, next string) bool {
+	// Identical strings are always equal.
+	if prior == next {
+		// Return equal.
+		return true
+	}
+
+	var priorData, nextData any
+	// Invalid JSON is only equal to itself.
+	if json.Unmarshal([]byte(prior), &priorData) != nil || json.Unmarshal([]byte(next), &nextData) != nil {
+		// Return not equal.
+		return false
+	}
+
+	// Compare by kind.
+	switch kind {
+	case KIND_NODES:
+		// Return nodes comparison.
+		return nodesEqual(priorData, nextData)
+	case KIND_CONNECTIONS:
+		// Return connections comparison.
+		return reflect.DeepEqual(normalizeConnections(priorData), normalizeConnections(nextData))
+	case KIND_SETTINGS:
+		// Return settings comparison.
+		return reflect.DeepEqual(normalizeSettings(priorData), normalizeSettings(nextData))
+	case KIND_PARAMETERS:
+		// Return parameters comparison.
+		return containsDefaults(priorData, nextData)
+	default:
+		// Return plain comparison.
+		return reflect.DeepEqual(priorData, nextData)
+	}
+}
+
+// nodesEqual compares node lists regardless of their order.
+//
+// Params:
+//   - prior: prior nodes
+//   - next: new nodes
+//
+// Returns:
+//   - bool: true if every node matches the node of the same name
+func nodesEqual(prior, next any) bool {
+	priorNodes, priorOK := prior.([]any)
+	nextNodes, nextOK := next.([]any)
+	// Both must be arrays of the same length.
+	if !priorOK || !nextOK || len(priorNodes) != len(nextNodes) {
+		// Return not equal.
+		return false
+	}
+
+	nextByName := make(map[string]map[string]any, len(nextNodes))
+	// Index new nodes by name.
+	for _, node := range nextNodes {
+		object, ok := node.(map[string]any)
+		name, named := object["name"].(string)
+		// Nodes must have unique names.
+		if !ok || !named || nextByName[name] != nil {
+			// Return plain comparison.
+			return reflect.DeepEqual(prior, next)
+		}
+		nextByName[name] = object
+	}
+
+	// Match prior nodes by name.
+	for _, node := range priorNodes {
+		object, ok := node.(map[string]any)
+		name, _ := object["name"].(string)
+		// Check the matching node.
+		if !ok || !nodeEqual(object, nextByName[name]) {
+			// Return not equal.
+			return false
+		}
+	}
+
+	// Return equal.
+	return true
+}
+
+// nodeEqual compares two nodes, ignoring the fields and parameters n8n adds.
+//
+// Params:
+//   - prior: prior node
+//   - next: new node, nil if missing
+//
+// Returns:
+//   - bool: true if the nodes match
+func nodeEqual(prior, next map[string]any) bool {
+	// Check for missing node.
+	if next == nil {
+		// Return not equal.
+		return false
+	}
+
+	// Check the fields of the new node.
+	for key, nextValue := range next {
+		priorValue, found := prior[key]
+		// Ignore generated fields that are not configured.
+		if !found && slices.Contains(serverNodeFields, key) {
+			continue
+		}
+		// Parameters may only gain defaults.
+		if key == "parameters" {
+			// Check parameters.
+			if !containsDefaults(priorValue, nextValue) {
+				// Return not equal.
+				return false
+			}
+			continue
+		}
+		// Check field.
+		if !found || !reflect.DeepEqual(priorValue, nextValue) {
+			// Return not equal.
+			return false
+		}
+	}
+
+	// Check the fields only present in the prior node.
+	for key, priorValue := range prior {
+		// Check for a removed field.
+		if _, found := next[key]; !found && !isEmpty(priorValue) {
+			// Return not equal.
+			return false
+		}
+	}
+
+	// Return equal.
+	return true
+}
+
+// containsDefaults checks that next equals prior, except for object keys only next has
+// whose value is empty or zero. The node catalog does not record parameter defaults, so
+// a key added with any other value, such as a parameter set in the n8n UI, is a change.
+//
+// Params:
+//   - prior: prior value
+//   - next: new value
+//
+// Returns:
+//   - bool: true if next is prior with added empty or zero keys
+func containsDefaults(prior, next any) bool {
+	// Compare by type.
+	switch priorValue := prior.(type) {
+	case nil:
+		// Missing parameters may only be added empty.
+		return isZero(next)
+	case map[string]any:
+		nextValue, ok := next.(map[string]any)
+		// Check type.
+		if !ok {
+			// Return not equal.
+			return false
+		}
+		// Check each prior key.
+		for key, value := range priorValue {
+			nested, found := nextValue[key]
+			// Check nested value.
+			if !found || !containsDefaults(value, nested) {
+				// Return not equal.
+				return false
+			}
+		}
+		// Check the keys added by n8n.
+		for key, value := range nextValue {
+			// Added keys must be empty or zero.
+			if _, found := priorValue[key]; !found && !isZero(value) {
+				// Return not equal.
+				return false
+			}
+		}
+		// Return equal.
+		return true
+	case []any:
+		nextValue, ok := next.([]any)
+		// Check type and length.
+		if !ok || len(priorValue) != len(nextValue) {
+			// Return not equal.
+			return false
+		}
+		// Check each element.
+		for i := range priorValue {
+			// Check element.
+			if !containsDefaults(priorValue[i], nextValue[i]) {
+				// Return not equal.
+				return false
+			}
+		}
+		// Return equal.
+		return true
+	default:
+		// Return scalar comparison.
+		return reflect.DeepEqual(prior, next)
+	}
+}
+
+// isEmpty checks whether a JSON value is empty: null, an empty object or an empty array.
+//
+// Params:
+//   - value: JSON value
+//
+// Returns:
+//   - bool: true if the value is empty
+func isEmpty(value any) bool {
+	// Check by type.
+	switch v := value.(type) {
+	case nil:
+		// Return empty.
+		return true
+	case map[string]any:
+		// Return emptiness.
+		return len(v) == 0
+	case []any:
+		// Return emptiness.
+		return len(v) == 0
+	default:
+		// Return not empty.
+		return false
+	}
+}
+
+// isZero checks whether a JSON value is empty or zero: null, false, "", 0, or an object or
+// array holding only such values.
+//
+// Params:
+//   - value: JSON value
+//
+// Returns:
+//   - bool: true if the value is empty or zero
+func isZero(value any) bool {
+	// Check by type.
+	switch v := value.(type) {
+	case nil:
+		// Return zero.
+		return true
+	case bool:
+		// Return zero.
+		return !v
+	case string:
+		// Return zero.
+		return v == ""
+	case float64:
+		// Return zero.
+		return v == 0
+	case map[string]any:
+		// Check each value.
+		for _, nested := range v {
+			// Check nested value.
+			if !isZero(nested) {
+				// Return not zero.
+				return false
+			}
+		}
+		// Return zero.
+		return true
+	case []any:
+		// Check each element.
+		for _, nested := range v {
+			// Check element.
+			if !isZero(nested) {
+				// Return not zero.
+				return false
+			}
+		}
+		// Return zero.
+		return true
+	default:
+		// Return not zero.
+		return false
+	}
+}
+
+// normalizeConnections removes the source nodes without any connection target.
+//
+// Params:
+//   - connections: connections object
+//
+// Returns:
+//   - any: normalized connections
+func normalizeConnections(connections any) any {
+	sources, ok := connections.(map[string]any)
+	// Keep other values as-is.
+	if !ok {
+		// Return original value.
+		return connections
+	}
+
+	normalized := make(map[string]any, len(sources))
+	// Keep sources with targets.
+	for source, outputs := range sources {
+		// Check for targets.
+		if hasTargets(outputs) {
+			normalized[source] = outputs
+		}
+	}
+
+	// Return normalized connections.
+	return normalized
+}
+
+// hasTargets checks whether a value contains any connection target.
+//
+// Params:
+//   - value: outputs of a source node, or a part of them
+//
+// Returns:
+//   - bool: true if a target is present
+func hasTargets(value any) bool {
+	// Check by type.
+	switch v := value.(type) {
+	case map[string]any:
+		// Objects other than output maps are targets.
+		if _, isTarget := v["node"]; isTarget {
+			// Return target found.
+			return true
+		}
+		// Check each output.
+		for _, nested := range v {
+			// Check output.
+			if hasTargets(nested) {
+				// Return target found.
+				return true
+			}
+		}
+		// Return no target.
+		return false
+	case []any:
+		// Check each element.
+		for _, nested := range v {
+			// Check element.
+			if hasTargets(nested) {
+				// Return target found.
+				return true
+			}
+		}
+		// Return no target.
+		return false
+	default:
+		// Return no target.
+		return false
+	}
+}
+
+// normalizeSettings removes the default values n8n adds to settings.
+//
+// Params:
+//   - settings: settings object
+//
+// Returns:
+//   - any: normalized settings
+func normalizeSettings(settings any) any {
+	values, ok := settings.(map[string]any)
+	// Keep other values as-is.
+	if !ok {
+		// Return original value.
+		return settings
+	}
+
+	normalized := make(map[string]any, len(values))
+	// Copy non-default settings.
+	for key, value := range values {
+		// Skip the default caller policy.
+		if key == "callerPolicy" && value == CALLER_POLICY_DEFAULT {
+			continue
+		}
+		// Skip the default MCP availability.
+		if key == "availableInMCP" && value == false {
+			continue
+		}
+		normalized[key] = value
+	}
+
+	// Return normalized settings.
+	return normalized
+}
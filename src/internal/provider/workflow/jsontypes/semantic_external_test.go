@@ -0,0 +1,190 @@
+package jsontypes_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSemanticallyEqual tests the semantic comparison of workflow JSON.
+func TestSemanticallyEqual(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		kind  jsontypes.Kind
+		prior string
+		next  string
+		want  bool
+	}{
+		{
+			name:  "identical strings",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[]`,
+			next:  `[]`,
+			want:  true,
+		},
+		{
+			name:  "nodes with different key order and spacing",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[{"name":"Start","type":"n8n-nodes-base.manualTrigger","position":[0,0],"parameters":{}}]`,
+			next:  `[ {"parameters":{}, "position":[0, 0], "type":"n8n-nodes-base.manualTrigger", "name":"Start"} ]`,
+			want:  true,
+		},
+		{
+			name:  "nodes in a different order",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[{"name":"A","type":"t"},{"name":"B","type":"t"}]`,
+			next:  `[{"name":"B","type":"t"},{"name":"A","type":"t"}]`,
+			want:  true,
+		},
+		{
+			name:  "nodes with generated id and numbers formatted differently",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[{"name":"A","typeVersion":1,"position":[250,300]}]`,
+			next:  `[{"id":"d7c6","webhookId":"abc","name":"A","typeVersion":1.0,"position":[250,300]}]`,
+			want:  true,
+		},
+		{
+			name:  "nodes with parameters defaulted by n8n",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[{"name":"A","parameters":{"url":"https://example.com"}}]`,
+			next:  `[{"name":"A","parameters":{"url":"https://example.com","options":{},"sendBody":false}}]`,
+			want:  true,
+		},
+		{
+			name:  "error case - non-default parameter added in the n8n UI",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[{"name":"A","parameters":{"url":"https://example.com"}}]`,
+			next:  `[{"name":"A","parameters":{"url":"https://example.com","method":"POST"}}]`,
+			want:  false,
+		},
+		{
+			name:  "error case - parameters added to a node without parameters",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[{"name":"A"}]`,
+			next:  `[{"name":"A","parameters":{"options":{"timeout":10000}}}]`,
+			want:  false,
+		},
+		{
+			name:  "nodes with empty parameters omitted by n8n",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[{"name":"A","parameters":{}}]`,
+			next:  `[{"name":"A"}]`,
+			want:  true,
+		},
+		{
+			name:  "error case - changed parameter",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[{"name":"A","parameters":{"url":"https://example.com"}}]`,
+			next:  `[{"name":"A","parameters":{"url":"https://example.org"}}]`,
+			want:  false,
+		},
+		{
+			name:  "error case - changed generated id",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[{"id":"1","name":"A"}]`,
+			next:  `[{"id":"2","name":"A"}]`,
+			want:  false,
+		},
+		{
+			name:  "error case - renamed node",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[{"name":"A"}]`,
+			next:  `[{"name":"B"}]`,
+			want:  false,
+		},
+		{
+			name:  "error case - added node",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[{"name":"A"}]`,
+			next:  `[{"name":"A"},{"name":"B"}]`,
+			want:  false,
+		},
+		{
+			name:  "error case - added node field",
+			kind:  jsontypes.KIND_NODES,
+			prior: `[{"name":"A"}]`,
+			next:  `[{"name":"A","disabled":true}]`,
+			want:  false,
+		},
+		{
+			name:  "connections with different key order",
+			kind:  jsontypes.KIND_CONNECTIONS,
+			prior: `{"A":{"main":[[{"node":"B","type":"main","index":0}]]}}`,
+			next:  `{"A":{"main":[[{"index":0,"node":"B","type":"main"}]]}}`,
+			want:  true,
+		},
+		{
+			name:  "connections with empty sources",
+			kind:  jsontypes.KIND_CONNECTIONS,
+			prior: `{"A":{"main":[[{"node":"B","type":"main","index":0}]]}}`,
+			next:  `{"A":{"main":[[{"index":0,"node":"B","type":"main"}]]},"B":{"main":[[]]}}`,
+			want:  true,
+		},
+		{
+			name:  "error case - changed connection target",
+			kind:  jsontypes.KIND_CONNECTIONS,
+			prior: `{"A":{"main":[[{"node":"B","type":"main","index":0}]]}}`,
+			next:  `{"A":{"main":[[{"node":"C","type":"main","index":0}]]}}`,
+			want:  false,
+		},
+		{
+			name:  "settings with server defaults",
+			kind:  jsontypes.KIND_SETTINGS,
+			prior: `{"executionOrder":"v1"}`,
+			next:  `{"availableInMCP":false,"callerPolicy":"workflowsFromSameOwner","executionOrder":"v1"}`,
+			want:  true,
+		},
+		{
+			name:  "error case - non-default caller policy",
+			kind:  jsontypes.KIND_SETTINGS,
+			prior: `{"executionOrder":"v1"}`,
+			next:  `{"callerPolicy":"any","executionOrder":"v1"}`,
+			want:  false,
+		},
+		{
+			name:  "parameters defaulted by n8n",
+			kind:  jsontypes.KIND_PARAMETERS,
+			prior: `{"path":"hook","n":1}`,
+			next:  `{"n":1.0,"options":{},"path":"hook","responseData":"","rawBody":false}`,
+			want:  true,
+		},
+		{
+			name:  "error case - non-default parameter added in the n8n UI",
+			kind:  jsontypes.KIND_PARAMETERS,
+			prior: `{"path":"hook"}`,
+			next:  `{"httpMethod":"POST","path":"hook"}`,
+			want:  false,
+		},
+		{
+			name:  "error case - nested non-default parameter added in the n8n UI",
+			kind:  jsontypes.KIND_PARAMETERS,
+			prior: `{"path":"hook","options":{}}`,
+			next:  `{"path":"hook","options":{"rawBody":true}}`,
+			want:  false,
+		},
+		{
+			name:  "error case - changed parameter",
+			kind:  jsontypes.KIND_PARAMETERS,
+			prior: `{"path":"hook"}`,
+			next:  `{"path":"other"}`,
+			want:  false,
+		},
+		{
+			name:  "error case - invalid JSON",
+			kind:  jsontypes.KIND_SETTINGS,
+			prior: `{`,
+			next:  `{}`,
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, jsontypes.SemanticallyEqual(tt.kind, tt.prior, tt.next))
+		})
+	}
+}
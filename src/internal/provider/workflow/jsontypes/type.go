@@ -0,0 +1,291 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+// Package jsontypes provides the JSON string types of the workflow attributes.
+// Their semantic equality ignores the differences n8n introduces when it
+// re-serializes a workflow, so that applying or refreshing does not show a diff.
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Kind identifies the workflow JSON attribute a value belongs to.
+type Kind int
+
+const (
+	// KIND_NODES is the kind of nodes_json.
+	KIND_NODES Kind = iota
+	// KIND_CONNECTIONS is the kind of connections_json.
+	KIND_CONNECTIONS
+	// KIND_SETTINGS is the kind of settings_json.
+	KIND_SETTINGS
+	// KIND_PARAMETERS is the kind of node parameters.
+	KIND_PARAMETERS
+)
+
+// kindNames are the names of the kinds, used in type descriptions.
+var kindNames map[Kind]string = map[Kind]string{
+	KIND_NODES:       "nodes",
+	KIND_CONNECTIONS: "connections",
+	KIND_SETTINGS:    "settings",
+	KIND_PARAMETERS:  "parameters",
+}
+
+// Ensure the types implement required interfaces.
+var (
+	_ basetypes.StringTypable                    = Type{}
+	_ basetypes.StringValuableWithSemanticEquals = Value{}
+)
+
+// Type is a string type holding workflow JSON of a given kind.
+type Type struct {
+	basetypes.StringType
+
+	// Kind is the workflow attribute the type belongs to.
+	Kind Kind
+}
+
+// NodesType is the type of nodes_json.
+var NodesType Type = Type{Kind: KIND_NODES}
+
+// ConnectionsType is the type of connections_json.
+var ConnectionsType Type = Type{Kind: KIND_CONNECTIONS}
+
+// SettingsType is the type of settings_json.
+var SettingsType Type = Type{Kind: KIND_SETTINGS}
+
+// ParametersType is the type of node parameters.
+var ParametersType Type = Type{Kind: KIND_PARAMETERS}
+
+// Equal returns true if the given type is the same type of the same kind.
+//
+// Params:
+//   - o: type to compare
+//
+// Returns:
+//   - bool: true if the types are equal
+func (t Type) Equal(o attr.Type) bool {
+	other, ok := o.(Type)
+	// Check type.
+	if !ok {
+		// Return not equal.
+		return false
+	}
+
+	// Return comparison.
+	return t.Kind == other.Kind
+}
+
+// String returns a human readable description of the type.
+//
+// Returns:
+//   - string: type description
+func (t Type) String() string {
+	// Return description.
+	return fmt.Sprintf("jsontypes.Type[%s]", kindNames[t.Kind])
+}
+
+// ValueFromString converts a string value to a value of this type.
+//
+// Params:
+//   - _ctx: context (unused)
+//   - in: string value
+//
+// Returns:
+//   - basetypes.StringValuable: converted value
+//   - diag.Diagnostics: conversion diagnostics
+func (t Type) ValueFromString(_ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	// Return value.
+	return Value{StringValue: in, kind: t.Kind}, nil
+}
+
+// ValueFromTerraform converts a Terraform value to a value of this type.
+//
+// Params:
+//   - ctx: context for the conversion
+//   - in: Terraform value
+//
+// Returns:
+//   - attr.Value: converted value
+//   - error: conversion error
+func (t Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	// Check for conversion error.
+	if err != nil {
+		// Return error.
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	// Check type.
+	if !ok {
+		// Return error.
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	// Check for conversion error.
+	if diags.HasError() {
+		// Return error.
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	// Return value.
+	return stringValuable, nil
+}
+
+// ValueType returns the value type of this type.
+//
+// Params:
+//   - _ctx: context (unused)
+//
+// Returns:
+//   - attr.Value: zero value of this type
+func (t Type) ValueType(_ctx context.Context) attr.Value {
+	// Return zero value.
+	return Value{kind: t.Kind}
+}
+
+// Value is a string value holding workflow JSON of a given kind.
+type Value struct {
+	basetypes.StringValue
+
+	// kind is the workflow attribute the value belongs to.
+	kind Kind
+}
+
+// NewNodesValue creates a known nodes_json value.
+//
+// Params:
+//   - value: JSON array of nodes
+//
+// Returns:
+//   - Value: nodes value
+func NewNodesValue(value string) Value {
+	// Return value.
+	return Value{StringValue: basetypes.NewStringValue(value), kind: KIND_NODES}
+}
+
+// NewConnectionsValue creates a known connections_json value.
+//
+// Params:
+//   - value: JSON object of connections
+//
+// Returns:
+//   - Value: connections value
+func NewConnectionsValue(value string) Value {
+	// Return value.
+	return Value{StringValue: basetypes.NewStringValue(value), kind: KIND_CONNECTIONS}
+}
+
+// NewSettingsValue creates a known settings_json value.
+//
+// Params:
+//   - value: JSON object of settings
+//
+// Returns:
+//   - Value: settings value
+func NewSettingsValue(value string) Value {
+	// Return value.
+	return Value{StringValue: basetypes.NewStringValue(value), kind: KIND_SETTINGS}
+}
+
+// NewParametersValue creates a known node parameters value.
+//
+// Params:
+//   - value: JSON object of parameters
+//
+// Returns:
+//   - Value: parameters value
+func NewParametersValue(value string) Value {
+	// Return value.
+	return Value{StringValue: basetypes.NewStringValue(value), kind: KIND_PARAMETERS}
+}
+
+// NewNull creates a null value of the given kind.
+//
+// Params:
+//   - kind: workflow attribute kind
+//
+// Returns:
+//   - Value: null value
+func NewNull(kind Kind) Value {
+	// Return value.
+	return Value{StringValue: basetypes.NewStringNull(), kind: kind}
+}
+
+// NewUnknown creates an unknown value of the given kind.
+//
+// Params:
+//   - kind: workflow attribute kind
+//
+// Returns:
+//   - Value: unknown value
+func NewUnknown(kind Kind) Value {
+	// Return value.
+	return Value{StringValue: basetypes.NewStringUnknown(), kind: kind}
+}
+
+// Type returns the type of the value.
+//
+// Params:
+//   - _ctx: context (unused)
+//
+// Returns:
+//   - attr.Type: value type
+func (v Value) Type(_ctx context.Context) attr.Type {
+	// Return type.
+	return Type{Kind: v.kind}
+}
+
+// Equal returns true if the given value has the same kind and the same string.
+//
+// Params:
+//   - o: value to compare
+//
+// Returns:
+//   - bool: true if the values are equal
+func (v Value) Equal(o attr.Value) bool {
+	other, ok := o.(Value)
+	// Check type.
+	if !ok {
+		// Return not equal.
+		return false
+	}
+
+	// Return comparison.
+	return v.kind == other.kind && v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the new value, as returned by n8n, describes the same workflow data.
+// The receiver is the prior value, from the configuration or the state.
+//
+// Params:
+//   - _ctx: context (unused)
+//   - newValuable: new value
+//
+// Returns:
+//   - bool: true if the values are semantically equal
+//   - diag.Diagnostics: comparison diagnostics
+func (v Value) StringSemanticEquals(_ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newValue, ok := newValuable.(Value)
+	// Check type.
+	if !ok {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. This is always an error in the provider.", v, newValuable),
+		)
+		// Return error.
+		return false, diags
+	}
+
+	// Return comparison.
+	return SemanticallyEqual(v.kind, v.ValueString(), newValue.ValueString()), nil
+}
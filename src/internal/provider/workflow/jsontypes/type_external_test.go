@@ -0,0 +1,114 @@
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestType_ValueFromTerraform tests the conversion of Terraform values.
+func TestType_ValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		typ   jsontypes.Type
+		value tftypes.Value
+		want  jsontypes.Value
+	}{
+		{
+			name:  "known nodes",
+			typ:   jsontypes.NodesType,
+			value: tftypes.NewValue(tftypes.String, `[]`),
+			want:  jsontypes.NewNodesValue(`[]`),
+		},
+		{
+			name:  "null connections",
+			typ:   jsontypes.ConnectionsType,
+			value: tftypes.NewValue(tftypes.String, nil),
+			want:  jsontypes.NewNull(jsontypes.KIND_CONNECTIONS),
+		},
+		{
+			name:  "unknown settings",
+			typ:   jsontypes.SettingsType,
+			value: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			want:  jsontypes.NewUnknown(jsontypes.KIND_SETTINGS),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.typ.ValueFromTerraform(context.Background(), tt.value)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got))
+			assert.True(t, tt.typ.Equal(got.Type(context.Background())))
+		})
+	}
+}
+
+// TestType_Equal tests type equality.
+func TestType_Equal(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, jsontypes.NodesType.Equal(jsontypes.NodesType))
+	assert.False(t, jsontypes.NodesType.Equal(jsontypes.SettingsType))
+	assert.False(t, jsontypes.NodesType.Equal(types.StringType))
+	assert.Equal(t, "jsontypes.Type[connections]", jsontypes.ConnectionsType.String())
+}
+
+// TestValue_StringSemanticEquals tests the semantic equality of values.
+func TestValue_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		prior    jsontypes.Value
+		next     types.String
+		useValue bool
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name:     "equal settings",
+			prior:    jsontypes.NewSettingsValue(`{}`),
+			next:     types.StringValue(`{"callerPolicy":"workflowsFromSameOwner"}`),
+			useValue: true,
+			want:     true,
+		},
+		{
+			name:     "different settings",
+			prior:    jsontypes.NewSettingsValue(`{}`),
+			next:     types.StringValue(`{"timezone":"UTC"}`),
+			useValue: true,
+			want:     false,
+		},
+		{
+			name:    "error case - unexpected value type",
+			prior:   jsontypes.NewSettingsValue(`{}`),
+			next:    types.StringValue(`{}`),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			got, diags := tt.prior.StringSemanticEquals(ctx, tt.next)
+			// Convert to the custom type when requested.
+			if tt.useValue {
+				next, conversionDiags := jsontypes.SettingsType.ValueFromString(ctx, tt.next)
+				require.False(t, conversionDiags.HasError())
+				got, diags = tt.prior.StringSemanticEquals(ctx, next)
+			}
+			assert.Equal(t, tt.wantErr, diags.HasError())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
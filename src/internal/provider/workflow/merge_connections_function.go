@@ -0,0 +1,195 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure MergeConnectionsFunction implements required interfaces.
+var _ function.Function = &MergeConnectionsFunction{}
+
+// MergeConnectionsFunction merges connection JSONs into the n8n connections format.
+type MergeConnectionsFunction struct{}
+
+// connectionEdge is a connection as serialized in connection_json.
+type connectionEdge struct {
+	SourceNode        string `json:"source_node"`
+	SourceOutput      string `json:"source_output"`
+	SourceOutputIndex int64  `json:"source_output_index"`
+	TargetNode        string `json:"target_node"`
+	TargetInput       string `json:"target_input"`
+	TargetInputIndex  int64  `json:"target_input_index"`
+}
+
+// connectionTarget is a connection target in the n8n connections format.
+// Fields are in alphabetical order so the output matches the connections read back from n8n.
+type connectionTarget struct {
+	Index int64  `json:"index"`
+	Node  string `json:"node"`
+	Type  string `json:"type"`
+}
+
+// NewMergeConnectionsFunction creates a new MergeConnectionsFunction instance.
+//
+// Returns:
+//   - function.Function: the merge_connections function
+func NewMergeConnectionsFunction() function.Function {
+	// Return new instance.
+	return &MergeConnectionsFunction{}
+}
+
+// Metadata returns the function name.
+//
+// Params:
+//   - _ctx: The context for the request (unused).
+//   - _req: The metadata request (unused).
+//   - resp: The metadata response to populate.
+func (f *MergeConnectionsFunction) Metadata(_ctx context.Context, _req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "merge_connections"
+}
+
+// Definition defines the parameters and return type of the function.
+//
+// Params:
+//   - _ctx: The context for the request (unused).
+//   - _req: The definition request (unused).
+//   - resp: The definition response to populate.
+func (f *MergeConnectionsFunction) Definition(_ctx context.Context, _req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merges connections into the n8n connections format",
+		MarkdownDescription: "Merges connection JSONs, as returned by `connect` or the `connection_json` of the " +
+			"`n8n_workflow_connection` resource, into the n8n connections format expected by `connections_json`. " +
+			"Duplicate connections are merged.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "connections",
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of connection JSONs",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run merges the connections.
+//
+// Params:
+//   - ctx: The context for the request.
+//   - req: The run request containing the arguments.
+//   - resp: The run response to populate.
+func (f *MergeConnectionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var connections []string
+
+	resp.Error = req.Arguments.Get(ctx, &connections)
+	// Check for argument errors.
+	if resp.Error != nil {
+		return
+	}
+
+	merged, funcErr := mergeConnections(connections)
+	// Check for merge errors.
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, merged)
+}
+
+// mergeConnections groups connection JSONs by source node, output type and output index.
+//
+// Params:
+//   - connections: Connection JSONs
+//
+// Returns:
+//   - string: Connections in the n8n format
+//   - *function.FuncError: Error if a connection is invalid
+func mergeConnections(connections []string) (string, *function.FuncError) {
+	merged := map[string]map[string][][]connectionTarget{}
+	// Add each connection.
+	for i, raw := range connections {
+		var edge connectionEdge
+		// Check for parse error.
+		if err := json.Unmarshal([]byte(raw), &edge); err != nil {
+			// Return error.
+			return "", function.NewArgumentFuncError(0, fmt.Sprintf("Invalid connection at index %d: %s", i, err.Error()))
+		}
+		// Check required fields.
+		if edge.SourceNode == "" || edge.TargetNode == "" {
+			// Return error.
+			return "", function.NewArgumentFuncError(0, fmt.Sprintf("Invalid connection at index %d: source_node and target_node are required", i))
+		}
+		// Check indexes.
+		if !validConnectionIndex(edge.SourceOutputIndex) || !validConnectionIndex(edge.TargetInputIndex) {
+			// Return error.
+			return "", function.NewArgumentFuncError(0, fmt.Sprintf("Invalid connection at index %d: indexes must be between 0 and %d", i, MAX_CONNECTION_INDEX))
+		}
+		// Apply the resource defaults.
+		if edge.SourceOutput == "" {
+			edge.SourceOutput = DEFAULT_OUTPUT_TYPE
+		}
+		// Apply the resource defaults.
+		if edge.TargetInput == "" {
+			edge.TargetInput = DEFAULT_INPUT_TYPE
+		}
+
+		addConnectionEdge(merged, edge)
+	}
+
+	encoded, err := json.Marshal(merged)
+	// Check for encoding error.
+	if err != nil {
+		// Return error.
+		return "", function.NewFuncError(fmt.Sprintf("Could not encode connections: %s", err.Error()))
+	}
+
+	// Return merged connections.
+	return string(encoded), nil
+}
+
+// validConnectionIndex checks that an output or input index is within the connection bounds.
+//
+// Params:
+//   - index: The output or input index
+//
+// Returns:
+//   - bool: True if the index is between 0 and MAX_CONNECTION_INDEX
+func validConnectionIndex(index int64) bool {
+	// Return result.
+	return index >= 0 && index <= MAX_CONNECTION_INDEX
+}
+
+// addConnectionEdge adds a connection to connections in the n8n format.
+// Output indexes without connections are kept as empty lists, as n8n does, and duplicates are skipped.
+//
+// Params:
+//   - merged: Connections keyed by source node and output type
+//   - edge: The connection to add, with defaults applied
+func addConnectionEdge(merged map[string]map[string][][]connectionTarget, edge connectionEdge) {
+	outputs, found := merged[edge.SourceNode]
+	// Create the source entry.
+	if !found {
+		outputs = map[string][][]connectionTarget{}
+		merged[edge.SourceNode] = outputs
+	}
+	slots := outputs[edge.SourceOutput]
+	// Pad missing output indexes.
+	for int64(len(slots)) <= edge.SourceOutputIndex {
+		slots = append(slots, []connectionTarget{})
+	}
+	target := connectionTarget{Index: edge.TargetInputIndex, Node: edge.TargetNode, Type: edge.TargetInput}
+	// Skip duplicates.
+	if !slices.Contains(slots[edge.SourceOutputIndex], target) {
+		slots[edge.SourceOutputIndex] = append(slots[edge.SourceOutputIndex], target)
+	}
+	outputs[edge.SourceOutput] = slots
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeConnectionsFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		connections []string
+		want        string
+		wantErr     string
+	}{
+		{
+			name:        "empty list",
+			connections: []string{},
+			want:        `{}`,
+		},
+		{
+			name: "connections are grouped by source and output",
+			connections: []string{
+				`{"source_node":"Webhook","source_output":"main","source_output_index":0,"target_node":"IF","target_input":"main","target_input_index":0}`,
+				`{"source_node":"IF","source_output":"main","source_output_index":1,"target_node":"Slack","target_input":"main","target_input_index":0}`,
+				`{"source_node":"IF","source_output":"main","source_output_index":1,"target_node":"Email","target_input":"main","target_input_index":0}`,
+			},
+			want: `{"IF":{"main":[[],[{"index":0,"node":"Slack","type":"main"},{"index":0,"node":"Email","type":"main"}]]},` +
+				`"Webhook":{"main":[[{"index":0,"node":"IF","type":"main"}]]}}`,
+		},
+		{
+			name: "duplicates are merged and defaults applied",
+			connections: []string{
+				`{"source_node":"Webhook","target_node":"IF"}`,
+				`{"source_node":"Webhook","source_output":"main","source_output_index":0,"target_node":"IF","target_input":"main","target_input_index":0}`,
+			},
+			want: `{"Webhook":{"main":[[{"index":0,"node":"IF","type":"main"}]]}}`,
+		},
+		{
+			name:        "error case - invalid JSON",
+			connections: []string{`{invalid`},
+			wantErr:     "Invalid connection at index 0",
+		},
+		{
+			name:        "error case - missing target",
+			connections: []string{`{"source_node":"Webhook"}`},
+			wantErr:     "source_node and target_node are required",
+		},
+		{
+			name:        "error case - negative index",
+			connections: []string{`{"source_node":"Webhook","source_output_index":-1,"target_node":"IF"}`},
+			wantErr:     "indexes must be between 0 and 1000",
+		},
+		{
+			name:        "error case - index above the bound",
+			connections: []string{`{"source_node":"Webhook","target_node":"IF","target_input_index":1000000000}`},
+			wantErr:     "indexes must be between 0 and 1000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			elements := make([]attr.Value, 0, len(tt.connections))
+			for _, connection := range tt.connections {
+				elements = append(elements, types.StringValue(connection))
+			}
+
+			result, funcErr := runFunction(t, NewMergeConnectionsFunction(), types.StringUnknown(),
+				types.ListValueMust(types.StringType, elements),
+			)
+
+			if tt.wantErr != "" {
+				require.NotNil(t, funcErr)
+				assert.Contains(t, funcErr.Text, tt.wantErr)
+				return
+			}
+			require.Nil(t, funcErr)
+			assert.Equal(t, types.StringValue(tt.want), result)
+		})
+	}
+}
+
+func TestMergeConnectionsFunction_ConnectOutput(t *testing.T) {
+	t.Parallel()
+
+	connection, funcErr := runFunction(t, NewConnectFunction(), types.StringUnknown(),
+		types.StringValue("Webhook"), types.StringValue("IF"), types.DynamicNull(),
+	)
+	require.Nil(t, funcErr)
+
+	result, funcErr := runFunction(t, NewMergeConnectionsFunction(), types.StringUnknown(),
+		types.ListValueMust(types.StringType, []attr.Value{connection}),
+	)
+	require.Nil(t, funcErr)
+	assert.Equal(t, types.StringValue(`{"Webhook":{"main":[[{"index":0,"node":"IF","type":"main"}]]}}`), result)
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Connection describes a connection block of the workflow resource.
+// It links an output of a source node to an input of a target node.
+type Connection struct {
+	From       types.String `tfsdk:"from"`
+	FromOutput types.String `tfsdk:"from_output"`
+	FromIndex  types.Int64  `tfsdk:"from_index"`
+	To         types.String `tfsdk:"to"`
+	ToInput    types.String `tfsdk:"to_input"`
+	ToIndex    types.Int64  `tfsdk:"to_index"`
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
+)
+
+// Node describes a node of the nodes attribute of the workflow resource, keyed by node name.
+// Each field maps to the field of the same name of an n8n workflow node.
+type Node struct {
+	ID               types.String    `tfsdk:"id"`
+	Type             types.String    `tfsdk:"type"`
+	TypeVersion      types.Float64   `tfsdk:"type_version"`
+	Position         types.List      `tfsdk:"position"`
+	Parameters       jsontypes.Value `tfsdk:"parameters"`
+	Credentials      types.Map       `tfsdk:"credentials"`
+	WebhookID        types.String    `tfsdk:"webhook_id"`
+	Disabled         types.Bool      `tfsdk:"disabled"`
+	Notes            types.String    `tfsdk:"notes"`
+	NotesInFlow      types.Bool      `tfsdk:"notes_in_flow"`
+	ExecuteOnce      types.Bool      `tfsdk:"execute_once"`
+	AlwaysOutputData types.Bool      `tfsdk:"always_output_data"`
+	RetryOnFail      types.Bool      `tfsdk:"retry_on_fail"`
+	ContinueOnFail   types.Bool      `tfsdk:"continue_on_fail"`
+	MaxTries         types.Int64     `tfsdk:"max_tries"`
+	WaitBetweenTries types.Int64     `tfsdk:"wait_between_tries"`
+	OnError          types.String    `tfsdk:"on_error"`
+}
+
+// NodeCredential describes a credential used by a node.
+type NodeCredential struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
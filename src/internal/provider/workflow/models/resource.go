@@ -6,25 +6,32 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
 )
 
 // Resource describes the workflow resource data model.
 // Maps n8n workflow attributes to Terraform schema, including nodes, connections, and settings.
 type Resource struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Active          types.Bool   `tfsdk:"active"`
-	Tags            types.Set    `tfsdk:"tags"`
-	ProjectID       types.String `tfsdk:"project_id"`
-	NodesJSON       types.String `tfsdk:"nodes_json"`
-	ConnectionsJSON types.String `tfsdk:"connections_json"`
-	SettingsJSON    types.String `tfsdk:"settings_json"`
-	CreatedAt       types.String `tfsdk:"created_at"`
-	UpdatedAt       types.String `tfsdk:"updated_at"`
-	VersionID       types.String `tfsdk:"version_id"`
-	IsArchived      types.Bool   `tfsdk:"is_archived"`
-	TriggerCount    types.Int64  `tfsdk:"trigger_count"`
-	Meta            types.Map    `tfsdk:"meta"`
-	PinData         types.Map    `tfsdk:"pin_data"`
+	ID              types.String    `tfsdk:"id"`
+	Name            types.String    `tfsdk:"name"`
+	Active          types.Bool      `tfsdk:"active"`
+	Tags            types.Set       `tfsdk:"tags"`
+	TagsAll         types.Set       `tfsdk:"tags_all"`
+	ProjectID       types.String    `tfsdk:"project_id"`
+	NodesJSON       jsontypes.Value `tfsdk:"nodes_json"`
+	ConnectionsJSON jsontypes.Value `tfsdk:"connections_json"`
+	SettingsJSON    jsontypes.Value `tfsdk:"settings_json"`
+	Nodes           types.Map       `tfsdk:"nodes"`
+	Connections     types.Set       `tfsdk:"node_connection"`
+	Settings        types.Object    `tfsdk:"settings"`
+	CreatedAt       types.String    `tfsdk:"created_at"`
+	UpdatedAt       types.String    `tfsdk:"updated_at"`
+	VersionID       types.String    `tfsdk:"version_id"`
+	IsArchived      types.Bool      `tfsdk:"is_archived"`
+	TriggerCount    types.Int64     `tfsdk:"trigger_count"`
+	Meta            types.Map       `tfsdk:"meta"`
+	PinData         types.Map       `tfsdk:"pin_data"`
+	Timeouts        timeouts.Value  `tfsdk:"timeouts"`
 }
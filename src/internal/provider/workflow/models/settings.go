@@ -0,0 +1,26 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Settings describes the settings attribute of the workflow resource.
+// Each field maps to a field of the n8n workflow settings.
+type Settings struct {
+	Timezone                 types.String `tfsdk:"timezone"`
+	ErrorWorkflow            types.String `tfsdk:"error_workflow"`
+	ExecutionTimeout         types.Int64  `tfsdk:"execution_timeout"`
+	SaveDataErrorExecution   types.String `tfsdk:"save_data_error_execution"`
+	SaveDataSuccessExecution types.String `tfsdk:"save_data_success_execution"`
+	SaveExecutionProgress    types.Bool   `tfsdk:"save_execution_progress"`
+	SaveManualExecutions     types.Bool   `tfsdk:"save_manual_executions"`
+	ExecutionOrder           types.String `tfsdk:"execution_order"`
+	CallerPolicy             types.String `tfsdk:"caller_policy"`
+	CallerIDs                types.List   `tfsdk:"caller_ids"`
+	TimeSavedPerExecution    types.Int64  `tfsdk:"time_saved_per_execution"`
+	AvailableInMCP           types.Bool   `tfsdk:"available_in_mcp"`
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/nodecatalog"
+)
+
+// communityNodeAllowlist returns the node types and packages skipped by the node catalog validation.
+//
+// Params:
+//   - n8nClient: The provider client, nil when the provider is not configured
+//
+// Returns:
+//   - []string: The provider community_node_allowlist
+func communityNodeAllowlist(n8nClient *client.N8nClient) []string {
+	// Check for a configured provider.
+	if n8nClient == nil {
+		// Return no allowlist.
+		return nil
+	}
+	// Return allowlist.
+	return n8nClient.CommunityNodeAllowlist
+}
+
+// parameterNames returns the names of node parameters.
+//
+// Params:
+//   - parameters: The node parameters as a JSON object
+//
+// Returns:
+//   - []string: The parameter names, nil if the parameters are not a JSON object
+func parameterNames(parameters string) []string {
+	var raw map[string]json.RawMessage
+	// Invalid parameters are reported on apply.
+	if err := json.Unmarshal([]byte(parameters), &raw); err != nil {
+		// Return unknown names.
+		return nil
+	}
+	// Return names.
+	return slices.Collect(maps.Keys(raw))
+}
+
+// validateNodeCatalog checks workflow nodes against the node catalog embedded in the provider.
+// Community node types and type versions unknown to the catalog are errors, unknown built-in
+// node types and unknown parameters are warnings.
+//
+// Params:
+//   - catalog: The node catalog
+//   - nodes: The nodes to check
+//   - allowlist: The node types and packages to skip
+//   - diags: Diagnostics for error reporting
+func validateNodeCatalog(catalog *nodecatalog.Catalog, nodes []graphNode, allowlist []string, diags *diag.Diagnostics) {
+	// Check each node.
+	for i := range nodes {
+		validateCatalogNode(catalog, &nodes[i], allowlist, diags)
+	}
+}
+
+// validateCatalogNode checks a node against the node catalog.
+//
+// Params:
+//   - catalog: The node catalog
+//   - node: The node to check
+//   - allowlist: The node types and packages to skip
+//   - diags: Diagnostics for error reporting
+func validateCatalogNode(catalog *nodecatalog.Catalog, node *graphNode, allowlist []string, diags *diag.Diagnostics) {
+	// Skip missing types, rejected by n8n, and allowlisted types.
+	if node.nodeType == "" || nodecatalog.Allowlisted(node.nodeType, allowlist) {
+		// Return early.
+		return
+	}
+
+	nodeType, ok := catalog.Lookup(node.nodeType)
+	// Check for an unknown node type.
+	if !ok {
+		reportUnknownNodeType(node, diags)
+		// Return early.
+		return
+	}
+
+	// Check for an unsupported type version.
+	if node.typeVersion != 0 && !nodeType.SupportsVersion(node.typeVersion) {
+		diags.AddAttributeError(
+			node.typeVersionPath,
+			"Unsupported Node Type Version",
+			fmt.Sprintf("Node %q has type version %s, which %q does not support. Supported versions: %s.",
+				node.name, formatTypeVersion(node.typeVersion), node.nodeType, formatTypeVersions(nodeType.Versions)),
+		)
+	}
+
+	// Check for unknown parameters.
+	if unknown := nodeType.UnknownParameters(node.parameters); len(unknown) > 0 {
+		diags.AddAttributeWarning(
+			node.parametersPath,
+			"Unknown Node Parameters",
+			fmt.Sprintf("Node %q sets parameters that %q does not declare, and that have no effect: %s.",
+				node.name, node.nodeType, strings.Join(unknown, ", ")),
+		)
+	}
+}
+
+// reportUnknownNodeType reports a node type missing from the node catalog.
+// Community node types are errors, built-in node types are warnings.
+//
+// Params:
+//   - node: The node
+//   - diags: Diagnostics for error reporting
+func reportUnknownNodeType(node *graphNode, diags *diag.Diagnostics) {
+	// Check for a community node.
+	if !nodecatalog.IsBuiltIn(node.nodeType) {
+		diags.AddAttributeError(
+			node.typePath,
+			"Unknown Community Node Type",
+			fmt.Sprintf("Node %q has type %q, which is not shipped with n8n. "+
+				"Add its package %q to the provider community_node_allowlist to use community nodes.",
+				node.name, node.nodeType, nodecatalog.Package(node.nodeType)),
+		)
+		// Return early.
+		return
+	}
+	// Warn only: the catalog lags behind n8n releases, which add built-in node types.
+	diags.AddAttributeWarning(
+		node.typePath,
+		"Unknown Node Type",
+		fmt.Sprintf("Node %q has type %q, which the node catalog of this provider version does not list. "+
+			"Check the type for a typo. If the node type was released after this provider version, "+
+			"add it to the provider community_node_allowlist to silence this warning.",
+			node.name, node.nodeType),
+	)
+}
+
+// formatTypeVersion formats a type version as n8n does (e.g., 2 or 4.2).
+//
+// Params:
+//   - version: The type version
+//
+// Returns:
+//   - string: The formatted version
+func formatTypeVersion(version float64) string {
+	// Return formatted version.
+	return strconv.FormatFloat(version, 'f', -1, 64)
+}
+
+// formatTypeVersions formats the supported type versions of a node type.
+//
+// Params:
+//   - versions: The type versions
+//
+// Returns:
+//   - string: The comma separated versions
+func formatTypeVersions(versions []float64) string {
+	formatted := make([]string, 0, len(versions))
+	// Format each version.
+	for _, version := range versions {
+		formatted = append(formatted, formatTypeVersion(version))
+	}
+	// Return versions.
+	return strings.Join(formatted, ", ")
+}
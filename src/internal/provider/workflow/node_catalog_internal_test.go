@@ -0,0 +1,270 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/nodecatalog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testNodeCatalog returns a catalog with a versioned node and a node without recorded details.
+func testNodeCatalog(t *testing.T) *nodecatalog.Catalog {
+	t.Helper()
+	catalog, err := nodecatalog.Parse([]byte(`{
+		"n8n_version": "1.0.0",
+		"nodes": {
+			"n8n-nodes-base.set": {"versions": [1, 2, 3.4], "parameters": ["mode", "values"]},
+			"n8n-nodes-base.slack": {}
+		}
+	}`))
+	require.NoError(t, err)
+
+	return catalog
+}
+
+// testCatalogNode returns a graph node with the paths of a node of the nodes attribute.
+func testCatalogNode(nodeType string, typeVersion float64, parameters ...string) graphNode {
+	return graphNode{
+		name:            "Node",
+		nodeType:        nodeType,
+		typeVersion:     typeVersion,
+		parameters:      parameters,
+		typePath:        nodePath("Node").AtName("type"),
+		typeVersionPath: nodePath("Node").AtName("type_version"),
+		parametersPath:  nodePath("Node").AtName("parameters"),
+	}
+}
+
+func Test_validateNodeCatalog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		node         graphNode
+		allowlist    []string
+		wantErrors   int
+		wantWarnings int
+		wantPath     path.Path
+	}{
+		{name: "known type, version and parameters", node: testCatalogNode("n8n-nodes-base.set", 3.4, "mode")},
+		{name: "type without recorded details", node: testCatalogNode("n8n-nodes-base.slack", 9, "anything")},
+		{name: "tool variant", node: testCatalogNode("n8n-nodes-base.slackTool", 1)},
+		{name: "unknown type version", node: testCatalogNode("n8n-nodes-base.set", 0)},
+		{name: "missing type", node: testCatalogNode("", 1)},
+		{name: "allowlisted community package", node: testCatalogNode("n8n-nodes-acme.invoice", 1), allowlist: []string{"n8n-nodes-acme"}},
+		{name: "allowlisted built-in type", node: testCatalogNode("n8n-nodes-base.newNode", 1), allowlist: []string{"n8n-nodes-base.newNode"}},
+		{
+			name:         "unknown parameters",
+			node:         testCatalogNode("n8n-nodes-base.set", 2, "mode", "keepOnly"),
+			wantWarnings: 1,
+			wantPath:     nodePath("Node").AtName("parameters"),
+		},
+		{
+			name:         "unknown built-in type",
+			node:         testCatalogNode("n8n-nodes-base.sett", 1),
+			wantWarnings: 1,
+			wantPath:     nodePath("Node").AtName("type"),
+		},
+		{
+			name:         "unknown built-in langchain type",
+			node:         testCatalogNode("@n8n/n8n-nodes-langchain.newAgent", 1),
+			wantWarnings: 1,
+			wantPath:     nodePath("Node").AtName("type"),
+		},
+		{
+			name:       "error case - community type not allowlisted",
+			node:       testCatalogNode("n8n-nodes-acme.invoice", 1),
+			allowlist:  []string{"n8n-nodes-other"},
+			wantErrors: 1,
+			wantPath:   nodePath("Node").AtName("type"),
+		},
+		{
+			name:       "error case - unsupported type version",
+			node:       testCatalogNode("n8n-nodes-base.set", 3.3),
+			wantErrors: 1,
+			wantPath:   nodePath("Node").AtName("type_version"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var diags diag.Diagnostics
+			validateNodeCatalog(testNodeCatalog(t), []graphNode{tt.node}, tt.allowlist, &diags)
+			assert.Len(t, diags.Errors(), tt.wantErrors)
+			assert.Len(t, diags.Warnings(), tt.wantWarnings)
+			// Check the reported attribute.
+			if len(diags) > 0 {
+				withPath, ok := diags[0].(diag.DiagnosticWithPath)
+				require.True(t, ok)
+				assert.Equal(t, tt.wantPath, withPath.Path())
+			}
+		})
+	}
+}
+
+func Test_parameterNames(t *testing.T) {
+	t.Parallel()
+
+	assert.ElementsMatch(t, []string{"path", "options"}, parameterNames(`{"path": "hook", "options": {}}`))
+	assert.Empty(t, parameterNames(`{}`))
+	assert.Nil(t, parameterNames(`[`), "invalid JSON")
+	assert.Nil(t, parameterNames(``), "unset parameters")
+}
+
+func Test_communityNodeAllowlist(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, communityNodeAllowlist(nil))
+	assert.Equal(t, []string{"n8n-nodes-acme"}, communityNodeAllowlist(&client.N8nClient{CommunityNodeAllowlist: []string{"n8n-nodes-acme"}}))
+}
+
+func TestWorkflowResource_modifyPlanWorkflowGraph_nodeCatalog(t *testing.T) {
+	t.Parallel()
+
+	community := `[{"name":"Invoice","type":"n8n-nodes-acme.invoice","typeVersion":1}]`
+
+	tests := []struct {
+		name    string
+		client  *client.N8nClient
+		wantErr bool
+	}{
+		{name: "skips allowlisted community nodes", client: &client.N8nClient{CommunityNodeAllowlist: []string{"n8n-nodes-acme"}}},
+		{name: "error case - provider not configured", client: nil, wantErr: true},
+		{name: "error case - community node not allowlisted", client: &client.N8nClient{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := NewWorkflowResource()
+			r.client = tt.client
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attrType := range objectType.AttributeTypes {
+				attrs[name] = tftypes.NewValue(attrType, nil)
+			}
+			attrs["nodes_json"] = tftypes.NewValue(tftypes.String, community)
+			raw := tftypes.NewValue(objectType, attrs)
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.modifyPlanWorkflowGraph(ctx, req, resp)
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestWorkflowNodeResource_ModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		nodeType     tftypes.Value
+		typeVersion  tftypes.Value
+		parameters   tftypes.Value
+		destroy      bool
+		wantErr      bool
+		wantWarnings int
+	}{
+		{
+			name:        "known node",
+			nodeType:    tftypes.NewValue(tftypes.String, "n8n-nodes-base.webhook"),
+			typeVersion: tftypes.NewValue(tftypes.Number, 2),
+			parameters:  tftypes.NewValue(tftypes.String, `{"path": "hook"}`),
+		},
+		{
+			name:        "unknown values are skipped",
+			nodeType:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			typeVersion: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			parameters:  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		{
+			name:    "destroy is skipped",
+			destroy: true,
+		},
+		{
+			name:         "unknown parameters",
+			nodeType:     tftypes.NewValue(tftypes.String, "n8n-nodes-base.webhook"),
+			typeVersion:  tftypes.NewValue(tftypes.Number, 1),
+			parameters:   tftypes.NewValue(tftypes.String, `{"paht": "hook"}`),
+			wantWarnings: 1,
+		},
+		{
+			name:         "unknown built-in type",
+			nodeType:     tftypes.NewValue(tftypes.String, "n8n-nodes-base.webhok"),
+			typeVersion:  tftypes.NewValue(tftypes.Number, 1),
+			parameters:   tftypes.NewValue(tftypes.String, `{}`),
+			wantWarnings: 1,
+		},
+		{
+			name:        "error case - community type not allowlisted",
+			nodeType:    tftypes.NewValue(tftypes.String, "n8n-nodes-acme.invoice"),
+			typeVersion: tftypes.NewValue(tftypes.Number, 1),
+			parameters:  tftypes.NewValue(tftypes.String, `{}`),
+			wantErr:     true,
+		},
+		{
+			name:        "error case - unsupported type version",
+			nodeType:    tftypes.NewValue(tftypes.String, "n8n-nodes-base.webhook"),
+			typeVersion: tftypes.NewValue(tftypes.Number, 7),
+			parameters:  tftypes.NewValue(tftypes.String, `{}`),
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := NewWorkflowNodeResource()
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			plan := tftypes.NewValue(objectType, nil)
+			// Build the planned node unless destroyed.
+			if !tt.destroy {
+				attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+				for name, attrType := range objectType.AttributeTypes {
+					attrs[name] = tftypes.NewValue(attrType, nil)
+				}
+				attrs["name"] = tftypes.NewValue(tftypes.String, "Webhook")
+				attrs["type"] = tt.nodeType
+				attrs["type_version"] = tt.typeVersion
+				attrs["parameters"] = tt.parameters
+				plan = tftypes.NewValue(objectType, attrs)
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			assert.Len(t, resp.Diagnostics.Warnings(), tt.wantWarnings)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+)
+
+// NODE_FUNCTION_OPTIONS_ARGUMENT is the position of the options argument of the node function.
+const NODE_FUNCTION_OPTIONS_ARGUMENT int64 = 3
+
+// nodeFunctionOptions are the options supported by the node function.
+var nodeFunctionOptions []string = []string{"type_version", "parameters", "webhook_id", "disabled", "notes"}
+
+// Ensure NodeFunction implements required interfaces.
+var _ function.Function = &NodeFunction{}
+
+// NodeFunction builds the JSON of a workflow node without storing it in the state.
+// Its output is identical to the node_json of the n8n_workflow_node resource.
+type NodeFunction struct{}
+
+// NewNodeFunction creates a new NodeFunction instance.
+//
+// Returns:
+//   - function.Function: the node function
+func NewNodeFunction() function.Function {
+	// Return new instance.
+	return &NodeFunction{}
+}
+
+// Metadata returns the function name.
+//
+// Params:
+//   - _ctx: The context for the request (unused).
+//   - _req: The metadata request (unused).
+//   - resp: The metadata response to populate.
+func (f *NodeFunction) Metadata(_ctx context.Context, _req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "node"
+}
+
+// Definition defines the parameters and return type of the function.
+//
+// Params:
+//   - _ctx: The context for the request (unused).
+//   - _req: The definition request (unused).
+//   - resp: The definition response to populate.
+func (f *NodeFunction) Definition(_ctx context.Context, _req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the JSON of a workflow node",
+		MarkdownDescription: "Builds the JSON of a workflow node for `nodes_json`, identical to the `node_json` " +
+			"of the `n8n_workflow_node` resource, without storing anything in the state.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Display name of the node (used in connections)",
+			},
+			function.StringParameter{
+				Name:                "type",
+				MarkdownDescription: "n8n node type (e.g., `n8n-nodes-base.webhook`)",
+			},
+			function.ListParameter{
+				Name:                "position",
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Position [x, y] coordinates for UI display",
+			},
+			function.DynamicParameter{
+				Name:           "options",
+				AllowNullValue: true,
+				MarkdownDescription: "Optional settings, or `null`: `type_version` (default 1), `parameters` " +
+					"(object or JSON string, default `{}`), `webhook_id`, `disabled` (default false) and `notes`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the node JSON.
+//
+// Params:
+//   - ctx: The context for the request.
+//   - req: The run request containing the arguments.
+//   - resp: The run response to populate.
+func (f *NodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, nodeType string
+	var position types.List
+	var options types.Dynamic
+
+	resp.Error = req.Arguments.Get(ctx, &name, &nodeType, &position, &options)
+	// Check for argument errors.
+	if resp.Error != nil {
+		return
+	}
+
+	plan, funcErr := buildNodeModel(ctx, name, nodeType, position, options)
+	// Check for option errors.
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	r := NewWorkflowNodeResource()
+	plan.ID = types.StringValue(r.generateNodeID(plan))
+	var diags diag.Diagnostics
+	// Check if JSON generation failed.
+	if !r.generateNodeJSON(ctx, plan, &diags) {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, plan.NodeJSON.ValueString())
+}
+
+// buildNodeModel builds the node resource model from the function arguments,
+// applying the defaults of the n8n_workflow_node resource.
+//
+// Params:
+//   - ctx: The context for the conversion.
+//   - name: Display name of the node.
+//   - nodeType: n8n node type.
+//   - position: Position coordinates.
+//   - options: Options argument.
+//
+// Returns:
+//   - *models.NodeResource: The node model.
+//   - *function.FuncError: Error if an option is invalid.
+func buildNodeModel(ctx context.Context, name, nodeType string, position types.List, options types.Dynamic) (*models.NodeResource, *function.FuncError) {
+	opts, funcErr := decodeFunctionOptions(ctx, options, NODE_FUNCTION_OPTIONS_ARGUMENT, nodeFunctionOptions)
+	// Check for decoding errors.
+	if funcErr != nil {
+		// Return error.
+		return nil, funcErr
+	}
+
+	plan := &models.NodeResource{
+		Name:     types.StringValue(name),
+		Type:     types.StringValue(nodeType),
+		Position: position,
+	}
+	var typeErr, paramsErr, webhookErr, disabledErr, notesErr *function.FuncError
+	plan.TypeVersion, typeErr = opts.Int64("type_version", DEFAULT_TYPE_VERSION)
+	plan.Parameters, paramsErr = opts.JSON("parameters", "{}")
+	plan.WebhookID, webhookErr = opts.String("webhook_id", types.StringNull())
+	plan.Disabled, disabledErr = opts.Bool("disabled", false)
+	plan.Notes, notesErr = opts.String("notes", types.StringNull())
+
+	// Return model.
+	return plan, function.ConcatFuncErrors(typeErr, paramsErr, webhookErr, disabledErr, notesErr)
+}
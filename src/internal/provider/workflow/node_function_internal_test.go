@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	position := types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(250), types.Int64Value(300)})
+
+	tests := []struct {
+		name    string
+		options types.Dynamic
+		plan    *models.NodeResource
+		wantErr string
+	}{
+		{
+			name:    "defaults match the resource",
+			options: types.DynamicNull(),
+			plan: &models.NodeResource{
+				Name:        types.StringValue("Webhook"),
+				Type:        types.StringValue("n8n-nodes-base.webhook"),
+				TypeVersion: types.Int64Value(DEFAULT_TYPE_VERSION),
+				Position:    position,
+				Parameters:  types.StringValue("{}"),
+				Disabled:    types.BoolValue(false),
+			},
+		},
+		{
+			name: "options match the resource",
+			options: dynamicOptions(t, map[string]attr.Value{
+				"type_version": types.Int64Value(2),
+				"parameters": types.ObjectValueMust(
+					map[string]attr.Type{"path": types.StringType, "httpMethod": types.StringType},
+					map[string]attr.Value{"path": types.StringValue("hook"), "httpMethod": types.StringValue("POST")},
+				),
+				"webhook_id": types.StringValue("hook-id"),
+				"disabled":   types.BoolValue(true),
+				"notes":      types.StringValue("Entry point"),
+			}),
+			plan: &models.NodeResource{
+				Name:        types.StringValue("Webhook"),
+				Type:        types.StringValue("n8n-nodes-base.webhook"),
+				TypeVersion: types.Int64Value(2),
+				Position:    position,
+				Parameters:  types.StringValue(`{"path":"hook","httpMethod":"POST"}`),
+				WebhookID:   types.StringValue("hook-id"),
+				Disabled:    types.BoolValue(true),
+				Notes:       types.StringValue("Entry point"),
+			},
+		},
+		{
+			name:    "error case - invalid parameters JSON",
+			options: dynamicOptions(t, map[string]attr.Value{"parameters": types.StringValue("{invalid")}),
+			wantErr: "Invalid parameters JSON",
+		},
+		{
+			name:    "error case - invalid option type",
+			options: dynamicOptions(t, map[string]attr.Value{"disabled": types.StringValue("yes")}),
+			wantErr: `Option "disabled" must be a boolean`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, funcErr := runFunction(t, NewNodeFunction(), types.StringUnknown(),
+				types.StringValue("Webhook"), types.StringValue("n8n-nodes-base.webhook"), position, tt.options,
+			)
+
+			if tt.wantErr != "" {
+				require.NotNil(t, funcErr)
+				assert.Contains(t, funcErr.Text, tt.wantErr)
+				return
+			}
+			require.Nil(t, funcErr)
+
+			// The function output is byte-identical to the resource node_json.
+			r := NewWorkflowNodeResource()
+			tt.plan.ID = types.StringValue(r.generateNodeID(tt.plan))
+			var diags diag.Diagnostics
+			require.True(t, r.generateNodeJSON(context.Background(), tt.plan, &diags))
+			assert.Equal(t, tt.plan.NodeJSON, result)
+		})
+	}
+}
+
+func TestNodeFunction_Definition(t *testing.T) {
+	t.Parallel()
+
+	f := NewNodeFunction()
+	metaResp := &function.MetadataResponse{}
+	f.Metadata(context.Background(), function.MetadataRequest{}, metaResp)
+	defResp := &function.DefinitionResponse{}
+	f.Definition(context.Background(), function.DefinitionRequest{}, defResp)
+
+	assert.Equal(t, "node", metaResp.Name)
+	assert.Len(t, defResp.Definition.Parameters, int(NODE_FUNCTION_OPTIONS_ARGUMENT)+1)
+	assert.False(t, defResp.Diagnostics.HasError())
+}
@@ -22,7 +22,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/nodecatalog"
 )
 
 const (
@@ -37,6 +39,7 @@ var (
 	_ resource.Resource                = &WorkflowNodeResource{}
 	_ resource.ResourceWithConfigure   = &WorkflowNodeResource{}
 	_ resource.ResourceWithImportState = &WorkflowNodeResource{}
+	_ resource.ResourceWithModifyPlan  = &WorkflowNodeResource{}
 )
 
 // WorkflowNodeResourceInterface defines the complete interface for workflow
@@ -53,12 +56,17 @@ type WorkflowNodeResourceInterface interface {
 	Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse)
 	ImportState(context.Context, resource.ImportStateRequest,
 		*resource.ImportStateResponse)
+	ModifyPlan(context.Context, resource.ModifyPlanRequest,
+		*resource.ModifyPlanResponse)
 }
 
 // WorkflowNodeResource defines a local-only resource for workflow nodes.
 // This resource does not make API calls; it exists purely in Terraform state
 // to generate node JSON for use in n8n_workflow resources.
-type WorkflowNodeResource struct{}
+type WorkflowNodeResource struct {
+	// client holds the provider community_node_allowlist, nil when the provider is not configured.
+	client *client.N8nClient
+}
 
 // NewWorkflowNodeResource creates a new WorkflowNodeResource instance.
 //
@@ -144,7 +152,7 @@ func (r *WorkflowNodeResource) addRequiredAttributes(attrs map[string]schema.Att
 		Required:            true,
 	}
 	attrs["type"] = schema.StringAttribute{
-		MarkdownDescription: "n8n node type (e.g., 'n8n-nodes-base.webhook')",
+		MarkdownDescription: "n8n node type (e.g., 'n8n-nodes-base.webhook'). Checked at plan time against the node types shipped with n8n; community nodes must be listed in the provider `community_node_allowlist`.",
 		Required:            true,
 	}
 	attrs["type_version"] = schema.Int64Attribute{
@@ -187,14 +195,72 @@ func (r *WorkflowNodeResource) addOptionalAttributes(attrs map[string]schema.Att
 	}
 }
 
-// Configure configures the resource (no-op for local resources).
+// Configure keeps the provider client, read for its community_node_allowlist only.
+// No API calls are made by local resources.
 //
 // Params:
 //   - _ctx: The context for the request (unused).
-//   - _req: The configuration request (unused).
-//   - _resp: The configuration response (unused).
-func (r *WorkflowNodeResource) Configure(_ctx context.Context, _req resource.ConfigureRequest, _resp *resource.ConfigureResponse) {
-	// No configuration needed for local-only resources.
+//   - req: The configuration request containing provider data.
+//   - resp: The configuration response to populate.
+func (r *WorkflowNodeResource) Configure(_ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		// Return with error.
+		return
+	}
+
+	clientData, ok := req.ProviderData.(*client.N8nClient)
+	// Check provider data type.
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.N8nClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		// Return result.
+		return
+	}
+
+	r.client = clientData
+}
+
+// ModifyPlan checks the node against the node catalog embedded in the provider.
+//
+// Params:
+//   - ctx: The context for the request.
+//   - req: The ModifyPlan request containing config, state and plan.
+//   - resp: The ModifyPlan response collecting diagnostics.
+func (r *WorkflowNodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy.
+	if req.Plan.Raw.IsNull() {
+		// Return early.
+		return
+	}
+
+	var plan models.NodeResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Check for error or unknown type.
+	if resp.Diagnostics.HasError() || plan.Type.IsUnknown() {
+		// Return early.
+		return
+	}
+
+	node := graphNode{
+		name:            plan.Name.ValueString(),
+		nodeType:        plan.Type.ValueString(),
+		typePath:        path.Root("type"),
+		typeVersionPath: path.Root("type_version"),
+		parametersPath:  path.Root("parameters"),
+	}
+	// Read the type version when known.
+	if !plan.TypeVersion.IsUnknown() && !plan.TypeVersion.IsNull() {
+		node.typeVersion = float64(plan.TypeVersion.ValueInt64())
+	}
+	// Read the parameter names when known.
+	if !plan.Parameters.IsUnknown() && !plan.Parameters.IsNull() {
+		node.parameters = parameterNames(plan.Parameters.ValueString())
+	}
+
+	validateNodeCatalog(nodecatalog.Default(), []graphNode{node}, communityNodeAllowlist(r.client), &resp.Diagnostics)
 }
 
 // Create creates the resource in Terraform state.
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow"
 )
 
@@ -243,7 +244,7 @@ func TestWorkflowNodeResource_Schema(t *testing.T) {
 	}
 }
 
-// TestWorkflowNodeResource_Configure verifies configure does not error.
+// TestWorkflowNodeResource_Configure verifies configure accepts the provider client only.
 func TestWorkflowNodeResource_Configure(t *testing.T) {
 	t.Parallel()
 
@@ -262,6 +263,16 @@ func TestWorkflowNodeResource_Configure(t *testing.T) {
 			providerData: nil,
 			expectError:  false,
 		},
+		{
+			name:         "provider client",
+			providerData: &client.N8nClient{CommunityNodeAllowlist: []string{"n8n-nodes-acme"}},
+			expectError:  false,
+		},
+		{
+			name:         "error case - unexpected provider data",
+			providerData: "invalid",
+			expectError:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -277,7 +288,7 @@ func TestWorkflowNodeResource_Configure(t *testing.T) {
 			}
 			resp := &resource.ConfigureResponse{}
 
-			// Execute configure (should be no-op).
+			// Execute configure.
 			res.Configure(context.Background(), req, resp)
 
 			// Verify diagnostics match expectation.
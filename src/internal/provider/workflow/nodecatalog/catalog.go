@@ -0,0 +1,205 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+// Package nodecatalog provides the catalog of the node types shipped with n8n.
+// The catalog is generated from the n8n sources by `make nodes/catalog` and embedded
+// in the provider, so that workflow nodes are validated at plan time without API calls.
+package nodecatalog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Packages of the node types shipped with n8n.
+const (
+	// BASE_PACKAGE is the package of the built-in nodes.
+	BASE_PACKAGE string = "n8n-nodes-base"
+	// LANGCHAIN_PACKAGE is the package of the AI nodes.
+	LANGCHAIN_PACKAGE string = "@n8n/n8n-nodes-langchain"
+	// TOOL_SUFFIX is appended by n8n to the type of the nodes usable as AI agent tools.
+	TOOL_SUFFIX string = "Tool"
+)
+
+// catalogJSON is the catalog generated by scripts/nodes/generate-node-catalog.js.
+//
+//go:embed catalog.json
+var catalogJSON []byte
+
+// defaultCatalog parses the embedded catalog once, on first use.
+var defaultCatalog func() *Catalog = sync.OnceValue(func() *Catalog {
+	catalog, err := Parse(catalogJSON)
+	// The embedded catalog is checked by the package tests.
+	if err != nil {
+		panic(err)
+	}
+	// Return catalog.
+	return catalog
+})
+
+// NodeType describes the supported versions and parameters of a node type.
+type NodeType struct {
+	// Versions are the supported type versions, empty when not recorded.
+	Versions []float64 `json:"versions,omitempty"`
+	// Parameters are the parameter names declared by the node, empty when not recorded.
+	Parameters []string `json:"parameters,omitempty"`
+	// Trigger is set for the nodes of the trigger group, which start workflow executions.
+	Trigger bool `json:"trigger,omitempty"`
+	// Polling is set for the triggers that poll a service on a schedule.
+	Polling bool `json:"polling,omitempty"`
+}
+
+// Catalog holds the node types of an n8n release.
+type Catalog struct {
+	// N8nVersion is the n8n release the catalog was generated from.
+	N8nVersion string `json:"n8n_version"`
+	// Nodes are the node types, by type name.
+	Nodes map[string]NodeType `json:"nodes"`
+}
+
+// Default returns the catalog embedded in the provider.
+//
+// Returns:
+//   - *Catalog: the embedded catalog
+func Default() *Catalog {
+	// Return embedded catalog.
+	return defaultCatalog()
+}
+
+// Parse decodes a catalog generated by the nodes tooling.
+//
+// Params:
+//   - data: the catalog JSON
+//
+// Returns:
+//   - *Catalog: the decoded catalog
+//   - error: decoding error
+func Parse(data []byte) (*Catalog, error) {
+	var catalog Catalog
+	// Check for decoding error.
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		// Return error.
+		return nil, fmt.Errorf("decoding node catalog: %w", err)
+	}
+	// Check for an empty catalog.
+	if len(catalog.Nodes) == 0 {
+		// Return error.
+		return nil, fmt.Errorf("node catalog has no node types")
+	}
+	// Return catalog.
+	return &catalog, nil
+}
+
+// Lookup returns a node type of the catalog.
+// The tool variants n8n derives from the nodes usable as AI agent tools share the node type.
+//
+// Params:
+//   - nodeType: the node type name (e.g., "n8n-nodes-base.webhook")
+//
+// Returns:
+//   - NodeType: the node type
+//   - bool: true if the node type is in the catalog
+func (c *Catalog) Lookup(nodeType string) (NodeType, bool) {
+	// Check for a cataloged type.
+	if node, ok := c.Nodes[nodeType]; ok {
+		// Return node type.
+		return node, true
+	}
+	base, ok := strings.CutSuffix(nodeType, TOOL_SUFFIX)
+	// Check for a tool variant.
+	if !ok {
+		// Return not found.
+		return NodeType{}, false
+	}
+	node, ok := c.Nodes[base]
+	// Return tool variant.
+	return node, ok
+}
+
+// SupportsVersion checks whether a type version is supported by the node type.
+//
+// Params:
+//   - version: the type version
+//
+// Returns:
+//   - bool: true if the version is supported or the versions are not recorded
+func (n NodeType) SupportsVersion(version float64) bool {
+	// Return result.
+	return len(n.Versions) == 0 || slices.Contains(n.Versions, version)
+}
+
+// UnknownParameters returns the parameter names not declared by the node type.
+//
+// Params:
+//   - names: the configured parameter names
+//
+// Returns:
+//   - []string: the unknown names, sorted, empty when the parameters are not recorded
+func (n NodeType) UnknownParameters(names []string) []string {
+	// Skip node types without recorded parameters.
+	if len(n.Parameters) == 0 {
+		// Return nothing.
+		return nil
+	}
+	var unknown []string
+	// Check each name.
+	for _, name := range names {
+		// Check for an undeclared parameter.
+		if !slices.Contains(n.Parameters, name) {
+			unknown = append(unknown, name)
+		}
+	}
+	slices.Sort(unknown)
+	// Return unknown names.
+	return unknown
+}
+
+// Package returns the package of a node type.
+//
+// Params:
+//   - nodeType: the node type name (e.g., "@n8n/n8n-nodes-langchain.agent")
+//
+// Returns:
+//   - string: the package (e.g., "@n8n/n8n-nodes-langchain"), empty without package
+func Package(nodeType string) string {
+	index := strings.LastIndex(nodeType, ".")
+	// Check for a package.
+	if index < 0 {
+		// Return no package.
+		return ""
+	}
+	// Return package.
+	return nodeType[:index]
+}
+
+// IsBuiltIn checks whether a node type belongs to a package shipped with n8n.
+//
+// Params:
+//   - nodeType: the node type name
+//
+// Returns:
+//   - bool: true for the built-in and AI node packages
+func IsBuiltIn(nodeType string) bool {
+	pkg := Package(nodeType)
+	// Return result.
+	return pkg == BASE_PACKAGE || pkg == LANGCHAIN_PACKAGE
+}
+
+// Allowlisted checks whether a node type is skipped by the validation.
+// Allowlist entries are node type names or package names.
+//
+// Params:
+//   - nodeType: the node type name
+//   - allowlist: the allowlisted node types and packages
+//
+// Returns:
+//   - bool: true if the node type or its package is allowlisted
+func Allowlisted(nodeType string, allowlist []string) bool {
+	// Return result.
+	return slices.Contains(allowlist, nodeType) || slices.Contains(allowlist, Package(nodeType))
+}
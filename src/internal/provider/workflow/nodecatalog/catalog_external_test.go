@@ -0,0 +1,200 @@
+package nodecatalog_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/nodecatalog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCatalog is a catalog with a versioned node and a node without recorded details.
+const testCatalog string = `{
+	"n8n_version": "1.0.0",
+	"nodes": {
+		"n8n-nodes-base.set": {"versions": [1, 2, 3.4], "parameters": ["mode", "values"]},
+		"n8n-nodes-base.slack": {}
+	}
+}`
+
+// TestDefault verifies that the embedded catalog parses and holds the built-in node types.
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	catalog := nodecatalog.Default()
+	require.NotNil(t, catalog)
+	// Check the node types the provider relies on.
+	for _, nodeType := range []string{"n8n-nodes-base.webhook", "n8n-nodes-base.manualTrigger", "@n8n/n8n-nodes-langchain.agent"} {
+		_, ok := catalog.Lookup(nodeType)
+		assert.True(t, ok, nodeType)
+	}
+	// Check the triggers whose name does not end with Trigger.
+	for _, nodeType := range []string{"n8n-nodes-base.webhook", "n8n-nodes-base.cron", "n8n-nodes-base.emailReadImap"} {
+		node, ok := catalog.Lookup(nodeType)
+		assert.True(t, ok && node.Trigger, nodeType)
+	}
+	// Check that every node type belongs to an n8n package.
+	for nodeType := range catalog.Nodes {
+		assert.True(t, nodecatalog.IsBuiltIn(nodeType), nodeType)
+	}
+}
+
+// TestDefault_generated verifies that the embedded catalog was generated from an n8n release by
+// make nodes/catalog, which records the release version and the type versions of every node type.
+func TestDefault_generated(t *testing.T) {
+	t.Parallel()
+
+	catalog := nodecatalog.Default()
+	// Check for the seed catalog, committed before the catalog was first generated.
+	if catalog.N8nVersion == "unknown" {
+		t.Skip("embedded catalog is not generated from the n8n sources: run make nodes/fetch nodes/parse nodes/catalog")
+	}
+	assert.Regexp(t, `^\d+\.\d+\.\d+$`, catalog.N8nVersion)
+	// Check the node types most workflows use.
+	for _, nodeType := range []string{
+		"n8n-nodes-base.code",
+		"n8n-nodes-base.httpRequest",
+		"n8n-nodes-base.if",
+		"n8n-nodes-base.merge",
+		"n8n-nodes-base.respondToWebhook",
+		"n8n-nodes-base.scheduleTrigger",
+		"n8n-nodes-base.set",
+		"n8n-nodes-base.switch",
+		"n8n-nodes-base.wait",
+		"n8n-nodes-base.webhook",
+		"@n8n/n8n-nodes-langchain.agent",
+		"@n8n/n8n-nodes-langchain.chatTrigger",
+	} {
+		node, ok := catalog.Lookup(nodeType)
+		assert.True(t, ok && len(node.Versions) > 0, nodeType)
+	}
+}
+
+// TestParse verifies catalog decoding.
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{name: "valid catalog", data: testCatalog},
+		{name: "error case - invalid JSON", data: `{`, wantErr: true},
+		{name: "error case - no node types", data: `{"n8n_version": "1.0.0", "nodes": {}}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			catalog, err := nodecatalog.Parse([]byte(tt.data))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "1.0.0", catalog.N8nVersion)
+			assert.Len(t, catalog.Nodes, 2)
+		})
+	}
+}
+
+// TestParse_triggers verifies the decoding of the trigger and poller flags.
+func TestParse_triggers(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := nodecatalog.Parse([]byte(`{"nodes": {
+		"n8n-nodes-base.gmailTrigger": {"trigger": true, "polling": true},
+		"n8n-nodes-base.gmail": {}
+	}}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, nodecatalog.NodeType{Trigger: true, Polling: true}, catalog.Nodes["n8n-nodes-base.gmailTrigger"])
+	assert.Equal(t, nodecatalog.NodeType{}, catalog.Nodes["n8n-nodes-base.gmail"])
+}
+
+// TestCatalog_Lookup verifies node type lookups, including tool variants.
+func TestCatalog_Lookup(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := nodecatalog.Parse([]byte(testCatalog))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		nodeType string
+		want     bool
+	}{
+		{name: "cataloged type", nodeType: "n8n-nodes-base.set", want: true},
+		{name: "tool variant", nodeType: "n8n-nodes-base.slackTool", want: true},
+		{name: "error case - unknown type", nodeType: "n8n-nodes-base.sett"},
+		{name: "error case - unknown tool variant", nodeType: "n8n-nodes-base.unknownTool"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, ok := catalog.Lookup(tt.nodeType)
+			assert.Equal(t, tt.want, ok)
+		})
+	}
+}
+
+// TestNodeType_SupportsVersion verifies type version checks.
+func TestNodeType_SupportsVersion(t *testing.T) {
+	t.Parallel()
+
+	versioned := nodecatalog.NodeType{Versions: []float64{1, 2, 3.4}}
+
+	assert.True(t, versioned.SupportsVersion(3.4))
+	assert.False(t, versioned.SupportsVersion(3))
+	assert.True(t, nodecatalog.NodeType{}.SupportsVersion(7), "versions not recorded")
+}
+
+// TestNodeType_UnknownParameters verifies parameter name checks.
+func TestNodeType_UnknownParameters(t *testing.T) {
+	t.Parallel()
+
+	node := nodecatalog.NodeType{Parameters: []string{"mode", "values"}}
+
+	assert.Equal(t, []string{"kepOnlySet", "mod"}, node.UnknownParameters([]string{"mode", "mod", "kepOnlySet"}))
+	assert.Empty(t, node.UnknownParameters([]string{"mode"}))
+	assert.Empty(t, nodecatalog.NodeType{}.UnknownParameters([]string{"anything"}), "parameters not recorded")
+}
+
+// TestAllowlisted verifies allowlist matching on node types and packages.
+func TestAllowlisted(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		nodeType  string
+		allowlist []string
+		want      bool
+	}{
+		{name: "allowlisted package", nodeType: "n8n-nodes-mcp.mcpClient", allowlist: []string{"n8n-nodes-mcp"}, want: true},
+		{name: "allowlisted scoped package", nodeType: "@acme/n8n-nodes-acme.invoice", allowlist: []string{"@acme/n8n-nodes-acme"}, want: true},
+		{name: "allowlisted type", nodeType: "n8n-nodes-base.newNode", allowlist: []string{"n8n-nodes-base.newNode"}, want: true},
+		{name: "error case - other package", nodeType: "n8n-nodes-mcp.mcpClient", allowlist: []string{"n8n-nodes-acme"}},
+		{name: "error case - empty allowlist", nodeType: "n8n-nodes-mcp.mcpClient"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, nodecatalog.Allowlisted(tt.nodeType, tt.allowlist))
+		})
+	}
+}
+
+// TestIsBuiltIn verifies the detection of the packages shipped with n8n.
+func TestIsBuiltIn(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, nodecatalog.IsBuiltIn("n8n-nodes-base.webhook"))
+	assert.True(t, nodecatalog.IsBuiltIn("@n8n/n8n-nodes-langchain.agent"))
+	assert.False(t, nodecatalog.IsBuiltIn("n8n-nodes-mcp.mcpClient"))
+	assert.False(t, nodecatalog.IsBuiltIn("webhook"))
+	assert.Equal(t, "@n8n/n8n-nodes-langchain", nodecatalog.Package("@n8n/n8n-nodes-langchain.agent"))
+	assert.Empty(t, nodecatalog.Package("webhook"))
+}
@@ -0,0 +1,651 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+)
+
+// nodeCredentialAttributeTypes are the attribute types of a node credential.
+var nodeCredentialAttributeTypes map[string]attr.Type = map[string]attr.Type{
+	"id":   types.StringType,
+	"name": types.StringType,
+}
+
+// nodeCredentialObjectType is the object type of a node credential.
+var nodeCredentialObjectType types.ObjectType = types.ObjectType{AttrTypes: nodeCredentialAttributeTypes}
+
+// nodeAttributeTypes are the attribute types of a node of the nodes attribute.
+var nodeAttributeTypes map[string]attr.Type = map[string]attr.Type{
+	"id":                 types.StringType,
+	"type":               types.StringType,
+	"type_version":       types.Float64Type,
+	"position":           types.ListType{ElemType: types.Float64Type},
+	"parameters":         jsontypes.ParametersType,
+	"credentials":        types.MapType{ElemType: nodeCredentialObjectType},
+	"webhook_id":         types.StringType,
+	"disabled":           types.BoolType,
+	"notes":              types.StringType,
+	"notes_in_flow":      types.BoolType,
+	"execute_once":       types.BoolType,
+	"always_output_data": types.BoolType,
+	"retry_on_fail":      types.BoolType,
+	"continue_on_fail":   types.BoolType,
+	"max_tries":          types.Int64Type,
+	"wait_between_tries": types.Int64Type,
+	"on_error":           types.StringType,
+}
+
+// nodeObjectType is the object type of a node of the nodes attribute.
+var nodeObjectType types.ObjectType = types.ObjectType{AttrTypes: nodeAttributeTypes}
+
+// nodesAttribute returns the nodes attribute of the workflow resource schema.
+// Nodes are keyed by name, so that plans match each node with its prior state and only show the
+// nodes that change. Parameters stay a JSON string: the framework does not support dynamic
+// attributes inside maps, because the elements of a map must all have the same type.
+//
+// Returns:
+//   - schema.MapNestedAttribute: the nodes attribute definition
+func (r *WorkflowResource) nodesAttribute() schema.MapNestedAttribute {
+	// Return nodes attribute.
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "Workflow nodes keyed by node name, as an alternative to `nodes_json`. Node names are unique " +
+			"in the workflow and used in connections. Plans only show the nodes that change; renaming a node plans its " +
+			"removal and the addition of the renamed node. Conflicts with `nodes_json`.",
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: r.nodeAttributes(),
+		},
+	}
+}
+
+// nodeAttributes returns the attribute definitions of a node of the nodes attribute.
+//
+// Returns:
+//   - map[string]schema.Attribute: the node attribute definitions
+func (r *WorkflowResource) nodeAttributes() map[string]schema.Attribute {
+	// Return node attributes.
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Node identifier, generated by n8n when not set",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "n8n node type (e.g., 'n8n-nodes-base.webhook'). Checked at plan time against the node types shipped with n8n; community nodes must be listed in the provider `community_node_allowlist`.",
+			Required:            true,
+		},
+		"type_version": schema.Float64Attribute{
+			MarkdownDescription: "Version of the node type (e.g., 4.2). n8n uses 1 when not set.",
+			Optional:            true,
+		},
+		"position": schema.ListAttribute{
+			MarkdownDescription: "Position [x, y] coordinates for UI display",
+			ElementType:         types.Float64Type,
+			Required:            true,
+		},
+		"parameters": schema.StringAttribute{
+			MarkdownDescription: "Node parameters as JSON object string (e.g., `jsonencode({ path = \"hook\" })`). " +
+				"Key order and parameters n8n adds with an empty or zero value (e.g., `options = {}`) are ignored.",
+			CustomType: jsontypes.ParametersType,
+			Optional:   true,
+		},
+		"credentials": schema.MapNestedAttribute{
+			MarkdownDescription: "Credentials used by the node, keyed by credential type (e.g., 'httpHeaderAuth')",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Credential identifier",
+						Required:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Credential name",
+						Optional:            true,
+					},
+				},
+			},
+		},
+		"webhook_id": schema.StringAttribute{
+			MarkdownDescription: "Webhook identifier for webhook nodes",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"disabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the node is disabled",
+			Optional:            true,
+		},
+		"notes": schema.StringAttribute{
+			MarkdownDescription: "Notes about the node",
+			Optional:            true,
+		},
+		"notes_in_flow": schema.BoolAttribute{
+			MarkdownDescription: "Whether the notes are displayed on the canvas",
+			Optional:            true,
+		},
+		"execute_once": schema.BoolAttribute{
+			MarkdownDescription: "Whether the node only executes once, with the first input item",
+			Optional:            true,
+		},
+		"always_output_data": schema.BoolAttribute{
+			MarkdownDescription: "Whether the node outputs an empty item when it has no output data",
+			Optional:            true,
+		},
+		"retry_on_fail": schema.BoolAttribute{
+			MarkdownDescription: "Whether the node is retried when it fails",
+			Optional:            true,
+		},
+		"continue_on_fail": schema.BoolAttribute{
+			MarkdownDescription: "Whether the workflow continues when the node fails. Legacy setting of n8n, replaced by `on_error`.",
+			Optional:            true,
+		},
+		"max_tries": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of tries when `retry_on_fail` is set",
+			Optional:            true,
+		},
+		"wait_between_tries": schema.Int64Attribute{
+			MarkdownDescription: "Milliseconds to wait between tries when `retry_on_fail` is set",
+			Optional:            true,
+		},
+		"on_error": schema.StringAttribute{
+			MarkdownDescription: "Behavior when the node fails: 'stopWorkflow', 'continueRegularOutput' or 'continueErrorOutput'",
+			Optional:            true,
+		},
+	}
+}
+
+// hasNodes checks whether the nodes attribute is configured.
+//
+// Params:
+//   - nodes: The nodes attribute
+//
+// Returns:
+//   - bool: True if at least one node is configured
+func hasNodes(nodes types.Map) bool {
+	// Return result.
+	return !nodes.IsNull() && !nodes.IsUnknown() && len(nodes.Elements()) > 0
+}
+
+// validateNodesAttribute checks that nodes are configured with either the nodes attribute or nodes_json.
+// Unknown values count as set.
+//
+// Params:
+//   - config: The resource configuration
+//   - diags: Diagnostics for error reporting
+func validateNodesAttribute(config *models.Resource, diags *diag.Diagnostics) {
+	hasNodesAttribute := config.Nodes.IsUnknown() || hasNodes(config.Nodes)
+	// Check for conflict.
+	if hasNodesAttribute && !config.NodesJSON.IsNull() {
+		diags.AddAttributeError(
+			path.Root("nodes_json"),
+			"Conflicting Workflow Nodes",
+			"Only one of nodes_json or nodes can be set.",
+		)
+	}
+}
+
+// nodeModels decodes the nodes attribute.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - nodes: The nodes attribute
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - map[string]models.Node: The nodes keyed by name
+//   - []string: The node names, sorted so that conversions are deterministic
+func nodeModels(ctx context.Context, nodes types.Map, diags *diag.Diagnostics) (map[string]models.Node, []string) {
+	var byName map[string]models.Node
+	diags.Append(nodes.ElementsAs(ctx, &byName, false)...)
+	// Return nodes and names.
+	return byName, slices.Sorted(maps.Keys(byName))
+}
+
+// nodesFromAttribute converts the nodes attribute to n8n nodes.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - nodes: The nodes attribute
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - []n8nsdk.Node: The n8n nodes, sorted by name
+func nodesFromAttribute(ctx context.Context, nodes types.Map, diags *diag.Diagnostics) []n8nsdk.Node {
+	byName, names := nodeModels(ctx, nodes, diags)
+	// Check for conversion errors.
+	if diags.HasError() {
+		// Return empty nodes.
+		return []n8nsdk.Node{}
+	}
+
+	result := make([]n8nsdk.Node, 0, len(names))
+	// Convert each node.
+	for _, name := range names {
+		node := byName[name]
+		result = append(result, nodeFromAttribute(ctx, name, &node, diags))
+	}
+
+	// Return nodes.
+	return result
+}
+
+// nodeFromAttribute converts a node of the nodes attribute to an n8n node, applying the n8n defaults.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - name: The node name
+//   - value: The node
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - n8nsdk.Node: The n8n node
+func nodeFromAttribute(ctx context.Context, name string, value *models.Node, diags *diag.Diagnostics) n8nsdk.Node {
+	node := n8nsdk.Node{
+		Id:          knownStringPointer(value.ID),
+		Name:        &name,
+		Type:        value.Type.ValueStringPointer(),
+		WebhookId:   knownStringPointer(value.WebhookID),
+		Notes:       knownStringPointer(value.Notes),
+		OnError:     knownStringPointer(value.OnError),
+		TypeVersion: float32Pointer(float64(DEFAULT_TYPE_VERSION)),
+		Parameters:  map[string]any{},
+	}
+	// Set type version if configured.
+	if !value.TypeVersion.IsNull() && !value.TypeVersion.IsUnknown() {
+		node.TypeVersion = float32Pointer(value.TypeVersion.ValueFloat64())
+	}
+	// Set retry settings if configured.
+	if !value.MaxTries.IsNull() && !value.MaxTries.IsUnknown() {
+		node.MaxTries = float32Pointer(float64(value.MaxTries.ValueInt64()))
+	}
+	// Set retry settings if configured.
+	if !value.WaitBetweenTries.IsNull() && !value.WaitBetweenTries.IsUnknown() {
+		node.WaitBetweenTries = float32Pointer(float64(value.WaitBetweenTries.ValueInt64()))
+	}
+	node.Disabled = truePointer(value.Disabled)
+	node.NotesInFlow = truePointer(value.NotesInFlow)
+	node.ExecuteOnce = truePointer(value.ExecuteOnce)
+	node.AlwaysOutputData = truePointer(value.AlwaysOutputData)
+	node.RetryOnFail = truePointer(value.RetryOnFail)
+	node.ContinueOnFail = truePointer(value.ContinueOnFail)
+
+	var position []float64
+	diags.Append(value.Position.ElementsAs(ctx, &position, false)...)
+	node.Position = make([]float32, 0, len(position))
+	// Convert position.
+	for _, coordinate := range position {
+		node.Position = append(node.Position, float32(coordinate))
+	}
+
+	// Parse parameters if configured.
+	if !value.Parameters.IsNull() && !value.Parameters.IsUnknown() {
+		// Check for parse error.
+		if err := json.Unmarshal([]byte(value.Parameters.ValueString()), &node.Parameters); err != nil || node.Parameters == nil {
+			diags.AddAttributeError(
+				nodePath(name).AtName("parameters"),
+				"Invalid Node Parameters",
+				fmt.Sprintf("Parameters of node %q must be a JSON object.", name),
+			)
+		}
+	}
+
+	node.Credentials = credentialsFromAttribute(ctx, value.Credentials, diags)
+
+	// Return node.
+	return node
+}
+
+// nodePath returns the path of a node of the nodes attribute, for error reporting.
+//
+// Params:
+//   - name: The node name
+//
+// Returns:
+//   - path.Path: The path of the node in the nodes attribute
+func nodePath(name string) path.Path {
+	// Return node path.
+	return path.Root("nodes").AtMapKey(name)
+}
+
+// credentialsFromAttribute converts node credentials to the n8n format.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - credentials: The node credentials
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - map[string]any: The credentials keyed by credential type, nil if none
+func credentialsFromAttribute(ctx context.Context, credentials types.Map, diags *diag.Diagnostics) map[string]any {
+	// Check for configured credentials.
+	if credentials.IsNull() || credentials.IsUnknown() {
+		// Return no credentials.
+		return nil
+	}
+
+	var credentialModels map[string]models.NodeCredential
+	diags.Append(credentials.ElementsAs(ctx, &credentialModels, false)...)
+	result := make(map[string]any, len(credentialModels))
+	// Convert each credential.
+	for credentialType, credential := range credentialModels {
+		entry := map[string]any{"id": credential.ID.ValueString()}
+		// Set name if configured.
+		if !credential.Name.IsNull() && !credential.Name.IsUnknown() {
+			entry["name"] = credential.Name.ValueString()
+		}
+		result[credentialType] = entry
+	}
+
+	// Return credentials.
+	return result
+}
+
+// mapNodesAttribute updates the nodes attribute from the nodes returned by n8n.
+// Nodes are matched by name, so that unchanged nodes keep their prior values.
+// The attribute is only maintained when it is configured.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - nodes: The nodes returned by n8n
+//   - prior: The prior nodes attribute, from the plan or the state
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - types.Map: The updated nodes attribute
+func mapNodesAttribute(ctx context.Context, nodes []n8nsdk.Node, prior types.Map, diags *diag.Diagnostics) types.Map {
+	// Keep an unconfigured attribute as-is.
+	if !hasNodes(prior) {
+		// Check for an untyped value.
+		if prior.ElementType(ctx) == nil {
+			// Return null nodes.
+			return types.MapNull(nodeObjectType)
+		}
+		// Return prior nodes.
+		return prior
+	}
+
+	priorNodes, _ := nodeModels(ctx, prior, diags)
+	// Check for conversion errors.
+	if diags.HasError() {
+		// Return prior nodes.
+		return prior
+	}
+
+	result := make(map[string]models.Node, len(nodes))
+	// Convert each node, with its prior value when it exists.
+	for i := range nodes {
+		priorNode := priorNodes[nodes[i].GetName()]
+		result[nodes[i].GetName()] = nodeToAttribute(ctx, &nodes[i], &priorNode, diags)
+	}
+
+	nodeMap, mapDiags := types.MapValueFrom(ctx, nodeObjectType, result)
+	diags.Append(mapDiags...)
+
+	// Return nodes attribute.
+	return nodeMap
+}
+
+// nodeToAttribute converts an n8n node to a node of the nodes attribute.
+// Unset attributes of the prior node stay null when n8n returns the default value.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - node: The node returned by n8n
+//   - prior: The prior value of the node, empty if none
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - models.Node: The node
+func nodeToAttribute(ctx context.Context, node *n8nsdk.Node, prior *models.Node, diags *diag.Diagnostics) models.Node {
+	value := models.Node{
+		ID:               types.StringPointerValue(node.Id),
+		Type:             types.StringPointerValue(node.Type),
+		TypeVersion:      types.Float64Null(),
+		WebhookID:        types.StringPointerValue(node.WebhookId),
+		Notes:            types.StringPointerValue(node.Notes),
+		OnError:          types.StringPointerValue(node.OnError),
+		MaxTries:         int64PointerValue(node.MaxTries),
+		WaitBetweenTries: int64PointerValue(node.WaitBetweenTries),
+		Disabled:         optionalBool(prior.Disabled, node.Disabled),
+		NotesInFlow:      optionalBool(prior.NotesInFlow, node.NotesInFlow),
+		ExecuteOnce:      optionalBool(prior.ExecuteOnce, node.ExecuteOnce),
+		AlwaysOutputData: optionalBool(prior.AlwaysOutputData, node.AlwaysOutputData),
+		RetryOnFail:      optionalBool(prior.RetryOnFail, node.RetryOnFail),
+		ContinueOnFail:   optionalBool(prior.ContinueOnFail, node.ContinueOnFail),
+	}
+
+	// Map type version, null when it is the default and not configured.
+	if node.TypeVersion != nil {
+		typeVersion := float32Value(*node.TypeVersion)
+		// Check for configured or non-default value.
+		if !prior.TypeVersion.IsNull() || typeVersion != float64(DEFAULT_TYPE_VERSION) {
+			value.TypeVersion = types.Float64Value(typeVersion)
+		}
+	}
+
+	position := make([]float64, 0, len(node.Position))
+	// Convert position.
+	for _, coordinate := range node.Position {
+		position = append(position, float32Value(coordinate))
+	}
+	positionList, listDiags := types.ListValueFrom(ctx, types.Float64Type, position)
+	diags.Append(listDiags...)
+	value.Position = positionList
+
+	value.Parameters = mapNodeParameters(node.Parameters, prior.Parameters, diags)
+	value.Credentials = mapNodeCredentials(ctx, node.Credentials, prior.Credentials, diags)
+
+	// Return node.
+	return value
+}
+
+// mapNodeParameters converts n8n node parameters to a node attribute value.
+// The prior value is kept when n8n only added default parameters to it.
+//
+// Params:
+//   - parameters: The parameters returned by n8n
+//   - prior: The prior parameters
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - jsontypes.Value: The parameters
+func mapNodeParameters(parameters map[string]any, prior jsontypes.Value, diags *diag.Diagnostics) jsontypes.Value {
+	// Keep unset parameters null when n8n returns no parameters.
+	if prior.IsNull() && len(parameters) == 0 {
+		// Return null parameters.
+		return jsontypes.NewNull(jsontypes.KIND_PARAMETERS)
+	}
+	// n8n returns an empty object for nodes without parameters.
+	if parameters == nil {
+		parameters = map[string]any{}
+	}
+
+	encoded, err := json.Marshal(parameters)
+	// Check for encoding error.
+	if err != nil {
+		diags.AddError("Invalid node parameters", fmt.Sprintf("Could not encode node parameters: %s", err.Error()))
+		// Return prior parameters.
+		return prior
+	}
+	// Keep prior parameters when n8n only added defaults.
+	if !prior.IsNull() && !prior.IsUnknown() && jsontypes.SemanticallyEqual(jsontypes.KIND_PARAMETERS, prior.ValueString(), string(encoded)) {
+		// Return prior parameters.
+		return prior
+	}
+
+	// Return parameters.
+	return jsontypes.NewParametersValue(string(encoded))
+}
+
+// mapNodeCredentials converts n8n node credentials to a node attribute value.
+// Unset credential names stay null when the credential is unchanged.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - credentials: The credentials returned by n8n
+//   - prior: The prior credentials
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - types.Map: The credentials
+func mapNodeCredentials(ctx context.Context, credentials map[string]any, prior types.Map, diags *diag.Diagnostics) types.Map {
+	// Keep unset credentials null when n8n returns no credentials.
+	if len(credentials) == 0 && (prior.IsNull() || prior.IsUnknown()) {
+		// Return null credentials.
+		return types.MapNull(nodeCredentialObjectType)
+	}
+
+	var priorModels map[string]models.NodeCredential
+	// Decode prior credentials.
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorModels, false)...)
+	}
+
+	result := make(map[string]models.NodeCredential, len(credentials))
+	// Convert each credential.
+	for credentialType, raw := range credentials {
+		entry, _ := raw.(map[string]any)
+		id, _ := entry["id"].(string)
+		credential := models.NodeCredential{ID: types.StringValue(id), Name: types.StringNull()}
+		// Set name if returned.
+		if name, ok := entry["name"].(string); ok {
+			credential.Name = types.StringValue(name)
+		}
+		priorCredential, found := priorModels[credentialType]
+		// Keep the name unset when the credential is unchanged.
+		if found && priorCredential.Name.IsNull() && priorCredential.ID.ValueString() == id {
+			credential.Name = types.StringNull()
+		}
+		result[credentialType] = credential
+	}
+
+	credentialMap, mapDiags := types.MapValueFrom(ctx, nodeCredentialObjectType, result)
+	diags.Append(mapDiags...)
+
+	// Return credentials.
+	return credentialMap
+}
+
+// knownStringPointer returns a pointer to a known string value.
+//
+// Params:
+//   - value: The string value
+//
+// Returns:
+//   - *string: The string, nil if null or unknown
+func knownStringPointer(value types.String) *string {
+	// Check for null or unknown value.
+	if value.IsNull() || value.IsUnknown() {
+		// Return nil.
+		return nil
+	}
+	// Return pointer.
+	return value.ValueStringPointer()
+}
+
+// truePointer returns a pointer to true when the value is true.
+// n8n omits boolean node fields that are false.
+//
+// Params:
+//   - value: The boolean value
+//
+// Returns:
+//   - *bool: Pointer to true, nil otherwise
+func truePointer(value types.Bool) *bool {
+	// Check for true value.
+	if !value.ValueBool() {
+		// Return nil.
+		return nil
+	}
+	enabled := true
+	// Return pointer.
+	return &enabled
+}
+
+// float32Pointer returns a pointer to a float32 value.
+//
+// Params:
+//   - value: The value
+//
+// Returns:
+//   - *float32: Pointer to the converted value
+func float32Pointer(value float64) *float32 {
+	converted := float32(value)
+	// Return pointer.
+	return &converted
+}
+
+// float32Value converts an n8n numeric field, such as a type version or a position, to a float64.
+// It formats the value as float32 to avoid float64 conversion noise (e.g., 4.2).
+//
+// Params:
+//   - value: The n8n value
+//
+// Returns:
+//   - float64: The value
+func float32Value(value float32) float64 {
+	converted, _ := strconv.ParseFloat(strconv.FormatFloat(float64(value), 'f', -1, 32), 64)
+	// Return value.
+	return converted
+}
+
+// int64PointerValue converts an n8n numeric field to an Int64 value.
+//
+// Params:
+//   - value: The numeric field
+//
+// Returns:
+//   - types.Int64: The value, null if unset
+func int64PointerValue(value *float32) types.Int64 {
+	// Check for unset value.
+	if value == nil {
+		// Return null.
+		return types.Int64Null()
+	}
+	// Return value.
+	return types.Int64Value(int64(*value))
+}
+
+// optionalBool converts an n8n boolean node field to a Bool value.
+// A false or unset field stays null when the prior value is null.
+//
+// Params:
+//   - prior: The prior value
+//   - value: The field returned by n8n
+//
+// Returns:
+//   - types.Bool: The value
+func optionalBool(prior types.Bool, value *bool) types.Bool {
+	enabled := value != nil && *value
+	// Keep unset false values null.
+	if !enabled && prior.IsNull() {
+		// Return null.
+		return types.BoolNull()
+	}
+	// Return value.
+	return types.BoolValue(enabled)
+}
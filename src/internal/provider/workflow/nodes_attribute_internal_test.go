@@ -0,0 +1,423 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testNode returns a node with only the required attributes set.
+func testNode(t *testing.T) models.Node {
+	t.Helper()
+	position, diags := types.ListValueFrom(context.Background(), types.Float64Type, []float64{250, 300})
+	require.False(t, diags.HasError())
+
+	return models.Node{
+		ID:               types.StringUnknown(),
+		Type:             types.StringValue("n8n-nodes-base.webhook"),
+		TypeVersion:      types.Float64Null(),
+		Position:         position,
+		Parameters:       jsontypes.NewNull(jsontypes.KIND_PARAMETERS),
+		Credentials:      types.MapNull(nodeCredentialObjectType),
+		WebhookID:        types.StringUnknown(),
+		Disabled:         types.BoolNull(),
+		Notes:            types.StringNull(),
+		NotesInFlow:      types.BoolNull(),
+		ExecuteOnce:      types.BoolNull(),
+		AlwaysOutputData: types.BoolNull(),
+		RetryOnFail:      types.BoolNull(),
+		ContinueOnFail:   types.BoolNull(),
+		MaxTries:         types.Int64Null(),
+		WaitBetweenTries: types.Int64Null(),
+		OnError:          types.StringNull(),
+	}
+}
+
+// testNodes converts nodes keyed by name to a nodes attribute value.
+func testNodes(t *testing.T, nodes map[string]models.Node) types.Map {
+	t.Helper()
+	nodeMap, diags := types.MapValueFrom(context.Background(), nodeObjectType, nodes)
+	require.False(t, diags.HasError())
+
+	return nodeMap
+}
+
+func TestWorkflowResource_nodesAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewWorkflowResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+
+	require.Contains(t, resp.Schema.Attributes, "nodes")
+	assert.False(t, resp.Schema.ValidateImplementation(ctx).HasError(), "schema should be valid")
+	assert.True(t, resp.Schema.Attributes["nodes"].GetType().Equal(types.MapType{ElemType: nodeObjectType}))
+}
+
+func Test_validateNodesAttribute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  func(t *testing.T) *models.Resource
+		wantErr bool
+	}{
+		{
+			name: "nodes only",
+			config: func(t *testing.T) *models.Resource {
+				t.Helper()
+				return &models.Resource{Nodes: testNodes(t, map[string]models.Node{"Webhook": testNode(t)}), NodesJSON: jsontypes.NewNull(jsontypes.KIND_NODES)}
+			},
+		},
+		{
+			name: "nodes_json only",
+			config: func(t *testing.T) *models.Resource {
+				t.Helper()
+				return &models.Resource{Nodes: types.MapNull(nodeObjectType), NodesJSON: jsontypes.NewNodesValue("[]")}
+			},
+		},
+		{
+			name: "error case - nodes and nodes_json",
+			config: func(t *testing.T) *models.Resource {
+				t.Helper()
+				return &models.Resource{Nodes: testNodes(t, map[string]models.Node{"Webhook": testNode(t)}), NodesJSON: jsontypes.NewNodesValue("[]")}
+			},
+			wantErr: true,
+		},
+		{
+			name: "error case - unknown nodes and nodes_json",
+			config: func(t *testing.T) *models.Resource {
+				t.Helper()
+				return &models.Resource{Nodes: types.MapUnknown(nodeObjectType), NodesJSON: jsontypes.NewNodesValue("[]")}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var diags diag.Diagnostics
+			validateNodesAttribute(tt.config(t), &diags)
+			assert.Equal(t, tt.wantErr, diags.HasError())
+		})
+	}
+}
+
+func Test_nodesFromAttribute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		node     func(t *testing.T) models.Node
+		wantErr  bool
+		validate func(t *testing.T, node n8nsdk.Node)
+	}{
+		{
+			name: "defaults",
+			node: func(t *testing.T) models.Node {
+				t.Helper()
+				return testNode(t)
+			},
+			validate: func(t *testing.T, node n8nsdk.Node) {
+				t.Helper()
+				assert.Nil(t, node.Id)
+				assert.Equal(t, "Node", node.GetName())
+				assert.Equal(t, float32(1), node.GetTypeVersion())
+				assert.Equal(t, []float32{250, 300}, node.Position)
+				assert.Equal(t, map[string]any{}, node.Parameters)
+				assert.Nil(t, node.Credentials)
+				assert.Nil(t, node.Disabled)
+				assert.Nil(t, node.MaxTries)
+			},
+		},
+		{
+			name: "all attributes",
+			node: func(t *testing.T) models.Node {
+				t.Helper()
+				node := testNode(t)
+				node.ID = types.StringValue("node-1")
+				node.TypeVersion = types.Float64Value(4.2)
+				node.Parameters = jsontypes.NewParametersValue(`{"url":"https://example.com"}`)
+				credentials, diags := types.MapValueFrom(context.Background(), nodeCredentialObjectType, map[string]models.NodeCredential{
+					"httpHeaderAuth": {ID: types.StringValue("cred-1"), Name: types.StringNull()},
+				})
+				require.False(t, diags.HasError())
+				node.Credentials = credentials
+				node.Disabled = types.BoolValue(true)
+				node.RetryOnFail = types.BoolValue(true)
+				node.ContinueOnFail = types.BoolValue(true)
+				node.Position = types.ListValueMust(types.Float64Type, []attr.Value{types.Float64Value(240.5), types.Float64Value(-80)})
+				node.MaxTries = types.Int64Value(5)
+				node.WaitBetweenTries = types.Int64Value(1000)
+				node.OnError = types.StringValue("continueErrorOutput")
+				return node
+			},
+			validate: func(t *testing.T, node n8nsdk.Node) {
+				t.Helper()
+				assert.Equal(t, "node-1", node.GetId())
+				assert.Equal(t, float32(4.2), node.GetTypeVersion())
+				assert.Equal(t, map[string]any{"url": "https://example.com"}, node.Parameters)
+				assert.Equal(t, map[string]any{"httpHeaderAuth": map[string]any{"id": "cred-1"}}, node.Credentials)
+				assert.True(t, node.GetDisabled())
+				assert.True(t, node.GetRetryOnFail())
+				assert.True(t, node.GetContinueOnFail())
+				assert.Equal(t, []float32{240.5, -80}, node.Position)
+				assert.Equal(t, float32(5), node.GetMaxTries())
+				assert.Equal(t, float32(1000), node.GetWaitBetweenTries())
+				assert.Equal(t, "continueErrorOutput", node.GetOnError())
+			},
+		},
+		{
+			name: "error case - parameters are not an object",
+			node: func(t *testing.T) models.Node {
+				t.Helper()
+				node := testNode(t)
+				node.Parameters = jsontypes.NewParametersValue(`"path"`)
+				return node
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var diags diag.Diagnostics
+			nodes := nodesFromAttribute(context.Background(), testNodes(t, map[string]models.Node{"Node": tt.node(t)}), &diags)
+			assert.Equal(t, tt.wantErr, diags.HasError())
+			if tt.validate != nil {
+				require.Len(t, nodes, 1)
+				tt.validate(t, nodes[0])
+			}
+		})
+	}
+}
+
+func Test_nodesFromAttribute_sorted(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	nodes := nodesFromAttribute(context.Background(), testNodes(t, map[string]models.Node{
+		"Webhook": testNode(t), "Call API": testNode(t), "Respond": testNode(t),
+	}), &diags)
+	require.False(t, diags.HasError())
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, node.GetName())
+	}
+	assert.Equal(t, []string{"Call API", "Respond", "Webhook"}, names)
+}
+
+func Test_mapNodesAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("matches nodes by name and keeps unset defaults", func(t *testing.T) {
+		t.Parallel()
+		first := testNode(t)
+		first.Parameters = jsontypes.NewParametersValue(`{"path":"hook"}`)
+		nodes := []n8nsdk.Node{
+			{
+				Id: shared.Ptr("id-2"), Name: shared.Ptr("Second"), Type: shared.Ptr("n8n-nodes-base.webhook"),
+				TypeVersion: shared.Ptr(float32(1)), Position: []float32{250, 300}, Parameters: map[string]any{},
+			},
+			{
+				Id: shared.Ptr("id-1"), Name: shared.Ptr("First"), Type: shared.Ptr("n8n-nodes-base.webhook"),
+				TypeVersion: shared.Ptr(float32(1)), Position: []float32{250, 300},
+				Parameters: map[string]any{"path": "hook", "options": map[string]any{}},
+			},
+		}
+
+		var diags diag.Diagnostics
+		nodeMap := mapNodesAttribute(ctx, nodes, testNodes(t, map[string]models.Node{"First": first, "Second": testNode(t)}), &diags)
+		require.False(t, diags.HasError())
+
+		var byName map[string]models.Node
+		require.False(t, nodeMap.ElementsAs(ctx, &byName, false).HasError())
+		require.Len(t, byName, 2)
+		assert.Equal(t, "id-1", byName["First"].ID.ValueString())
+		assert.True(t, byName["First"].Parameters.Equal(first.Parameters), "parameters defaulted by n8n keep the prior value")
+		assert.True(t, byName["First"].TypeVersion.IsNull())
+		assert.True(t, byName["First"].Disabled.IsNull())
+		assert.Equal(t, "id-2", byName["Second"].ID.ValueString())
+		assert.True(t, byName["Second"].Parameters.IsNull())
+		assert.True(t, byName["Second"].Credentials.IsNull())
+	})
+
+	t.Run("reports drift and nodes added in n8n", func(t *testing.T) {
+		t.Parallel()
+		nodes := []n8nsdk.Node{
+			{
+				Name: shared.Ptr("First"), Type: shared.Ptr("n8n-nodes-base.webhook"), TypeVersion: shared.Ptr(float32(2.1)),
+				Position: []float32{250.3, 300}, Disabled: shared.Ptr(true), ContinueOnFail: shared.Ptr(true),
+				Parameters: map[string]any{"path": "other"},
+			},
+			{Name: shared.Ptr("Added"), Type: shared.Ptr("n8n-nodes-base.noOp"), Position: []float32{0, 0}},
+		}
+
+		var diags diag.Diagnostics
+		nodeMap := mapNodesAttribute(ctx, nodes, testNodes(t, map[string]models.Node{"First": testNode(t)}), &diags)
+		require.False(t, diags.HasError())
+
+		var byName map[string]models.Node
+		require.False(t, nodeMap.ElementsAs(ctx, &byName, false).HasError())
+		require.Len(t, byName, 2)
+		first := byName["First"]
+		assert.Equal(t, 2.1, first.TypeVersion.ValueFloat64())
+		assert.True(t, first.Disabled.ValueBool())
+		assert.True(t, first.ContinueOnFail.ValueBool())
+		var position []float64
+		require.False(t, first.Position.ElementsAs(ctx, &position, false).HasError())
+		assert.Equal(t, []float64{250.3, 300}, position, "positions keep their fraction without float32 noise")
+		assert.False(t, first.Parameters.IsNull())
+		assert.Equal(t, "n8n-nodes-base.noOp", byName["Added"].Type.ValueString())
+	})
+
+	t.Run("keeps an unconfigured attribute", func(t *testing.T) {
+		t.Parallel()
+		var diags diag.Diagnostics
+		nodeMap := mapNodesAttribute(ctx, []n8nsdk.Node{{Name: shared.Ptr("First")}}, types.Map{}, &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, nodeMap.IsNull())
+		assert.Equal(t, nodeObjectType, nodeMap.ElementType(ctx))
+	})
+}
+
+// testPlanProvider is a provider serving the workflow resource, for plan tests through the framework.
+type testPlanProvider struct{}
+
+func (p *testPlanProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "n8n"
+}
+
+func (p *testPlanProvider) Schema(_ context.Context, _ provider.SchemaRequest, _ *provider.SchemaResponse) {
+}
+
+func (p *testPlanProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	resp.ResourceData = &client.N8nClient{}
+}
+
+func (p *testPlanProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{func() resource.Resource { return NewWorkflowResource() }}
+}
+
+func (p *testPlanProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func TestWorkflowResource_planNodes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewWorkflowResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// workflow returns a workflow with a webhook node and an HTTP request node.
+	workflow := func(t *testing.T, url string, known bool) tftypes.Value {
+		t.Helper()
+		webhook := testNode(t)
+		webhook.Parameters = jsontypes.NewParametersValue(`{"path":"hook"}`)
+		request := testNode(t)
+		request.Type = types.StringValue("n8n-nodes-base.httpRequest")
+		request.Parameters = jsontypes.NewParametersValue(fmt.Sprintf(`{"url":%q}`, url))
+		request.WebhookID = types.StringNull()
+		// Set the identifiers generated by n8n.
+		if known {
+			webhook.ID = types.StringValue("id-1")
+			webhook.WebhookID = types.StringValue("hook-1")
+			request.ID = types.StringValue("id-2")
+		} else {
+			webhook.ID = types.StringNull()
+			webhook.WebhookID = types.StringNull()
+			request.ID = types.StringNull()
+		}
+
+		attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attrType := range objectType.AttributeTypes {
+			attrs[name] = tftypes.NewValue(attrType, nil)
+		}
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attrs)}
+		require.False(t, state.SetAttribute(ctx, path.Root("name"), "Workflow").HasError())
+		require.False(t, state.SetAttribute(ctx, path.Root("nodes"), testNodes(t, map[string]models.Node{
+			"Webhook": webhook, "Call API": request,
+		})).HasError())
+		// Set the attributes computed by the provider.
+		if known {
+			require.False(t, state.SetAttribute(ctx, path.Root("id"), "wf-1").HasError())
+			require.False(t, state.SetAttribute(ctx, path.Root("version_id"), "v1").HasError())
+		}
+
+		return state.Raw
+	}
+	dynamicValue := func(t *testing.T, value tftypes.Value) *tfprotov6.DynamicValue {
+		t.Helper()
+		dynamic, err := tfprotov6.NewDynamicValue(objectType, value)
+		require.NoError(t, err)
+
+		return &dynamic
+	}
+
+	server, err := providerserver.NewProtocol6WithError(&testPlanProvider{})()
+	require.NoError(t, err)
+	providerConfig, err := tfprotov6.NewDynamicValue(tftypes.Object{}, tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}))
+	require.NoError(t, err)
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &providerConfig})
+	require.NoError(t, err)
+	require.Empty(t, configureResp.Diagnostics)
+
+	prior := workflow(t, "https://example.com/v1", true)
+	// Terraform proposes the configuration, with the computed values of the prior state.
+	proposed := workflow(t, "https://example.com/v2", true)
+	resp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "n8n_workflow",
+		PriorState:       dynamicValue(t, prior),
+		ProposedNewState: dynamicValue(t, proposed),
+		Config:           dynamicValue(t, workflow(t, "https://example.com/v2", false)),
+	})
+	require.NoError(t, err)
+	for _, diagnostic := range resp.Diagnostics {
+		assert.NotEqual(t, tfprotov6.DiagnosticSeverityError, diagnostic.Severity, diagnostic.Summary+": "+diagnostic.Detail)
+	}
+	assert.Empty(t, resp.RequiresReplace, "node changes are updated in place")
+
+	planned, err := resp.PlannedState.Unmarshal(objectType)
+	require.NoError(t, err)
+	plannedNodes, _, err := tftypes.WalkAttributePath(planned, tftypes.NewAttributePath().WithAttributeName("nodes"))
+	require.NoError(t, err)
+	priorNodes, _, err := tftypes.WalkAttributePath(prior, tftypes.NewAttributePath().WithAttributeName("nodes"))
+	require.NoError(t, err)
+	proposedNodes, _, err := tftypes.WalkAttributePath(proposed, tftypes.NewAttributePath().WithAttributeName("nodes"))
+	require.NoError(t, err)
+
+	var plannedByName, priorByName, proposedByName map[string]tftypes.Value
+	require.NoError(t, plannedNodes.(tftypes.Value).As(&plannedByName))
+	require.NoError(t, priorNodes.(tftypes.Value).As(&priorByName))
+	require.NoError(t, proposedNodes.(tftypes.Value).As(&proposedByName))
+	// Check that the unchanged node is planned as-is, and the changed node keeps its identifiers.
+	assert.True(t, plannedByName["Webhook"].Equal(priorByName["Webhook"]), plannedByName["Webhook"].String())
+	assert.True(t, plannedByName["Call API"].Equal(proposedByName["Call API"]), plannedByName["Call API"].String())
+	assert.False(t, plannedByName["Call API"].Equal(priorByName["Call API"]))
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+)
+
+// Ensure NormalizeWorkflowFunction implements required interfaces.
+var _ function.Function = &NormalizeWorkflowFunction{}
+
+// NormalizeWorkflowFunction splits an exported n8n workflow into the JSON attributes of the n8n_workflow resource.
+type NormalizeWorkflowFunction struct{}
+
+// workflowExport is the part of an exported n8n workflow managed by the n8n_workflow resource.
+type workflowExport struct {
+	Name        string                  `json:"name"`
+	Nodes       []n8nsdk.Node           `json:"nodes"`
+	Connections map[string]any          `json:"connections"`
+	Settings    n8nsdk.WorkflowSettings `json:"settings"`
+}
+
+// normalizedWorkflow is the object returned by the normalize_workflow function.
+type normalizedWorkflow struct {
+	Name            types.String `tfsdk:"name"`
+	NodesJSON       types.String `tfsdk:"nodes_json"`
+	ConnectionsJSON types.String `tfsdk:"connections_json"`
+	SettingsJSON    types.String `tfsdk:"settings_json"`
+}
+
+// normalizedWorkflowAttributeTypes are the attribute types of the normalize_workflow result.
+var normalizedWorkflowAttributeTypes map[string]attr.Type = map[string]attr.Type{
+	"name":             types.StringType,
+	"nodes_json":       types.StringType,
+	"connections_json": types.StringType,
+	"settings_json":    types.StringType,
+}
+
+// NewNormalizeWorkflowFunction creates a new NormalizeWorkflowFunction instance.
+//
+// Returns:
+//   - function.Function: the normalize_workflow function
+func NewNormalizeWorkflowFunction() function.Function {
+	// Return new instance.
+	return &NormalizeWorkflowFunction{}
+}
+
+// Metadata returns the function name.
+//
+// Params:
+//   - _ctx: The context for the request (unused).
+//   - _req: The metadata request (unused).
+//   - resp: The metadata response to populate.
+func (f *NormalizeWorkflowFunction) Metadata(_ctx context.Context, _req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_workflow"
+}
+
+// Definition defines the parameters and return type of the function.
+//
+// Params:
+//   - _ctx: The context for the request (unused).
+//   - _req: The definition request (unused).
+//   - resp: The definition response to populate.
+func (f *NormalizeWorkflowFunction) Definition(_ctx context.Context, _req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalizes an exported n8n workflow",
+		MarkdownDescription: "Splits a workflow exported from the n8n editor into `name`, `nodes_json`, " +
+			"`connections_json` and `settings_json`, serialized exactly as the `n8n_workflow` resource stores them, " +
+			"so importing an export does not produce a diff.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "workflow_json",
+				MarkdownDescription: "Exported workflow JSON",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: normalizedWorkflowAttributeTypes,
+		},
+	}
+}
+
+// Run normalizes the workflow JSON.
+//
+// Params:
+//   - ctx: The context for the request.
+//   - req: The run request containing the arguments.
+//   - resp: The run response to populate.
+func (f *NormalizeWorkflowFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var workflowJSON string
+
+	resp.Error = req.Arguments.Get(ctx, &workflowJSON)
+	// Check for argument errors.
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, funcErr := normalizeWorkflowJSON(workflowJSON)
+	// Check for parse errors.
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
+
+// normalizeWorkflowJSON parses an exported workflow and serializes it like the n8n_workflow resource.
+//
+// Params:
+//   - workflowJSON: Exported workflow JSON
+//
+// Returns:
+//   - normalizedWorkflow: The workflow JSON attributes
+//   - *function.FuncError: Error if the workflow cannot be parsed
+func normalizeWorkflowJSON(workflowJSON string) (normalizedWorkflow, *function.FuncError) {
+	var export workflowExport
+	// Check for parse error.
+	if err := json.Unmarshal([]byte(workflowJSON), &export); err != nil {
+		// Return error.
+		return normalizedWorkflow{}, function.NewArgumentFuncError(0, fmt.Sprintf("Invalid workflow JSON: %s", err.Error()))
+	}
+	// Default missing nodes.
+	if export.Nodes == nil {
+		export.Nodes = []n8nsdk.Node{}
+	}
+	// Default missing connections.
+	if export.Connections == nil {
+		export.Connections = map[string]any{}
+	}
+
+	workflow := n8nsdk.NewWorkflow(export.Name, export.Nodes, export.Connections, export.Settings)
+	plan := &models.Resource{}
+	serializeWorkflowJSON(workflow, plan)
+
+	// Return normalized workflow.
+	return normalizedWorkflow{
+		Name:            types.StringValue(export.Name),
+		NodesJSON:       plan.NodesJSON.StringValue,
+		ConnectionsJSON: plan.ConnectionsJSON.StringValue,
+		SettingsJSON:    plan.SettingsJSON.StringValue,
+	}, nil
+}
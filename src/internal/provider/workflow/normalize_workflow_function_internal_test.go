@@ -0,0 +1,76 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeWorkflowFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		workflow string
+		want     map[string]attr.Value
+		wantErr  string
+	}{
+		{
+			name: "export is split and normalized",
+			workflow: `{
+				"name": "Example",
+				"active": false,
+				"id": "abc",
+				"nodes": [{"parameters": {"path": "hook"}, "name": "Webhook", "type": "n8n-nodes-base.webhook", "typeVersion": 2, "position": [250, 300], "id": "n1"}],
+				"connections": {"Webhook": {"main": [[{"node": "IF", "type": "main", "index": 0}]]}},
+				"settings": {"executionOrder": "v1", "callerPolicy": "workflowsFromSameOwner"}
+			}`,
+			want: map[string]attr.Value{
+				"name":             types.StringValue("Example"),
+				"nodes_json":       types.StringValue(`[{"id":"n1","name":"Webhook","parameters":{"path":"hook"},"position":[250,300],"type":"n8n-nodes-base.webhook","typeVersion":2}]`),
+				"connections_json": types.StringValue(`{"Webhook":{"main":[[{"index":0,"node":"IF","type":"main"}]]}}`),
+				"settings_json":    types.StringValue(`{"executionOrder":"v1"}`),
+			},
+		},
+		{
+			name:     "missing fields default to empty",
+			workflow: `{"name": "Empty"}`,
+			want: map[string]attr.Value{
+				"name":             types.StringValue("Empty"),
+				"nodes_json":       types.StringValue(`[]`),
+				"connections_json": types.StringValue(`{}`),
+				"settings_json":    types.StringValue(`{}`),
+			},
+		},
+		{
+			name:     "error case - invalid JSON",
+			workflow: `{invalid`,
+			wantErr:  "Invalid workflow JSON",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, funcErr := runFunction(t, NewNormalizeWorkflowFunction(),
+				types.ObjectUnknown(normalizedWorkflowAttributeTypes), types.StringValue(tt.workflow),
+			)
+
+			if tt.wantErr != "" {
+				require.NotNil(t, funcErr)
+				assert.Contains(t, funcErr.Text, tt.wantErr)
+				return
+			}
+			require.Nil(t, funcErr)
+			assert.Equal(t, types.ObjectValueMust(normalizedWorkflowAttributeTypes, tt.want), result)
+		})
+	}
+}
@@ -15,19 +15,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
 )
 
 // WORKFLOW_ATTRIBUTES_SIZE defines the initial capacity for workflow attributes map.
-const WORKFLOW_ATTRIBUTES_SIZE int = 15
+const WORKFLOW_ATTRIBUTES_SIZE int = 18
 
 // Ensure WorkflowResource implements required interfaces.
 var (
-	_ resource.Resource                = &WorkflowResource{}
-	_ WorkflowResourceInterface        = &WorkflowResource{}
-	_ resource.ResourceWithConfigure   = &WorkflowResource{}
-	_ resource.ResourceWithImportState = &WorkflowResource{}
+	_ resource.Resource                   = &WorkflowResource{}
+	_ WorkflowResourceInterface           = &WorkflowResource{}
+	_ resource.ResourceWithConfigure      = &WorkflowResource{}
+	_ resource.ResourceWithImportState    = &WorkflowResource{}
+	_ resource.ResourceWithModifyPlan     = &WorkflowResource{}
+	_ resource.ResourceWithValidateConfig = &WorkflowResource{}
 )
 
 // WorkflowResource defines the resource implementation for n8n workflows.
@@ -50,6 +54,8 @@ type WorkflowResourceInterface interface {
 	Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse)
 	Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse)
 	ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse)
+	ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse)
+	ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse)
 }
 
 // WorkflowResource defines the resource implementation for workflows.
@@ -101,10 +107,14 @@ func (r *WorkflowResource) Metadata(_ctx context.Context, req resource.MetadataR
 //
 // Returns:
 //   - None: Updates resp in-place
-func (r *WorkflowResource) Schema(_ctx context.Context, _req resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *WorkflowResource) Schema(ctx context.Context, _req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "n8n workflow resource using generated SDK",
 		Attributes:          r.schemaAttributes(),
+		Blocks: map[string]schema.Block{
+			"node_connection": r.connectionBlock(),
+			"timeouts":        shared.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -118,6 +128,8 @@ func (r *WorkflowResource) schemaAttributes() map[string]schema.Attribute {
 	r.addCoreAttributes(attrs)
 	r.addJSONAttributes(attrs)
 	r.addMetadataAttributes(attrs)
+	attrs["nodes"] = r.nodesAttribute()
+	attrs["settings"] = r.settingsAttribute()
 
 	// Return schema attributes.
 	return attrs
@@ -146,8 +158,13 @@ func (r *WorkflowResource) addCoreAttributes(attrs map[string]schema.Attribute)
 		ElementType:         types.StringType,
 		Optional:            true,
 	}
+	attrs["tags_all"] = schema.SetAttribute{
+		MarkdownDescription: "Set of tag IDs applied to this workflow, including the provider `default_tags`",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
 	attrs["project_id"] = schema.StringAttribute{
-		MarkdownDescription: "Project ID where the workflow should be created. If not specified, workflow is created in the default 'Overview' location. The workflow can be transferred to a different project by updating this value. Note: Once assigned to a project, a workflow cannot be moved back to the Overview location due to n8n API limitations.",
+		MarkdownDescription: "Project ID where the workflow should be created. If not specified, the provider `default_project_id` is used, or the workflow is created in the default 'Overview' location. The workflow can be transferred to a different project by updating this value. Note: Once assigned to a project, a workflow cannot be moved back to the Overview location due to n8n API limitations.",
 		Optional:            true,
 		Computed:            true,
 	}
@@ -159,19 +176,25 @@ func (r *WorkflowResource) addCoreAttributes(attrs map[string]schema.Attribute)
 //   - attrs: attribute map to populate
 func (r *WorkflowResource) addJSONAttributes(attrs map[string]schema.Attribute) {
 	attrs["nodes_json"] = schema.StringAttribute{
-		MarkdownDescription: "Workflow nodes as JSON string. Must be valid JSON array of node objects.",
-		Optional:            true,
-		Computed:            true,
+		MarkdownDescription: "Workflow nodes as JSON string. Must be valid JSON array of node objects. " +
+			"Key order, node order and parameters defaulted by n8n are ignored when comparing with the workflow in n8n.",
+		CustomType: jsontypes.NodesType,
+		Optional:   true,
+		Computed:   true,
 	}
 	attrs["connections_json"] = schema.StringAttribute{
-		MarkdownDescription: "Workflow connections as JSON string. Must be valid JSON object mapping node connections.",
-		Optional:            true,
-		Computed:            true,
+		MarkdownDescription: "Workflow connections as JSON string. Must be valid JSON object mapping node connections. " +
+			"Key order and nodes without connections are ignored when comparing with the workflow in n8n.",
+		CustomType: jsontypes.ConnectionsType,
+		Optional:   true,
+		Computed:   true,
 	}
 	attrs["settings_json"] = schema.StringAttribute{
-		MarkdownDescription: "Workflow settings as JSON string. Must be valid JSON object.",
-		Optional:            true,
-		Computed:            true,
+		MarkdownDescription: "Workflow settings as JSON string. Must be valid JSON object. " +
+			"Key order and the `callerPolicy` and `availableInMCP` defaults are ignored when comparing with the workflow in n8n.",
+		CustomType: jsontypes.SettingsType,
+		Optional:   true,
+		Computed:   true,
 	}
 }
 
@@ -242,6 +265,47 @@ func (r *WorkflowResource) Configure(_ctx context.Context, req resource.Configur
 	r.client = clientData
 }
 
+// ValidateConfig checks that nodes, connections and settings are configured with either attributes, blocks or JSON,
+// and validates the workflow graph when it is known.
+//
+// Params:
+//   - ctx: Context for the operation
+//   - req: ValidateConfig request containing the configuration
+//   - resp: ValidateConfig response collecting diagnostics
+func (r *WorkflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.Resource
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	// Check for config parsing errors.
+	if resp.Diagnostics.HasError() {
+		// Return with error.
+		return
+	}
+
+	validateNodesAttribute(&config, &resp.Diagnostics)
+	validateConnectionBlocks(&config, &resp.Diagnostics)
+	validateSettingsAttribute(&config, &resp.Diagnostics)
+	// Check for a known workflow graph.
+	if graph, known := workflowGraphFromModel(ctx, &config); known {
+		validateWorkflowGraph(graph, &resp.Diagnostics)
+	}
+}
+
+// ModifyPlan plans the project of the workflow, and tags_all from tags merged with the provider
+// default_tags.
+// It also checks the nodes and triggers against the node catalog, and validates the workflow graph when it was
+// not known yet during the configuration validation.
+//
+// Params:
+//   - ctx: Context for the operation
+//   - req: ModifyPlan request containing config, state and plan
+//   - resp: ModifyPlan response to update the plan
+func (r *WorkflowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	shared.ModifyPlanProjectID(ctx, r.client, req, resp)
+	r.modifyPlanTagsAll(ctx, req, resp)
+	r.modifyPlanWorkflowGraph(ctx, req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 //
 // Params:
@@ -261,6 +325,9 @@ func (r *WorkflowResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_CREATE, "creating workflow", plan.Timeouts.Create, &resp.Diagnostics)
+	defer done()
+
 	// Execute create logic
 	if !r.executeCreateLogic(ctx, plan, resp) {
 		// Return with error.
@@ -283,7 +350,7 @@ func (r *WorkflowResource) Create(ctx context.Context, req resource.CreateReques
 //   - bool: True if creation succeeded, false otherwise
 func (r *WorkflowResource) executeCreateLogic(ctx context.Context, plan *models.Resource, resp *resource.CreateResponse) bool {
 	// Parse JSON fields.
-	nodes, connections, settings := parseWorkflowJSON(plan, &resp.Diagnostics)
+	nodes, connections, settings := parseWorkflowJSON(ctx, plan, &resp.Diagnostics)
 	// Check for JSON parsing errors.
 	if resp.Diagnostics.HasError() {
 		// Return failure.
@@ -313,7 +380,9 @@ func (r *WorkflowResource) executeCreateLogic(ctx context.Context, plan *models.
 
 	// Map workflow state to model.
 	// Note: plan.Active retains value from plan or activation result.
+	configuredTags := plan.Tags
 	mapWorkflowToModel(ctx, workflow, plan, &resp.Diagnostics)
+	r.excludeDefaultTags(ctx, plan, configuredTags, &resp.Diagnostics)
 
 	// Return success.
 	return true
@@ -389,6 +458,9 @@ func (r *WorkflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_READ, "reading workflow", state.Timeouts.Read, &resp.Diagnostics)
+	defer done()
+
 	// Execute read logic
 	if !r.executeReadLogic(ctx, state, resp) {
 		// Return with error.
@@ -420,16 +492,25 @@ func (r *WorkflowResource) executeReadLogic(ctx context.Context, state *models.R
 
 	// Check for API error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// The workflow was deleted outside Terraform.
+		if shared.RemoveIfNotFound(ctx, err, httpResp, &resp.State, "workflow", state.ID.ValueString()) {
+			// Return failure.
+			return false
+		}
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error reading workflow",
-			fmt.Sprintf("Could not read workflow ID %s: %s\nHTTP Response: %v", state.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not read workflow ID %s", state.ID.ValueString()),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
 	}
 
 	// Map response to state.
+	priorTags := state.Tags
 	mapWorkflowToModel(ctx, workflow, state, &resp.Diagnostics)
+	r.excludeDefaultTags(ctx, state, priorTags, &resp.Diagnostics)
 
 	// Return success.
 	return true
@@ -456,6 +537,9 @@ func (r *WorkflowResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_UPDATE, "updating workflow", plan.Timeouts.Update, &resp.Diagnostics)
+	defer done()
+
 	// Execute update logic
 	if !r.executeUpdateLogic(ctx, plan, state, resp) {
 		// Return with error.
@@ -491,7 +575,9 @@ func (r *WorkflowResource) executeUpdateLogic(ctx context.Context, plan, state *
 
 	// Finalize state by mapping response and applying fallbacks.
 	applyTimestampFallbacks(plan, state)
+	configuredTags := plan.Tags
 	mapWorkflowToModel(ctx, workflow, plan, &resp.Diagnostics)
+	r.excludeDefaultTags(ctx, plan, configuredTags, &resp.Diagnostics)
 	preserveProjectIDOnUpdate(plan, state)
 
 	// Return success.
@@ -511,7 +597,7 @@ func (r *WorkflowResource) executeUpdateLogic(ctx context.Context, plan, state *
 //   - *n8nsdk.Workflow: The updated workflow or nil on error
 func (r *WorkflowResource) performUpdateOperations(ctx context.Context, workflowID string, plan, state *models.Resource, diags *diag.Diagnostics) *n8nsdk.Workflow {
 	// Parse JSON fields.
-	nodes, connections, settings := parseWorkflowJSON(plan, diags)
+	nodes, connections, settings := parseWorkflowJSON(ctx, plan, diags)
 	// Check for JSON parsing errors.
 	if diags.HasError() {
 		return nil
@@ -592,6 +678,9 @@ func (r *WorkflowResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, done := shared.WithTimeout(ctx, shared.OPERATION_DELETE, "deleting workflow", state.Timeouts.Delete, &resp.Diagnostics)
+	defer done()
+
 	// Execute delete logic
 	r.executeDeleteLogic(ctx, state, resp)
 }
@@ -616,9 +705,16 @@ func (r *WorkflowResource) executeDeleteLogic(ctx context.Context, state *models
 
 	// Check for API error.
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// The workflow was already deleted outside Terraform.
+		if shared.IgnoreNotFound(ctx, err, httpResp, "workflow", state.ID.ValueString()) {
+			// Return success.
+			return true
+		}
+		shared.AddAPIError(
+			&resp.Diagnostics,
 			"Error deleting workflow",
-			fmt.Sprintf("Could not delete workflow ID %s: %s\nHTTP Response: %v", state.ID.ValueString(), err.Error(), httpResp),
+			fmt.Sprintf("Could not delete workflow ID %s", state.ID.ValueString()),
+			err, httpResp,
 		)
 		// Return failure.
 		return false
@@ -439,8 +439,12 @@ func TestWorkflowResource_ImportState(t *testing.T) {
 		"name":             tftypes.NewValue(tftypes.String, nil),
 		"active":           tftypes.NewValue(tftypes.Bool, nil),
 		"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 		"project_id":       tftypes.NewValue(tftypes.String, nil),
 		"nodes_json":       tftypes.NewValue(tftypes.String, nil),
+		"nodes":            tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["nodes"], nil),
+		"node_connection":  tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["node_connection"], nil),
+		"settings":         tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["settings"], nil),
 		"connections_json": tftypes.NewValue(tftypes.String, nil),
 		"settings_json":    tftypes.NewValue(tftypes.String, nil),
 		"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -450,6 +454,7 @@ func TestWorkflowResource_ImportState(t *testing.T) {
 		"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 		"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 		"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 	})
 
 	req := resource.ImportStateRequest{
@@ -7,7 +7,9 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -15,8 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared/client"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
 	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // strPtr returns a pointer to the given string value.
@@ -60,6 +64,15 @@ func TestWorkflowResource_InterfaceCompliance(t *testing.T) {
 	})
 }
 
+// testNodesType is the Terraform type of the nodes attribute.
+var testNodesType tftypes.Type = types.MapType{ElemType: nodeObjectType}.TerraformType(context.Background())
+
+// testConnectionBlockType is the Terraform type of the connection blocks.
+var testConnectionBlockType tftypes.Type = types.SetType{ElemType: connectionBlockObjectType}.TerraformType(context.Background())
+
+// testSettingsType is the Terraform type of the settings attribute.
+var testSettingsType tftypes.Type = types.ObjectType{AttrTypes: settingsAttributeTypes}.TerraformType(context.Background())
+
 // createTestSchema creates a test schema for workflow resource.
 func createTestSchema(t *testing.T) schema.Schema {
 	t.Helper()
@@ -177,19 +190,19 @@ func TestWORKFLOW_ATTRIBUTES_SIZE(t *testing.T) {
 			name: "constant is defined",
 			testFunc: func(t *testing.T) {
 				t.Helper()
-				assert.Equal(t, 15, WORKFLOW_ATTRIBUTES_SIZE)
+				assert.Equal(t, 18, WORKFLOW_ATTRIBUTES_SIZE)
 			},
 		},
 		{
-			name: "actual schema has 15 attributes",
+			name: "actual schema has 18 attributes",
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				r := &WorkflowResource{}
 				attrs := r.schemaAttributes()
-				// The actual schema has 15 attributes:
-				// id, name, active, tags, project_id, nodes_json, connections_json, settings_json,
-				// created_at, updated_at, version_id, is_archived, trigger_count, meta, pin_data
-				assert.Equal(t, 15, len(attrs))
+				// The actual schema has 18 attributes:
+				// id, name, active, tags, tags_all, project_id, nodes_json, connections_json, settings_json,
+				// created_at, updated_at, version_id, is_archived, trigger_count, meta, pin_data, nodes, settings
+				assert.Equal(t, 18, len(attrs))
 			},
 		},
 		{
@@ -303,8 +316,12 @@ func TestWorkflowResource_Create_JSONParsing(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Test Workflow"),
 					"active":           tftypes.NewValue(tftypes.Bool, nil),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "tag1")}),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, nil),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, nil),
 					"settings_json":    tftypes.NewValue(tftypes.String, nil),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -314,6 +331,7 @@ func TestWorkflowResource_Create_JSONParsing(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -321,8 +339,12 @@ func TestWorkflowResource_Create_JSONParsing(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -332,6 +354,7 @@ func TestWorkflowResource_Create_JSONParsing(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -368,12 +391,12 @@ func TestWorkflowResource_Create_JSONParsing(t *testing.T) {
 				t.Helper()
 				plan := &models.Resource{
 					Name:            types.StringValue("Test Workflow"),
-					NodesJSON:       types.StringValue("invalid json"),
-					ConnectionsJSON: types.StringValue("{}"),
+					NodesJSON:       jsontypes.NewNodesValue("invalid json"),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("{}"),
 				}
 
 				diags := &diag.Diagnostics{}
-				parseWorkflowJSON(plan, diags)
+				parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.True(t, diags.HasError())
 				assert.Contains(t, diags.Errors()[0].Summary(), "Invalid nodes JSON")
@@ -385,12 +408,12 @@ func TestWorkflowResource_Create_JSONParsing(t *testing.T) {
 				t.Helper()
 				plan := &models.Resource{
 					Name:            types.StringValue("Test Workflow"),
-					NodesJSON:       types.StringValue("[]"),
-					ConnectionsJSON: types.StringValue("invalid json"),
+					NodesJSON:       jsontypes.NewNodesValue("[]"),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("invalid json"),
 				}
 
 				diags := &diag.Diagnostics{}
-				parseWorkflowJSON(plan, diags)
+				parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.True(t, diags.HasError())
 				assert.Contains(t, diags.Errors()[0].Summary(), "Invalid connections JSON")
@@ -402,13 +425,13 @@ func TestWorkflowResource_Create_JSONParsing(t *testing.T) {
 				t.Helper()
 				plan := &models.Resource{
 					Name:            types.StringValue("Test Workflow"),
-					NodesJSON:       types.StringValue("[]"),
-					ConnectionsJSON: types.StringValue("{}"),
-					SettingsJSON:    types.StringValue("invalid json"),
+					NodesJSON:       jsontypes.NewNodesValue("[]"),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("{}"),
+					SettingsJSON:    jsontypes.NewSettingsValue("invalid json"),
 				}
 
 				diags := &diag.Diagnostics{}
-				parseWorkflowJSON(plan, diags)
+				parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.True(t, diags.HasError())
 				assert.Contains(t, diags.Errors()[0].Summary(), "Invalid settings JSON")
@@ -420,13 +443,13 @@ func TestWorkflowResource_Create_JSONParsing(t *testing.T) {
 				t.Helper()
 				plan := &models.Resource{
 					Name:            types.StringValue("Test Workflow"),
-					NodesJSON:       types.StringValue("[]"),
-					ConnectionsJSON: types.StringValue("{}"),
-					SettingsJSON:    types.StringValue("{}"),
+					NodesJSON:       jsontypes.NewNodesValue("[]"),
+					ConnectionsJSON: jsontypes.NewConnectionsValue("{}"),
+					SettingsJSON:    jsontypes.NewSettingsValue("{}"),
 				}
 
 				diags := &diag.Diagnostics{}
-				nodes, connections, settings := parseWorkflowJSON(plan, diags)
+				nodes, connections, settings := parseWorkflowJSON(context.Background(), plan, diags)
 
 				assert.False(t, diags.HasError())
 				assert.NotNil(t, nodes)
@@ -550,8 +573,12 @@ func TestWorkflowResource_Delete_Internal(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "test"),
 					"active":           tftypes.NewValue(tftypes.Bool, false),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, nil),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -561,6 +588,7 @@ func TestWorkflowResource_Delete_Internal(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), rawState)
@@ -609,8 +637,12 @@ func TestWorkflowResource_Delete_Internal(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "test"),
 					"active":           tftypes.NewValue(tftypes.Bool, false),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, nil),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -620,6 +652,7 @@ func TestWorkflowResource_Delete_Internal(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 
 				stateRaw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), rawState)
@@ -641,6 +674,71 @@ func TestWorkflowResource_Delete_Internal(t *testing.T) {
 				assert.True(t, resp.Diagnostics.HasError())
 			},
 		},
+		{
+			name: "error - delete exceeding its timeout",
+			testFunc: func(t *testing.T) {
+				t.Helper()
+				handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					// Hang until the client gives up.
+					<-r.Context().Done()
+				})
+
+				n8nClient, server := setupTestClient(t, handler)
+				defer server.Close()
+
+				r := NewWorkflowResource()
+				r.Configure(context.Background(), resource.ConfigureRequest{
+					ProviderData: n8nClient,
+				}, &resource.ConfigureResponse{})
+
+				ctx := context.Background()
+				schemaResp := resource.SchemaResponse{}
+				r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+				timeoutsType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}
+				rawState := map[string]tftypes.Value{
+					"id":               tftypes.NewValue(tftypes.String, "test-workflow-id"),
+					"name":             tftypes.NewValue(tftypes.String, "test"),
+					"active":           tftypes.NewValue(tftypes.Bool, false),
+					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"project_id":       tftypes.NewValue(tftypes.String, nil),
+					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
+					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
+					"settings_json":    tftypes.NewValue(tftypes.String, nil),
+					"created_at":       tftypes.NewValue(tftypes.String, nil),
+					"updated_at":       tftypes.NewValue(tftypes.String, nil),
+					"version_id":       tftypes.NewValue(tftypes.String, nil),
+					"is_archived":      tftypes.NewValue(tftypes.Bool, nil),
+					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
+					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+						"create": tftypes.NewValue(tftypes.String, nil),
+						"read":   tftypes.NewValue(tftypes.String, nil),
+						"update": tftypes.NewValue(tftypes.String, nil),
+						"delete": tftypes.NewValue(tftypes.String, "50ms"),
+					}),
+				}
+
+				state := tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), rawState),
+				}
+				resp := &resource.DeleteResponse{}
+
+				r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+				// Verify the timeout names the failed step.
+				require.True(t, resp.Diagnostics.HasError())
+				errs := resp.Diagnostics.Errors()
+				assert.Equal(t, "Operation Timed Out", errs[len(errs)-1].Summary())
+				assert.Contains(t, errs[len(errs)-1].Detail(), `The delete operation exceeded its 50ms timeout during step "deleting workflow".`)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -853,13 +951,13 @@ func TestWorkflowResource_schemaAttributes(t *testing.T) {
 	}{
 		{
 			name:          "returns correct number of attributes",
-			wantAttrCount: 15,
+			wantAttrCount: 18,
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				r := &WorkflowResource{}
 				attrs := r.schemaAttributes()
 				assert.NotNil(t, attrs)
-				assert.Equal(t, 15, len(attrs), "Should have exactly 15 attributes")
+				assert.Equal(t, 18, len(attrs), "Should have exactly 18 attributes")
 			},
 		},
 		{
@@ -928,10 +1026,10 @@ func TestWorkflowResource_schemaAttributes(t *testing.T) {
 				attrs := r.schemaAttributes()
 				// Verify all expected keys are unique (map already ensures uniqueness)
 				expectedKeys := []string{
-					"id", "name", "active", "tags", "project_id",
+					"id", "name", "active", "tags", "tags_all", "project_id",
 					"nodes_json", "connections_json", "settings_json",
 					"created_at", "updated_at", "version_id",
-					"is_archived", "trigger_count", "meta", "pin_data",
+					"is_archived", "trigger_count", "meta", "pin_data", "nodes", "settings",
 				}
 				assert.Equal(t, len(expectedKeys), len(attrs), "Should have no duplicate keys")
 			},
@@ -970,7 +1068,7 @@ func TestWorkflowResource_addCoreAttributes(t *testing.T) {
 				attrs := make(map[string]schema.Attribute)
 				r.addCoreAttributes(attrs)
 				assert.NotNil(t, attrs)
-				assert.Equal(t, 5, len(attrs), "Should add exactly 5 core attributes")
+				assert.Equal(t, 6, len(attrs), "Should add exactly 6 core attributes")
 			},
 		},
 		{
@@ -1043,7 +1141,7 @@ func TestWorkflowResource_addCoreAttributes(t *testing.T) {
 					"existing": schema.StringAttribute{},
 				}
 				r.addCoreAttributes(attrs)
-				assert.Equal(t, 6, len(attrs), "Should have 1 existing + 5 new attributes")
+				assert.Equal(t, 7, len(attrs), "Should have 1 existing + 6 new attributes")
 				assert.Contains(t, attrs, "existing")
 				assert.Contains(t, attrs, "id")
 			},
@@ -1461,8 +1559,12 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Test"),
 					"active":           tftypes.NewValue(tftypes.Bool, nil),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "invalid json"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, nil),
 					"settings_json":    tftypes.NewValue(tftypes.String, nil),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -1472,6 +1574,7 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -1479,8 +1582,12 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -1490,6 +1597,7 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -1530,8 +1638,12 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Test"),
 					"active":           tftypes.NewValue(tftypes.Bool, nil),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, "{}"),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -1541,6 +1653,7 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -1548,8 +1661,12 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -1559,6 +1676,7 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -1616,8 +1734,12 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Test"),
 					"active":           tftypes.NewValue(tftypes.Bool, nil),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "tag1")}),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, "{}"),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -1627,6 +1749,7 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -1634,8 +1757,12 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -1645,6 +1772,7 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -1698,8 +1826,12 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Test"),
 					"active":           tftypes.NewValue(tftypes.Bool, nil),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, "{}"),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -1709,6 +1841,7 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -1716,8 +1849,12 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -1727,6 +1864,7 @@ func TestWorkflowResource_Create_Complete(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -1766,6 +1904,85 @@ func TestWorkflowResource_Read_Complete(t *testing.T) {
 	}{
 		{
 			name: "read fails when API returns error",
+			testFunc: func(t *testing.T) {
+				t.Helper()
+				handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+					w.Write([]byte("Internal server error"))
+				})
+
+				n8nClient, server := setupTestClient(t, handler)
+				defer server.Close()
+
+				r := &WorkflowResource{client: n8nClient}
+
+				rawState := map[string]tftypes.Value{
+					"id":               tftypes.NewValue(tftypes.String, "wf-123"),
+					"name":             tftypes.NewValue(tftypes.String, "Test"),
+					"active":           tftypes.NewValue(tftypes.Bool, false),
+					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"project_id":       tftypes.NewValue(tftypes.String, nil),
+					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
+					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
+					"settings_json":    tftypes.NewValue(tftypes.String, "{}"),
+					"created_at":       tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+					"updated_at":       tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+					"version_id":       tftypes.NewValue(tftypes.String, "v1"),
+					"is_archived":      tftypes.NewValue(tftypes.Bool, false),
+					"trigger_count":    tftypes.NewValue(tftypes.Number, 0),
+					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
+					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
+				}
+				objectType := tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":               tftypes.String,
+						"name":             tftypes.String,
+						"active":           tftypes.Bool,
+						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
+						"project_id":       tftypes.String,
+						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
+						"connections_json": tftypes.String,
+						"settings_json":    tftypes.String,
+						"created_at":       tftypes.String,
+						"updated_at":       tftypes.String,
+						"version_id":       tftypes.String,
+						"is_archived":      tftypes.Bool,
+						"trigger_count":    tftypes.Number,
+						"meta":             tftypes.Map{ElementType: tftypes.String},
+						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
+					},
+				}
+
+				req := resource.ReadRequest{
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(objectType, rawState),
+						Schema: createTestSchema(t),
+					},
+				}
+				resp := resource.ReadResponse{
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(objectType, rawState),
+						Schema: createTestSchema(t),
+					},
+				}
+
+				r.Read(context.Background(), req, &resp)
+
+				assert.True(t, resp.Diagnostics.HasError())
+			},
+		},
+		{
+			name: "read removes workflow deleted outside Terraform",
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1783,8 +2000,12 @@ func TestWorkflowResource_Read_Complete(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Test"),
 					"active":           tftypes.NewValue(tftypes.Bool, false),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, "{}"),
 					"created_at":       tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
@@ -1794,6 +2015,7 @@ func TestWorkflowResource_Read_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, 0),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -1801,8 +2023,12 @@ func TestWorkflowResource_Read_Complete(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -1812,6 +2038,7 @@ func TestWorkflowResource_Read_Complete(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -1830,7 +2057,8 @@ func TestWorkflowResource_Read_Complete(t *testing.T) {
 
 				r.Read(context.Background(), req, &resp)
 
-				assert.True(t, resp.Diagnostics.HasError())
+				assert.False(t, resp.Diagnostics.HasError())
+				assert.True(t, resp.State.Raw.IsNull())
 			},
 		},
 		{
@@ -1865,8 +2093,12 @@ func TestWorkflowResource_Read_Complete(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Test"),
 					"active":           tftypes.NewValue(tftypes.Bool, false),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, "{}"),
 					"created_at":       tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
@@ -1876,6 +2108,7 @@ func TestWorkflowResource_Read_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, 0),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -1883,8 +2116,12 @@ func TestWorkflowResource_Read_Complete(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -1894,6 +2131,7 @@ func TestWorkflowResource_Read_Complete(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -1949,8 +2187,12 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Test"),
 					"active":           tftypes.NewValue(tftypes.Bool, false),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, "{}"),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -1960,6 +2202,7 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -1967,8 +2210,12 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -1978,6 +2225,7 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -2021,8 +2269,12 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Test"),
 					"active":           tftypes.NewValue(tftypes.Bool, false),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "invalid json"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, nil),
 					"settings_json":    tftypes.NewValue(tftypes.String, nil),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -2032,6 +2284,7 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -2039,8 +2292,12 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -2050,6 +2307,7 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -2097,8 +2355,12 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Test"),
 					"active":           tftypes.NewValue(tftypes.Bool, true),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, "{}"),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -2108,14 +2370,19 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				rawState := map[string]tftypes.Value{
 					"id":               tftypes.NewValue(tftypes.String, "wf-123"),
 					"name":             tftypes.NewValue(tftypes.String, "Test"),
 					"active":           tftypes.NewValue(tftypes.Bool, false),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, "{}"),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -2125,6 +2392,7 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -2132,8 +2400,12 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -2143,6 +2415,7 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -2186,8 +2459,12 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Test"),
 					"active":           tftypes.NewValue(tftypes.Bool, false),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, "{}"),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -2197,6 +2474,7 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -2204,8 +2482,12 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -2215,6 +2497,7 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -2276,8 +2559,12 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Test"),
 					"active":           tftypes.NewValue(tftypes.Bool, false),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "tag1")}),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, "{}"),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -2287,6 +2574,7 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -2294,8 +2582,12 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -2305,6 +2597,7 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -2362,8 +2655,12 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"name":             tftypes.NewValue(tftypes.String, "Updated"),
 					"active":           tftypes.NewValue(tftypes.Bool, false),
 					"tags":             tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"tags_all":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 					"project_id":       tftypes.NewValue(tftypes.String, nil),
 					"nodes_json":       tftypes.NewValue(tftypes.String, "[]"),
+					"nodes":            tftypes.NewValue(testNodesType, nil),
+					"node_connection":  tftypes.NewValue(testConnectionBlockType, nil),
+					"settings":         tftypes.NewValue(testSettingsType, nil),
 					"connections_json": tftypes.NewValue(tftypes.String, "{}"),
 					"settings_json":    tftypes.NewValue(tftypes.String, "{}"),
 					"created_at":       tftypes.NewValue(tftypes.String, nil),
@@ -2373,6 +2670,7 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 					"trigger_count":    tftypes.NewValue(tftypes.Number, nil),
 					"meta":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 					"pin_data":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"timeouts":         tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}}, nil),
 				}
 				objectType := tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
@@ -2380,8 +2678,12 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 						"name":             tftypes.String,
 						"active":           tftypes.Bool,
 						"tags":             tftypes.Set{ElementType: tftypes.String},
+						"tags_all":         tftypes.Set{ElementType: tftypes.String},
 						"project_id":       tftypes.String,
 						"nodes_json":       tftypes.String,
+						"nodes":            testNodesType,
+						"node_connection":  testConnectionBlockType,
+						"settings":         testSettingsType,
 						"connections_json": tftypes.String,
 						"settings_json":    tftypes.String,
 						"created_at":       tftypes.String,
@@ -2391,6 +2693,7 @@ func TestWorkflowResource_Update_Complete(t *testing.T) {
 						"trigger_count":    tftypes.Number,
 						"meta":             tftypes.Map{ElementType: tftypes.String},
 						"pin_data":         tftypes.Map{ElementType: tftypes.String},
+						"timeouts":         tftypes.Object{AttributeTypes: map[string]tftypes.Type{"create": tftypes.String, "read": tftypes.String, "update": tftypes.String, "delete": tftypes.String}},
 					},
 				}
 
@@ -2531,7 +2834,7 @@ func TestWorkflowResource_executeCreateLogic(t *testing.T) {
 			ctx := context.Background()
 			plan := &models.Resource{
 				Name:      types.StringValue(tt.workflowName),
-				NodesJSON: types.StringValue(tt.nodesJSON),
+				NodesJSON: jsontypes.NewNodesValue(tt.nodesJSON),
 			}
 
 			// Add tags for the tag test case
@@ -2593,15 +2896,8 @@ func TestWorkflowResource_executeReadLogic(t *testing.T) {
 			expectError: false,
 			expectName:  "Retrieved Workflow",
 		},
-		{
-			name:       "workflow not found",
-			workflowID: "workflow-404",
-			setupHandler: func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusNotFound)
-				w.Write([]byte(`{"message": "Workflow not found"}`))
-			},
-			expectError: true,
-		},
+		// Note: "workflow not found" case (RemoveResource) is tested in full CRUD tests
+		// as it requires a properly initialized tfsdk.State with schema
 		{
 			name:       "API error",
 			workflowID: "workflow-500",
@@ -2774,7 +3070,7 @@ func TestWorkflowResource_executeUpdateLogic(t *testing.T) {
 			plan := &models.Resource{
 				ID:        types.StringValue(tt.workflowID),
 				Name:      types.StringValue(tt.newName),
-				NodesJSON: types.StringValue(tt.nodesJSON),
+				NodesJSON: jsontypes.NewNodesValue(tt.nodesJSON),
 			}
 			state := &models.Resource{
 				ID:     types.StringValue(tt.workflowID),
@@ -2826,13 +3122,13 @@ func TestWorkflowResource_executeDeleteLogic(t *testing.T) {
 			expectError: false,
 		},
 		{
-			name:       "workflow not found",
+			name:       "workflow already deleted",
 			workflowID: "workflow-404",
 			setupHandler: func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusNotFound)
 				w.Write([]byte(`{"message": "Workflow not found"}`))
 			},
-			expectError: true,
+			expectError: false,
 		},
 		{
 			name:       "API error",
@@ -3231,9 +3527,9 @@ func TestWorkflowResource_performUpdateOperations(t *testing.T) {
 			plan := &models.Resource{
 				ID:              types.StringValue(tt.workflowID),
 				Name:            types.StringValue("Test Workflow"),
-				NodesJSON:       types.StringValue(tt.planNodesJSON),
-				ConnectionsJSON: types.StringValue(tt.planConnJSON),
-				SettingsJSON:    types.StringValue(tt.planSettJSON),
+				NodesJSON:       jsontypes.NewNodesValue(tt.planNodesJSON),
+				ConnectionsJSON: jsontypes.NewConnectionsValue(tt.planConnJSON),
+				SettingsJSON:    jsontypes.NewSettingsValue(tt.planSettJSON),
 				Active:          tt.planActive,
 				ProjectID:       tt.planProjectID,
 				Tags:            types.SetNull(types.StringType),
@@ -3370,3 +3666,86 @@ func Test_applyTimestampFallbacks(t *testing.T) {
 		})
 	}
 }
+
+// TestWorkflowResource_modifyPlanTagsAll tests the modifyPlanTagsAll method.
+func TestWorkflowResource_modifyPlanTagsAll(t *testing.T) {
+	t.Parallel()
+
+	tagList := `{"data":[{"id":"tag-default","name":"managed"}]}`
+
+	tests := []struct {
+		name        string
+		defaultTags []string
+		tags        tftypes.Value
+		want        types.Set
+	}{
+		{
+			name:        "merges configured and default tags",
+			defaultTags: []string{"managed"},
+			tags:        tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "tag-1")}),
+			want:        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("tag-1"), types.StringValue("tag-default")}),
+		},
+		{
+			name:        "default tags only",
+			defaultTags: []string{"managed"},
+			tags:        tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			want:        types.SetValueMust(types.StringType, []attr.Value{types.StringValue("tag-default")}),
+		},
+		{
+			name: "null without tags or defaults",
+			tags: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			want: types.SetNull(types.StringType),
+		},
+		{
+			name:        "unknown when a default tag does not exist yet",
+			defaultTags: []string{"managed", "missing"},
+			tags:        tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			want:        types.SetUnknown(types.StringType),
+		},
+		{
+			name:        "error case - unknown configured tags",
+			defaultTags: []string{"managed"},
+			tags:        tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+			want:        types.SetUnknown(types.StringType),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			n8nClient, server := setupTestClientForHelpers(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(tagList))
+			})
+			defer server.Close()
+			n8nClient.DefaultTags = client.NewDefaultTags(tt.defaultTags)
+
+			r := NewWorkflowResource()
+			r.client = n8nClient
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+			planAttrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attrType := range objectType.AttributeTypes {
+				planAttrs[name] = tftypes.NewValue(attrType, nil)
+			}
+			planAttrs["tags"] = tt.tags
+			planAttrs["tags_all"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue)
+
+			req := resource.ModifyPlanRequest{
+				Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, planAttrs)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.modifyPlanTagsAll(context.Background(), req, resp)
+			require.False(t, resp.Diagnostics.HasError())
+
+			var got types.Set
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("tags_all"), &got)...)
+			require.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
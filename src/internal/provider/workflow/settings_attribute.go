@@ -0,0 +1,306 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+)
+
+// AVAILABLE_IN_MCP_DEFAULT is the default value for the AvailableInMCP workflow setting.
+const AVAILABLE_IN_MCP_DEFAULT bool = false
+
+// saveDataValues are the values accepted by the save data settings.
+var saveDataValues []string = []string{"all", "none"}
+
+// executionOrderValues are the values accepted by the execution order setting.
+var executionOrderValues []string = []string{"v0", "v1"}
+
+// callerPolicyValues are the values accepted by the caller policy setting.
+var callerPolicyValues []string = []string{"any", "none", "workflowsFromAList", "workflowsFromSameOwner"}
+
+// settingsAttributeTypes are the attribute types of the settings attribute.
+var settingsAttributeTypes map[string]attr.Type = map[string]attr.Type{
+	"timezone":                    types.StringType,
+	"error_workflow":              types.StringType,
+	"execution_timeout":           types.Int64Type,
+	"save_data_error_execution":   types.StringType,
+	"save_data_success_execution": types.StringType,
+	"save_execution_progress":     types.BoolType,
+	"save_manual_executions":      types.BoolType,
+	"execution_order":             types.StringType,
+	"caller_policy":               types.StringType,
+	"caller_ids":                  types.ListType{ElemType: types.StringType},
+	"time_saved_per_execution":    types.Int64Type,
+	"available_in_mcp":            types.BoolType,
+}
+
+// settingsAttribute returns the settings attribute of the workflow resource schema.
+//
+// Returns:
+//   - schema.SingleNestedAttribute: the settings attribute definition
+func (r *WorkflowResource) settingsAttribute() schema.SingleNestedAttribute {
+	// Return settings attribute.
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Workflow settings, as an alternative to `settings_json`. Conflicts with `settings_json`.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "Timezone of the workflow (e.g., 'America/New_York')",
+				Optional:            true,
+			},
+			"error_workflow": schema.StringAttribute{
+				MarkdownDescription: "ID of the workflow that contains the error trigger node",
+				Optional:            true,
+			},
+			"execution_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Execution timeout in seconds, -1 to disable it",
+				Optional:            true,
+			},
+			"save_data_error_execution": schema.StringAttribute{
+				MarkdownDescription: "Whether failed executions are saved: 'all' or 'none'",
+				Optional:            true,
+				Validators:          []validator.String{stringOneOfValidator{values: saveDataValues}},
+			},
+			"save_data_success_execution": schema.StringAttribute{
+				MarkdownDescription: "Whether successful executions are saved: 'all' or 'none'",
+				Optional:            true,
+				Validators:          []validator.String{stringOneOfValidator{values: saveDataValues}},
+			},
+			"save_execution_progress": schema.BoolAttribute{
+				MarkdownDescription: "Whether the execution progress is saved",
+				Optional:            true,
+			},
+			"save_manual_executions": schema.BoolAttribute{
+				MarkdownDescription: "Whether manual executions are saved",
+				Optional:            true,
+			},
+			"execution_order": schema.StringAttribute{
+				MarkdownDescription: "Execution order of the nodes: 'v0' or 'v1'",
+				Optional:            true,
+				Validators:          []validator.String{stringOneOfValidator{values: executionOrderValues}},
+			},
+			"caller_policy": schema.StringAttribute{
+				MarkdownDescription: "Workflows allowed to call this workflow: 'any', 'none', 'workflowsFromAList' or 'workflowsFromSameOwner'. " +
+					"Defaults to 'workflowsFromSameOwner'.",
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(CALLER_POLICY_DEFAULT),
+				Validators: []validator.String{stringOneOfValidator{values: callerPolicyValues}},
+			},
+			"caller_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the workflows allowed to call this workflow, when `caller_policy` is 'workflowsFromAList'",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"time_saved_per_execution": schema.Int64Attribute{
+				MarkdownDescription: "Estimated time saved per execution, in minutes",
+				Optional:            true,
+			},
+			"available_in_mcp": schema.BoolAttribute{
+				MarkdownDescription: "Whether the workflow is available to MCP clients. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(AVAILABLE_IN_MCP_DEFAULT),
+			},
+		},
+	}
+}
+
+// hasSettingsAttribute checks whether the settings attribute is configured.
+//
+// Params:
+//   - settings: The settings attribute
+//
+// Returns:
+//   - bool: True if the settings attribute is configured
+func hasSettingsAttribute(settings types.Object) bool {
+	// Return result.
+	return !settings.IsNull() && !settings.IsUnknown()
+}
+
+// validateSettingsAttribute checks that settings are configured with either the settings attribute
+// or settings_json. Unknown values count as set.
+//
+// Params:
+//   - config: The resource configuration
+//   - diags: Diagnostics for error reporting
+func validateSettingsAttribute(config *models.Resource, diags *diag.Diagnostics) {
+	// Check for conflict.
+	if !config.Settings.IsNull() && !config.SettingsJSON.IsNull() {
+		diags.AddAttributeError(
+			path.Root("settings_json"),
+			"Conflicting Workflow Settings",
+			"Only one of settings_json or settings can be set.",
+		)
+	}
+}
+
+// settingsFromAttribute converts the settings attribute to n8n workflow settings.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - settings: The settings attribute
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - n8nsdk.WorkflowSettings: The workflow settings
+func settingsFromAttribute(ctx context.Context, settings types.Object, diags *diag.Diagnostics) n8nsdk.WorkflowSettings {
+	var model models.Settings
+	diags.Append(settings.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	result := n8nsdk.WorkflowSettings{
+		Timezone:                 knownStringPointer(model.Timezone),
+		ErrorWorkflow:            knownStringPointer(model.ErrorWorkflow),
+		SaveDataErrorExecution:   knownStringPointer(model.SaveDataErrorExecution),
+		SaveDataSuccessExecution: knownStringPointer(model.SaveDataSuccessExecution),
+		SaveExecutionProgress:    knownBoolPointer(model.SaveExecutionProgress),
+		SaveManualExecutions:     knownBoolPointer(model.SaveManualExecutions),
+		ExecutionOrder:           knownStringPointer(model.ExecutionOrder),
+		CallerPolicy:             knownStringPointer(model.CallerPolicy),
+		AvailableInMCP:           knownBoolPointer(model.AvailableInMCP),
+	}
+	// Set execution timeout if configured.
+	if !model.ExecutionTimeout.IsNull() && !model.ExecutionTimeout.IsUnknown() {
+		result.ExecutionTimeout = float32Pointer(float64(model.ExecutionTimeout.ValueInt64()))
+	}
+	// Set time saved if configured.
+	if !model.TimeSavedPerExecution.IsNull() && !model.TimeSavedPerExecution.IsUnknown() {
+		result.TimeSavedPerExecution = float32Pointer(float64(model.TimeSavedPerExecution.ValueInt64()))
+	}
+	// Set caller IDs if configured.
+	if !model.CallerIDs.IsNull() && !model.CallerIDs.IsUnknown() {
+		var callerIDs []string
+		diags.Append(model.CallerIDs.ElementsAs(ctx, &callerIDs, false)...)
+		joined := strings.Join(callerIDs, ",")
+		result.CallerIds = &joined
+	}
+
+	// Return settings.
+	return result
+}
+
+// mapSettingsAttribute updates the settings attribute from the settings returned by n8n.
+// The attribute is only maintained when it is configured.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - settings: The settings returned by n8n
+//   - prior: The prior settings attribute, from the plan or the state
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - types.Object: The updated settings attribute
+func mapSettingsAttribute(ctx context.Context, settings n8nsdk.WorkflowSettings, prior types.Object, diags *diag.Diagnostics) types.Object {
+	// Keep an unconfigured attribute as-is.
+	if !hasSettingsAttribute(prior) {
+		// Check for an untyped value.
+		if len(prior.AttributeTypes(ctx)) == 0 {
+			// Return null settings.
+			return types.ObjectNull(settingsAttributeTypes)
+		}
+		// Return prior settings.
+		return prior
+	}
+
+	var priorModel models.Settings
+	diags.Append(prior.As(ctx, &priorModel, basetypes.ObjectAsOptions{})...)
+
+	model := models.Settings{
+		Timezone:                 types.StringPointerValue(settings.Timezone),
+		ErrorWorkflow:            types.StringPointerValue(settings.ErrorWorkflow),
+		ExecutionTimeout:         int64PointerValue(settings.ExecutionTimeout),
+		SaveDataErrorExecution:   types.StringPointerValue(settings.SaveDataErrorExecution),
+		SaveDataSuccessExecution: types.StringPointerValue(settings.SaveDataSuccessExecution),
+		SaveExecutionProgress:    types.BoolPointerValue(settings.SaveExecutionProgress),
+		SaveManualExecutions:     types.BoolPointerValue(settings.SaveManualExecutions),
+		ExecutionOrder:           types.StringPointerValue(settings.ExecutionOrder),
+		CallerPolicy:             types.StringValue(CALLER_POLICY_DEFAULT),
+		CallerIDs:                mapCallerIDs(ctx, settings.CallerIds, priorModel.CallerIDs, diags),
+		TimeSavedPerExecution:    int64PointerValue(settings.TimeSavedPerExecution),
+		AvailableInMCP:           types.BoolValue(AVAILABLE_IN_MCP_DEFAULT),
+	}
+	// Set caller policy if returned.
+	if settings.CallerPolicy != nil {
+		model.CallerPolicy = types.StringValue(*settings.CallerPolicy)
+	}
+	// Set MCP availability if returned.
+	if settings.AvailableInMCP != nil {
+		model.AvailableInMCP = types.BoolValue(*settings.AvailableInMCP)
+	}
+
+	object, objectDiags := types.ObjectValueFrom(ctx, settingsAttributeTypes, model)
+	diags.Append(objectDiags...)
+
+	// Return settings attribute.
+	return object
+}
+
+// mapCallerIDs converts the comma separated caller IDs returned by n8n to a list.
+// An empty value keeps the prior empty list.
+//
+// Params:
+//   - ctx: Context for the conversion
+//   - callerIDs: The caller IDs returned by n8n
+//   - prior: The prior caller IDs
+//   - diags: Diagnostics for error reporting
+//
+// Returns:
+//   - types.List: The caller IDs
+func mapCallerIDs(ctx context.Context, callerIDs *string, prior types.List, diags *diag.Diagnostics) types.List {
+	// Check for unset value.
+	if callerIDs == nil || strings.TrimSpace(*callerIDs) == "" {
+		// Keep a prior empty list.
+		if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+			// Return prior list.
+			return prior
+		}
+		// Return null.
+		return types.ListNull(types.StringType)
+	}
+
+	ids := []string{}
+	// Split each ID.
+	for id := range strings.SplitSeq(*callerIDs, ",") {
+		// Skip empty IDs.
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	list, listDiags := types.ListValueFrom(ctx, types.StringType, ids)
+	diags.Append(listDiags...)
+
+	// Return caller IDs.
+	return list
+}
+
+// knownBoolPointer returns a pointer to a known boolean value.
+//
+// Params:
+//   - value: The boolean value
+//
+// Returns:
+//   - *bool: Pointer to the value, nil if null or unknown
+func knownBoolPointer(value types.Bool) *bool {
+	// Check for unset value.
+	if value.IsNull() || value.IsUnknown() {
+		// Return nil.
+		return nil
+	}
+	// Return pointer.
+	return value.ValueBoolPointer()
+}
@@ -0,0 +1,240 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/kodflow/terraform-provider-n8n/sdk/n8nsdk"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/shared"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/jsontypes"
+	"github.com/kodflow/terraform-provider-n8n/src/internal/provider/workflow/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSettings returns a settings attribute with only the defaults set.
+func testSettings() models.Settings {
+	return models.Settings{
+		Timezone:                 types.StringNull(),
+		ErrorWorkflow:            types.StringNull(),
+		ExecutionTimeout:         types.Int64Null(),
+		SaveDataErrorExecution:   types.StringNull(),
+		SaveDataSuccessExecution: types.StringNull(),
+		SaveExecutionProgress:    types.BoolNull(),
+		SaveManualExecutions:     types.BoolNull(),
+		ExecutionOrder:           types.StringNull(),
+		CallerPolicy:             types.StringValue(CALLER_POLICY_DEFAULT),
+		CallerIDs:                types.ListNull(types.StringType),
+		TimeSavedPerExecution:    types.Int64Null(),
+		AvailableInMCP:           types.BoolValue(AVAILABLE_IN_MCP_DEFAULT),
+	}
+}
+
+// testSettingsObject converts a settings attribute to an object value.
+func testSettingsObject(t *testing.T, settings models.Settings) types.Object {
+	t.Helper()
+	object, diags := types.ObjectValueFrom(context.Background(), settingsAttributeTypes, settings)
+	require.False(t, diags.HasError())
+
+	return object
+}
+
+func Test_validateSettingsAttribute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  func(t *testing.T) *models.Resource
+		wantErr bool
+	}{
+		{
+			name: "settings attribute only",
+			config: func(t *testing.T) *models.Resource {
+				t.Helper()
+				return &models.Resource{Settings: testSettingsObject(t, testSettings()), SettingsJSON: jsontypes.NewNull(jsontypes.KIND_SETTINGS)}
+			},
+		},
+		{
+			name: "settings_json only",
+			config: func(t *testing.T) *models.Resource {
+				t.Helper()
+				return &models.Resource{Settings: types.ObjectNull(settingsAttributeTypes), SettingsJSON: jsontypes.NewSettingsValue("{}")}
+			},
+		},
+		{
+			name: "error case - settings attribute and settings_json",
+			config: func(t *testing.T) *models.Resource {
+				t.Helper()
+				return &models.Resource{Settings: testSettingsObject(t, testSettings()), SettingsJSON: jsontypes.NewSettingsValue("{}")}
+			},
+			wantErr: true,
+		},
+		{
+			name: "error case - unknown settings attribute and settings_json",
+			config: func(t *testing.T) *models.Resource {
+				t.Helper()
+				return &models.Resource{Settings: types.ObjectUnknown(settingsAttributeTypes), SettingsJSON: jsontypes.NewSettingsValue("{}")}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var diags diag.Diagnostics
+			validateSettingsAttribute(tt.config(t), &diags)
+			assert.Equal(t, tt.wantErr, diags.HasError())
+		})
+	}
+}
+
+func Test_settingsFromAttribute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+		var diags diag.Diagnostics
+		settings := settingsFromAttribute(context.Background(), testSettingsObject(t, testSettings()), &diags)
+		require.False(t, diags.HasError())
+		assert.Equal(t, n8nsdk.WorkflowSettings{
+			CallerPolicy:   shared.Ptr(CALLER_POLICY_DEFAULT),
+			AvailableInMCP: shared.Ptr(false),
+		}, settings)
+	})
+
+	t.Run("all attributes", func(t *testing.T) {
+		t.Parallel()
+		model := testSettings()
+		model.Timezone = types.StringValue("Europe/Paris")
+		model.ErrorWorkflow = types.StringValue("wf-error")
+		model.ExecutionTimeout = types.Int64Value(3600)
+		model.SaveDataErrorExecution = types.StringValue("all")
+		model.SaveDataSuccessExecution = types.StringValue("none")
+		model.SaveExecutionProgress = types.BoolValue(true)
+		model.SaveManualExecutions = types.BoolValue(false)
+		model.ExecutionOrder = types.StringValue("v1")
+		model.CallerPolicy = types.StringValue("workflowsFromAList")
+		model.CallerIDs, _ = types.ListValueFrom(context.Background(), types.StringType, []string{"14", "18"})
+		model.TimeSavedPerExecution = types.Int64Value(5)
+		model.AvailableInMCP = types.BoolValue(true)
+
+		var diags diag.Diagnostics
+		settings := settingsFromAttribute(context.Background(), testSettingsObject(t, model), &diags)
+		require.False(t, diags.HasError())
+		assert.Equal(t, n8nsdk.WorkflowSettings{
+			Timezone:                 shared.Ptr("Europe/Paris"),
+			ErrorWorkflow:            shared.Ptr("wf-error"),
+			ExecutionTimeout:         shared.Ptr(float32(3600)),
+			SaveDataErrorExecution:   shared.Ptr("all"),
+			SaveDataSuccessExecution: shared.Ptr("none"),
+			SaveExecutionProgress:    shared.Ptr(true),
+			SaveManualExecutions:     shared.Ptr(false),
+			ExecutionOrder:           shared.Ptr("v1"),
+			CallerPolicy:             shared.Ptr("workflowsFromAList"),
+			CallerIds:                shared.Ptr("14,18"),
+			TimeSavedPerExecution:    shared.Ptr(float32(5)),
+			AvailableInMCP:           shared.Ptr(true),
+		}, settings)
+	})
+}
+
+func Test_mapSettingsAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("matches the API defaults", func(t *testing.T) {
+		t.Parallel()
+		prior := testSettingsObject(t, testSettings())
+		settings := n8nsdk.WorkflowSettings{
+			CallerPolicy:   shared.Ptr(CALLER_POLICY_DEFAULT),
+			AvailableInMCP: shared.Ptr(false),
+		}
+
+		var diags diag.Diagnostics
+		object := mapSettingsAttribute(ctx, settings, prior, &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, object.Equal(prior))
+	})
+
+	t.Run("fills defaults omitted by the API", func(t *testing.T) {
+		t.Parallel()
+		prior := testSettingsObject(t, testSettings())
+
+		var diags diag.Diagnostics
+		object := mapSettingsAttribute(ctx, n8nsdk.WorkflowSettings{}, prior, &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, object.Equal(prior))
+	})
+
+	t.Run("reports drift", func(t *testing.T) {
+		t.Parallel()
+		settings := n8nsdk.WorkflowSettings{
+			Timezone:         shared.Ptr("UTC"),
+			ExecutionTimeout: shared.Ptr(float32(60)),
+			CallerPolicy:     shared.Ptr("workflowsFromAList"),
+			CallerIds:        shared.Ptr("14, 18"),
+		}
+
+		var diags diag.Diagnostics
+		object := mapSettingsAttribute(ctx, settings, testSettingsObject(t, testSettings()), &diags)
+		require.False(t, diags.HasError())
+
+		var model models.Settings
+		require.False(t, object.As(ctx, &model, basetypes.ObjectAsOptions{}).HasError())
+		assert.Equal(t, "UTC", model.Timezone.ValueString())
+		assert.Equal(t, int64(60), model.ExecutionTimeout.ValueInt64())
+		assert.Equal(t, "workflowsFromAList", model.CallerPolicy.ValueString())
+		var callerIDs []string
+		require.False(t, model.CallerIDs.ElementsAs(ctx, &callerIDs, false).HasError())
+		assert.Equal(t, []string{"14", "18"}, callerIDs)
+		assert.True(t, model.SaveManualExecutions.IsNull())
+	})
+
+	t.Run("keeps an unconfigured attribute", func(t *testing.T) {
+		t.Parallel()
+		var diags diag.Diagnostics
+		object := mapSettingsAttribute(ctx, n8nsdk.WorkflowSettings{Timezone: shared.Ptr("UTC")}, types.Object{}, &diags)
+		require.False(t, diags.HasError())
+		assert.True(t, object.IsNull())
+		assert.Equal(t, settingsAttributeTypes, object.AttributeTypes(ctx))
+	})
+}
+
+func Test_mapCallerIDs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	emptyList, _ := types.ListValueFrom(ctx, types.StringType, []string{})
+
+	tests := []struct {
+		name      string
+		callerIDs *string
+		prior     types.List
+		want      []string
+		wantNull  bool
+	}{
+		{name: "unset", callerIDs: nil, prior: types.ListNull(types.StringType), wantNull: true},
+		{name: "empty keeps prior empty list", callerIDs: shared.Ptr(""), prior: emptyList, want: []string{}},
+		{name: "splits and trims", callerIDs: shared.Ptr("14, 18,,23"), prior: types.ListNull(types.StringType), want: []string{"14", "18", "23"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var diags diag.Diagnostics
+			list := mapCallerIDs(ctx, tt.callerIDs, tt.prior, &diags)
+			require.False(t, diags.HasError())
+			assert.Equal(t, tt.wantNull, list.IsNull())
+			if !tt.wantNull {
+				var ids []string
+				require.False(t, list.ElementsAs(ctx, &ids, false).HasError())
+				assert.Equal(t, tt.want, ids)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure validators implement the framework interfaces.
+var (
+	_ validator.String = stringOneOfValidator{}
+	_ validator.Int64  = int64BetweenValidator{}
+)
+
+// stringOneOfValidator validates that a string attribute is one of the values accepted by n8n.
+type stringOneOfValidator struct {
+	// values are the accepted values.
+	values []string
+}
+
+// Description returns a plain text description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v stringOneOfValidator) Description(_ctx context.Context) string {
+	// Return description.
+	return fmt.Sprintf("value must be one of: %s", v.quotedValues())
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	// Return description.
+	return v.Description(ctx)
+}
+
+// ValidateString checks that the configured value is one of the accepted values.
+//
+// Params:
+//   - ctx: context for the operation
+//   - req: validation request holding the value
+//   - resp: validation response collecting diagnostics
+func (v stringOneOfValidator) ValidateString(_ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Skip values that cannot be validated yet.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		// Return early.
+		return
+	}
+	// Check for an accepted value.
+	if !slices.Contains(v.values, req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Value must be one of: %s, got: %q", v.quotedValues(), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// quotedValues returns the accepted values, quoted and comma separated.
+//
+// Returns:
+//   - string: the accepted values
+func (v stringOneOfValidator) quotedValues() string {
+	quoted := make([]string, 0, len(v.values))
+	// Quote each value.
+	for _, value := range v.values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	// Return values.
+	return strings.Join(quoted, ", ")
+}
+
+// int64BetweenValidator validates that an int64 attribute is within inclusive bounds.
+type int64BetweenValidator struct {
+	// min is the lowest accepted value.
+	min int64
+	// max is the highest accepted value.
+	max int64
+}
+
+// Description returns a plain text description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v int64BetweenValidator) Description(_ctx context.Context) string {
+	// Return description.
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+//
+// Params:
+//   - ctx: context for the operation
+//
+// Returns:
+//   - string: validator description
+func (v int64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	// Return description.
+	return v.Description(ctx)
+}
+
+// ValidateInt64 checks that the configured value is within the bounds.
+//
+// Params:
+//   - ctx: context for the operation
+//   - req: validation request holding the value
+//   - resp: validation response collecting diagnostics
+func (v int64BetweenValidator) ValidateInt64(_ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	// Skip values that cannot be validated yet.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		// Return early.
+		return
+	}
+	// Check bounds.
+	if value := req.ConfigValue.ValueInt64(); value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Value must be between %d and %d, got: %d", v.min, v.max, value),
+		)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2024 Florent (Kodflow). All rights reserved.
+// Licensed under the Sustainable Use License 1.0
+// See LICENSE in the project root for license information.
+
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_stringOneOfValidator tests the stringOneOfValidator type.
+func Test_stringOneOfValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "accepts listed values", value: types.StringValue("all"), wantErr: false},
+		{name: "skips null values", value: types.StringNull(), wantErr: false},
+		{name: "skips unknown values", value: types.StringUnknown(), wantErr: false},
+		{name: "error case - rejects other values", value: types.StringValue("some"), wantErr: true},
+		{name: "error case - is case sensitive", value: types.StringValue("ALL"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := stringOneOfValidator{values: saveDataValues}
+			req := validator.StringRequest{Path: path.Root("settings").AtName("save_data_error_execution"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			v.ValidateString(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			assert.Equal(t, `value must be one of: "all", "none"`, v.MarkdownDescription(context.Background()))
+		})
+	}
+}
+
+// Test_int64BetweenValidator tests the int64BetweenValidator type.
+func Test_int64BetweenValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   types.Int64
+		wantErr bool
+	}{
+		{name: "accepts the lower bound", value: types.Int64Value(0), wantErr: false},
+		{name: "accepts the upper bound", value: types.Int64Value(MAX_CONNECTION_INDEX), wantErr: false},
+		{name: "skips null values", value: types.Int64Null(), wantErr: false},
+		{name: "skips unknown values", value: types.Int64Unknown(), wantErr: false},
+		{name: "error case - rejects negative values", value: types.Int64Value(-1), wantErr: true},
+		{name: "error case - rejects values above the upper bound", value: types.Int64Value(MAX_CONNECTION_INDEX + 1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := connectionIndexValidator
+			req := validator.Int64Request{Path: path.Root("node_connection"), ConfigValue: tt.value}
+			resp := &validator.Int64Response{}
+
+			v.ValidateInt64(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+			assert.Equal(t, "value must be between 0 and 1000", v.MarkdownDescription(context.Background()))
+		})
+	}
+}